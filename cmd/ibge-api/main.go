@@ -3,10 +3,13 @@ package main
 import (
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"runtime"
+	_ "time/tzdata" // Embute a base de fusos horários IANA, usada pelo endpoint de hora local
 
 	"github.com/brauliohms/ibge-service/docs"
+	grpcserver "github.com/brauliohms/ibge-service/internal/adapter/grpc"
 	httphandler "github.com/brauliohms/ibge-service/internal/adapter/http"
 	"github.com/brauliohms/ibge-service/internal/adapter/repository/memory"
 	"github.com/brauliohms/ibge-service/internal/adapter/repository/sqlite"
@@ -17,6 +20,11 @@ import (
 // @title           API de Dados do IBGE
 // @version         1.0
 // @description     Este é um microserviço para consulta de estados e cidades do Brasil, baseado nos dados do IBGE.
+// @description     A edição do conjunto de dados pode ser escolhida com o header X-Dataset-Version ou o parâmetro versao.
+// @description     As respostas podem ser em JSON, CSV, XML, YAML, MessagePack, CBOR ou Protobuf, pelo header Accept ou pelo parâmetro formato.
+// @description     O parâmetro fields seleciona os campos dos recursos e, na v1, expand=estado,regiao aninha o estado e a Grande Região.
+// @description     A v2 tem um contrato estável: estado e região aninhados, códigos numéricos, campos ausentes como null e listas em envelopes com metadados.
+// @description     As respostas levam ETag e Last-Modified e atendem If-None-Match e If-Modified-Since com 304.
 
 // @contact.name   API IBGE Support
 // @contact.url    http://www.exemplo.com/support
@@ -25,7 +33,7 @@ import (
 // @license.name  Apache 2.0
 // @license.url   http://www.apache.org/licenses/LICENSE-2.0.html
 
-// @BasePath  /api/v1
+// @BasePath  /api
 
 func main() {
 	// Otimizar para alta concorrência
@@ -45,26 +53,36 @@ func main() {
 	// }
 	// log.Println("Conexão com PostgreSQL estabelecida")
 
-	ibgeRepo, err := sqlite.NewSQLiteRepository(cfg.SqliteDSN)
-	if err != nil {
-		log.Fatalf("❌ Erro ao conectar com o SQLite: %v", err)
-	}
+	// 3. Para cada edição do conjunto de dados (ex: DTB 2020, 2022, 2024), carregar o SQLite
+	// em um repositório em memória próprio. Esta é a mágica do cache no startup!
+	// 4. Injetar cada repositório EM MEMÓRIA em um caso de uso, registrado pela sua versão.
+	// A aplicação agora só falará com o cache, sendo extremamente rápida.
+	versoes := usecase.NewRegistroVersoes()
+	for versao, dsn := range cfg.GetDatasetSources() {
+		ibgeRepo, err := sqlite.NewSQLiteRepository(dsn)
+		if err != nil {
+			log.Fatalf("❌ Erro ao conectar com o SQLite da versão %s: %v", versao, err)
+		}
 
-	// 3. Inicializar o repositório em memória, usando o PostgreSQL como fonte.
-	// Esta é a mágica do cache no startup!
-	memoryRepo, err := memory.NewMemoryRepository(ibgeRepo)
-	if err != nil {
-		log.Fatalf("❌ Erro ao carregar dados para o repositório em memória: %v", err)
-	}
-	log.Println("Repositório em memória populado com sucesso")
-	// Neste ponto, a conexão com o Postgres poderia até ser fechada se não fosse mais necessária.
+		memoryRepo, err := memory.NewMemoryRepository(ibgeRepo)
+		if err != nil {
+			log.Fatalf("❌ Erro ao carregar dados da versão %s para o repositório em memória: %v", versao, err)
+		}
+		// Os dados já estão em memória; a conexão com o SQLite não é mais necessária.
+		ibgeRepo.Close()
 
-	// 4. Injetar o repositório EM MEMÓRIA no caso de uso.
-	// A aplicação agora só falará com o cache, sendo extremamente rápida.
-	ibgeUseCase := usecase.NewIBGEUseCase(memoryRepo)
+		versoes.Registrar(versao, usecase.NewIBGEUseCase(memoryRepo))
+		log.Printf("Repositório em memória da versão %s populado com sucesso (%s)", versao, dsn)
+	}
+	if cfg.DatasetDefault != "" {
+		if err := versoes.DefinirPadrao(cfg.DatasetDefault); err != nil {
+			log.Fatalf("❌ Erro ao definir a versão padrão: %v", err)
+		}
+	}
+	log.Printf("📦 Versões do conjunto de dados: %v (padrão: %s)", versoes.Versoes(), versoes.Padrao())
 
-	// 5. Injetar o caso de uso nos handlers HTTP.
-	ibgeHandler := httphandler.NewIBGEHandler(ibgeUseCase)
+	// 5. Injetar o registro de versões nos handlers HTTP.
+	ibgeHandler := httphandler.NewIBGEHandler(versoes)
 
 	// 6. Configurar o roteador.
 	router := httphandler.SetupRouter(ibgeHandler)
@@ -73,10 +91,24 @@ func main() {
 	docs.SwaggerInfo.Description = "Microserviço para consulta de estados e cidades do Brasil."
 	docs.SwaggerInfo.Version = "1.0"
 	docs.SwaggerInfo.Host = fmt.Sprintf("localhost:%s", cfg.ServerPort)
-	docs.SwaggerInfo.BasePath = "/api/v1"
+	docs.SwaggerInfo.BasePath = "/api"
 	docs.SwaggerInfo.Schemes = []string{"http", "https"}
 
-	// 7. Iniciar o servidor HTTP.
+	// 7. Iniciar o servidor gRPC, que compartilha o mesmo registro de versões.
+	if cfg.GRPCPort != "" {
+		lis, err := net.Listen("tcp", cfg.GetGRPCAddress())
+		if err != nil {
+			log.Fatalf("❌ Erro ao abrir a porta do servidor gRPC: %v", err)
+		}
+		go func() {
+			log.Printf("✅ Servidor gRPC rodando em %s", cfg.GetGRPCAddress())
+			if err := grpcserver.NewServer(versoes).Serve(lis); err != nil {
+				log.Fatalf("❌ Erro ao iniciar o servidor gRPC: %v", err)
+			}
+		}()
+	}
+
+	// 8. Iniciar o servidor HTTP.
 	log.Printf("✅ Servidor rodando em http://%s", cfg.GetServerAddress())
 	log.Printf("📚 Documentação disponível em http://%s/api/v1/docs/", cfg.GetServerAddress())
 	if err := http.ListenAndServe(cfg.GetServerAddress(), router); err != nil {
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/brauliohms/ibge-service/internal/adapter/repository/postgres"
+	"github.com/brauliohms/ibge-service/internal/adapter/repository/sqlite"
+	"github.com/brauliohms/ibge-service/internal/diff"
+	"github.com/brauliohms/ibge-service/internal/seed"
+)
+
+func main() {
+	var (
+		antigo  = flag.String("antigo", "", "Edição atual: diretório com os JSON, arquivo SQLite ou DSN postgres://")
+		novo    = flag.String("novo", "", "Nova edição: diretório com os JSON, arquivo SQLite ou DSN postgres://")
+		formato = flag.String("formato", diff.FormatoTexto, "Formato da saída (texto, json, csv)")
+		saida   = flag.String("saida", "", "Arquivo de saída (padrão: saída padrão)")
+	)
+	flag.Parse()
+
+	if *antigo == "" || *novo == "" {
+		log.Fatal("As edições são obrigatórias. Use -antigo e -novo para especificá-las")
+	}
+
+	conjuntoAntigo, err := carregar(*antigo)
+	if err != nil {
+		log.Fatalf("Erro ao carregar a edição %s: %v", *antigo, err)
+	}
+
+	conjuntoNovo, err := carregar(*novo)
+	if err != nil {
+		log.Fatalf("Erro ao carregar a edição %s: %v", *novo, err)
+	}
+
+	w := os.Stdout
+	if *saida != "" {
+		w, err = os.Create(*saida)
+		if err != nil {
+			log.Fatalf("Erro ao criar o arquivo de saída: %v", err)
+		}
+		defer w.Close()
+	}
+
+	relatorio := diff.Comparar(conjuntoAntigo, conjuntoNovo)
+	if err := relatorio.Escrever(w, *formato); err != nil {
+		log.Fatalf("Erro ao escrever o relatório: %v", err)
+	}
+}
+
+// carregar lê uma edição a partir de um diretório de JSON, de um DSN PostgreSQL ou de um arquivo SQLite.
+func carregar(origem string) (*diff.Conjunto, error) {
+	if info, err := os.Stat(origem); err == nil && info.IsDir() {
+		estados, cidades, err := seed.CarregarDiretorio(origem)
+		if err != nil {
+			return nil, err
+		}
+		return &diff.Conjunto{Estados: estados, Cidades: cidades}, nil
+	}
+
+	if strings.HasPrefix(origem, "postgres://") || strings.HasPrefix(origem, "postgresql://") {
+		repo, err := postgres.NewPostgresRepository(origem)
+		if err != nil {
+			return nil, err
+		}
+		return diff.Carregar(repo)
+	}
+
+	if _, err := os.Stat(origem); err != nil {
+		return nil, err
+	}
+	repo, err := sqlite.NewSQLiteRepository(origem)
+	if err != nil {
+		return nil, err
+	}
+	defer repo.Close()
+	return diff.Carregar(repo)
+}
+
+// Comparar a base atual com uma nova DTB antes de executar o seed
+// go run ./cmd/ibge-diff -antigo=data/ibge.db -novo=/caminho/dtb-2024 -formato=csv -saida=diff.csv
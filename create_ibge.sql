@@ -1,7 +1,8 @@
 CREATE TABLE estados (
     codigo_ibge INT PRIMARY KEY,         -- Código numérico do IBGE para o estado. É a chave natural.
     nome VARCHAR(30) NOT NULL UNIQUE,           -- Nome completo do estado. Ex: "São Paulo".
-    sigla CHAR(2) NOT NULL UNIQUE        -- Sigla do estado. Ex: "SP". A constraint UNIQUE garante a unicidade e acelera buscas pela sigla.
+    sigla CHAR(2) NOT NULL UNIQUE,       -- Sigla do estado. Ex: "SP". A constraint UNIQUE garante a unicidade e acelera buscas pela sigla.
+    fuso_horario VARCHAR(40)             -- Fuso horário IANA padrão do estado. Ex: "America/Sao_Paulo".
 );
 
 CREATE TABLE cidades (
@@ -10,6 +11,8 @@ CREATE TABLE cidades (
     codigo_tom INT,              -- Código TOM (Tribunal de Contas dos Municípios), pode ser nulo se não aplicável.
     micro_regiao INT,           -- Nome da microrregião.
     regiao_imediata INT,        -- Nome da região imediata.
+    fuso_horario VARCHAR(40),   -- Fuso horário IANA do município. Ex: "America/Manaus".
+    gentilico VARCHAR(60),      -- Gentílico do município. Ex: "paulistano".
     estado_codigo_ibge INT NOT NULL,     -- Chave estrangeira referenciando o estado.
 
     -- Definindo a chave estrangeira para garantir a integridade relacional
@@ -19,4 +22,82 @@ CREATE TABLE cidades (
 );
 
 -- Criar índice para otimizar a busca de cidades por estado.
-CREATE INDEX idx_cidades_por_estado ON cidades(estado_codigo_ibge);
\ No newline at end of file
+CREATE INDEX idx_cidades_por_estado ON cidades(estado_codigo_ibge);
+
+CREATE TABLE faixas_cep (
+    cep_inicial CHAR(8) NOT NULL,        -- Primeiro CEP da faixa, 8 dígitos sem máscara.
+    cep_final CHAR(8) NOT NULL,          -- Último CEP da faixa, 8 dígitos sem máscara.
+    uf CHAR(2) NOT NULL,                 -- Sigla do estado ao qual a faixa pertence.
+    codigo_ibge INT NOT NULL DEFAULT 0,  -- Código IBGE do município. 0 indica uma faixa estadual.
+
+    PRIMARY KEY(cep_inicial, codigo_ibge)
+);
+
+
+CREATE TABLE feriados (
+    uf CHAR(2) NOT NULL,                 -- Sigla do estado do feriado (estadual) ou do município (municipal).
+    codigo_ibge INT NOT NULL DEFAULT 0,  -- Código IBGE do município. 0 indica um feriado estadual.
+    nome VARCHAR(80) NOT NULL,           -- Nome do feriado. Ex: "Revolução Constitucionalista de 1932".
+    dia INT,                             -- Dia do mês, para feriados de data fixa.
+    mes INT,                             -- Mês, para feriados de data fixa.
+    dias_apos_pascoa INT,                -- Deslocamento em dias a partir do domingo de Páscoa, para feriados móveis.
+
+    PRIMARY KEY(uf, codigo_ibge, nome)
+);
+
+
+CREATE TABLE classificacoes_territoriais (
+    codigo_ibge INT NOT NULL,            -- Código IBGE do município classificado.
+    classificacao VARCHAR(20) NOT NULL,  -- amazonia_legal, semiarido, faixa_fronteira, zona_costeira ou matopiba.
+    paises_fronteira VARCHAR(200),       -- Países lindeiros separados por vírgula (apenas faixa_fronteira).
+
+    PRIMARY KEY(codigo_ibge, classificacao),
+    CONSTRAINT fk_cidade
+        FOREIGN KEY(codigo_ibge) 
+        REFERENCES cidades(codigo_ibge)
+);
+
+
+CREATE TABLE cidades_aliases (
+    codigo_ibge INT NOT NULL,            -- Código IBGE do município.
+    alias VARCHAR(80) NOT NULL,          -- Nome alternativo: grafia antiga, abreviação ou nome anterior. Ex: "Parati".
+
+    PRIMARY KEY(codigo_ibge, alias),
+    CONSTRAINT fk_cidade
+        FOREIGN KEY(codigo_ibge) 
+        REFERENCES cidades(codigo_ibge)
+);
+
+
+CREATE TABLE municipios_historico (
+    codigo_ibge INT NOT NULL,            -- Código IBGE do município (sem chave estrangeira: municípios extintos não constam de cidades).
+    tipo VARCHAR(20) NOT NULL,           -- criacao, desmembramento, renomeacao ou extincao.
+    data CHAR(10) NOT NULL,              -- Data de vigência do evento (AAAA-MM-DD). Ex: '2013-01-01'.
+    nome VARCHAR(80),                    -- Nome do município após o evento.
+    nome_anterior VARCHAR(80),           -- Nome anterior, em renomeações. Ex: 'Embu'.
+    uf CHAR(2),                          -- Sigla do estado, necessária para municípios extintos.
+    origem VARCHAR(200),                 -- Códigos IBGE dos municípios de origem, separados por vírgula (desmembramentos).
+    sucessores VARCHAR(200),             -- Códigos IBGE dos municípios que absorveram o território, separados por vírgula (extinções).
+
+    PRIMARY KEY(codigo_ibge, tipo, data)
+);
+
+
+CREATE TABLE alteracoes (
+    versao INT NOT NULL,                 -- Versão do conjunto de dados em que a alteração foi registrada pelo seed.
+    sequencia INT NOT NULL,              -- Ordem da alteração dentro da versão.
+    entidade VARCHAR(10) NOT NULL,       -- estado ou cidade.
+    tipo VARCHAR(10) NOT NULL,           -- adicionado, removido ou alterado.
+    codigo_ibge INT NOT NULL,            -- Código IBGE do estado ou município.
+    nome VARCHAR(80) NOT NULL,           -- Nome após a alteração (ou anterior, se removido).
+    uf CHAR(2),                          -- Sigla do estado.
+    campos TEXT,                         -- JSON com os campos alterados: [{"campo":"nome","antes":"Embu","depois":"Embu das Artes"}].
+
+    PRIMARY KEY(versao, sequencia)
+);
+
+
+CREATE TABLE metadados (
+    chave VARCHAR(40) PRIMARY KEY,       -- Ex: 'versao_dataset', 'atualizado_em'.
+    valor VARCHAR(200) NOT NULL
+);
@@ -1,4 +1,5 @@
-// Package docs Code generated by swaggo/swag. DO NOT EDIT
+// Package docs GENERATED BY SWAG; DO NOT EDIT
+// This file was generated by swaggo/swag
 package docs
 
 import "github.com/swaggo/swag"
@@ -23,9 +24,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/cidades/{codigo_ibge}": {
+        "/v1/alteracoes": {
             "get": {
-                "description": "Retorna uma cidade específica pelo seu código IBGE",
+                "description": "Retorna as criações, atualizações e remoções de estados e municípios registradas pelo seed depois da versão informada. Guarde versao_atual e use-a como desde na próxima sincronização.",
                 "consumes": [
                     "application/json"
                 ],
@@ -33,17 +34,90 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cidades"
+                    "Alterações"
                 ],
-                "summary": "Busca cidade por código IBGE",
+                "summary": "Feed de alterações para sincronização",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 1,
+                        "description": "Versão a partir da qual listar as alterações (padrão: 0, histórico completo)",
+                        "name": "desde",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (a partir de 1)",
+                        "name": "pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 100, máximo 1000)",
+                        "name": "por_pagina",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "example": "3550308",
-                        "description": "Código IBGE da cidade",
-                        "name": "codigo_ibge",
+                        "description": "Cursor do header Link (vazio para começar a navegação por cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.FeedAlteracoes"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Links para as páginas first, prev, next e last (RFC 8288)"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total de alterações do feed"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/cep/{cep}": {
+            "get": {
+                "description": "Retorna a cidade cuja faixa de CEP contém o CEP informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CEP"
+                ],
+                "summary": "Busca cidade por CEP",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "01310-100",
+                        "description": "CEP com ou sem máscara",
+                        "name": "cep",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "2010-08-01",
+                        "description": "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época",
+                        "name": "data",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -56,27 +130,21 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/http.problema"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/http.problema"
                         }
                     }
                 }
             }
         },
-        "/cidades/{codigo_tom}/tom": {
+        "/v1/cep/{cep}/validacao": {
             "get": {
-                "description": "Retorna uma cidade específica pelo seu código TOM",
+                "description": "Verifica se a cidade e a UF informadas em um endereço correspondem às faixas de CEP cadastradas",
                 "consumes": [
                     "application/json"
                 ],
@@ -84,50 +152,60 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cidades"
+                    "CEP"
                 ],
-                "summary": "Busca cidade por código TOM",
+                "summary": "Valida a consistência entre CEP, cidade e UF",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "7107",
-                        "description": "Código TOM da cidade",
-                        "name": "codigo_tom",
+                        "example": "01310-100",
+                        "description": "CEP com ou sem máscara",
+                        "name": "cep",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "São Paulo",
+                        "description": "Nome ou código IBGE da cidade",
+                        "name": "cidade",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "SP",
+                        "description": "Sigla ou código IBGE do estado",
+                        "name": "uf",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.Cidade"
+                            "$ref": "#/definitions/domain.ValidacaoEndereco"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/http.problema"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/http.problema"
                         }
                     }
                 }
             }
         },
-        "/estados": {
+        "/v1/cidades": {
             "get": {
-                "description": "Retorna um array com todos os 27 estados brasileiros",
+                "description": "Retorna as cidades do país, com os mesmos filtros e ordenação de /estados/{uf}/cidades. Sem ordenação, a lista segue a ordem por UF e nome.",
                 "consumes": [
                     "application/json"
                 ],
@@ -135,34 +213,120 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Estados"
+                    "Cidades"
+                ],
+                "summary": "Lista as cidades de todos os estados",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "sao jose",
+                        "description": "Parte do nome, sem diferenciar acentos e caixa",
+                        "name": "nome_contem",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "35061",
+                        "description": "Código da microrregião",
+                        "name": "micro_regiao",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "350001",
+                        "description": "Código da região geográfica imediata",
+                        "name": "regiao_imediata",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filtra cidades com (ou sem) código TOM",
+                        "name": "tem_codigo_tom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nome,-codigo_ibge",
+                        "description": "Campos de ordenação separados por vírgula, com - para ordem decrescente",
+                        "name": "ordenar",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "aliases, estado e/ou regiao, separados por vírgula",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "codigo_ibge,nome",
+                        "description": "Campos de cada cidade, separados por vírgula",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época",
+                        "name": "data",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (a partir de 1)",
+                        "name": "pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 100, máximo 1000)",
+                        "name": "por_pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor do header Link (vazio para começar a navegação por cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Lista todos os estados",
                 "responses": {
                     "200": {
-                        "description": "Lista de estados retornada com sucesso",
+                        "description": "Lista de cidades retornada com sucesso",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/domain.Estado"
+                                "$ref": "#/definitions/domain.Cidade"
+                            }
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Links para as páginas first, prev, next e last (RFC 8288)"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total de itens da lista"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Filtro, ordenação ou paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
                     "500": {
                         "description": "Erro interno do servidor",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/http.problema"
                         }
                     }
                 }
             }
         },
-        "/estados/{uf}": {
+        "/v1/cidades/busca": {
             "get": {
-                "description": "Retorna os dados completos de um único estado",
+                "description": "Retorna as cidades cujo nome oficial ou nome alternativo (grafias antigas, abreviações, nomes anteriores) contém o termo, sem diferenciar acentos e caixa. Correspondências exatas vêm primeiro.",
                 "consumes": [
                     "application/json"
                 ],
@@ -170,40 +334,223 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Estados"
+                    "Cidades"
                 ],
-                "summary": "Busca um estado pela sua sigla (UF)",
+                "summary": "Busca cidades pelo nome",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Sigla do Estado (ex: SP, RJ, BA) ou Código IBGE do Estado (ex: 35, 33, 29)",
+                        "example": "S. J. dos Campos",
+                        "description": "Nome ou parte do nome da cidade",
+                        "name": "nome",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "SP",
+                        "description": "Sigla do estado",
+                        "name": "uf",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "aliases, estado e/ou regiao, separados por vírgula",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "codigo_ibge,nome",
+                        "description": "Campos de cada cidade, separados por vírgula",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2010-08-01",
+                        "description": "Data de referência (AAAA-MM-DD); inclui municípios extintos depois dela",
+                        "name": "data",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (a partir de 1)",
+                        "name": "pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 100, máximo 1000)",
+                        "name": "por_pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor do header Link (vazio para começar a navegação por cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Cidade"
+                            }
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Links para as páginas first, prev, next e last (RFC 8288)"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total de itens da lista"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/cidades/export": {
+            "get": {
+                "description": "Envia os municípios em JSON delimitado por linha (application/x-ndjson), estado a estado, sem montar a lista completa em memória. A primeira linha traz os metadados do export na chave _meta (versão do conjunto de dados, data de geração e filtros) e cada linha seguinte é um município. A quantidade de municípios enviados é informada no trailer X-Export-Total.",
+                "produces": [
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "Cidades"
+                ],
+                "summary": "Exporta todos os municípios em NDJSON",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "SP,RJ",
+                        "description": "Siglas dos estados, separadas por vírgula",
                         "name": "uf",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "SE",
+                        "description": "Códigos ou siglas das Grandes Regiões, separados por vírgula",
+                        "name": "regiao",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "aliases, estado e/ou regiao, separados por vírgula",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "codigo_ibge,nome",
+                        "description": "Campos de cada cidade, separados por vírgula",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Um município por linha",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Cidade"
+                        }
+                    },
+                    "400": {
+                        "description": "Filtro inválido",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/cidades/{codigo_ibge}": {
+            "get": {
+                "description": "Retorna uma cidade específica pelo seu código IBGE",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cidades"
+                ],
+                "summary": "Busca cidade por código IBGE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "3550308",
+                        "description": "Código IBGE da cidade",
+                        "name": "codigo_ibge",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "estado,regiao",
+                        "description": "aliases, estado e/ou regiao, separados por vírgula",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "codigo_ibge,nome",
+                        "description": "Campos da cidade, separados por vírgula",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2010-08-01",
+                        "description": "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época",
+                        "name": "data",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Dados do estado retornados com sucesso",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.Estado"
+                            "$ref": "#/definitions/domain.Cidade"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
                         }
                     },
                     "404": {
-                        "description": "Estado não encontrado",
+                        "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "410": {
+                        "description": "Município extinto; a resposta lista os sucessores",
+                        "schema": {
+                            "$ref": "#/definitions/http.respostaCidadeExtinta"
                         }
                     }
                 }
             }
         },
-        "/estados/{uf}/cidades": {
+        "/v1/cidades/{codigo_ibge}/hora-local": {
             "get": {
-                "description": "Retorna um array com todas as cidades pertencentes a um determinado estado (UF)",
+                "description": "Retorna a hora corrente, o fuso horário IANA e o deslocamento UTC de uma cidade",
                 "consumes": [
                     "application/json"
                 ],
@@ -213,70 +560,1684 @@ const docTemplate = `{
                 "tags": [
                     "Cidades"
                 ],
-                "summary": "Busca todas as cidades de um estado",
+                "summary": "Hora local de uma cidade",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Sigla do Estado (ex: SP, RJ, BA) ou Código IBGE do Estado (ex: 35, 33, 29)",
-                        "name": "uf",
+                        "example": "1302603",
+                        "description": "Código IBGE da cidade",
+                        "name": "codigo_ibge",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lista de cidades retornada com sucesso",
+                        "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/domain.Cidade"
-                            }
+                            "$ref": "#/definitions/domain.HoraLocal"
                         }
                     },
                     "404": {
-                        "description": "Estado não encontrado",
+                        "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/http.problema"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
+        },
+        "/v1/cidades/{codigo_tom}/tom": {
+            "get": {
+                "description": "Retorna uma cidade específica pelo seu código TOM",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cidades"
+                ],
+                "summary": "Busca cidade por código TOM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "7107",
+                        "description": "Código TOM da cidade",
+                        "name": "codigo_tom",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "estado,regiao",
+                        "description": "aliases, estado e/ou regiao, separados por vírgula",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "codigo_ibge,nome",
+                        "description": "Campos da cidade, separados por vírgula",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2010-08-01",
+                        "description": "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época",
+                        "name": "data",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Cidade"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/dias-uteis": {
+            "get": {
+                "description": "Soma dias úteis a uma data, desconsiderando fins de semana e feriados nacionais, estaduais e municipais da cidade",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feriados"
+                ],
+                "summary": "Calcula um prazo em dias úteis",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "3550308",
+                        "description": "Código IBGE da cidade",
+                        "name": "cidade",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "2026-01-23",
+                        "description": "Data inicial no formato AAAA-MM-DD (não é contada)",
+                        "name": "inicio",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 5,
+                        "description": "Quantidade de dias úteis (negativo para contar para trás)",
+                        "name": "dias",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.DiasUteis"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/downloads": {
+            "get": {
+                "description": "Retorna os pacotes com a edição completa do conjunto de dados (planilha XLSX com uma aba por tabela, ZIP de CSVs e dump SQL com CREATE TABLE e INSERT no esquema de create_ibge.sql), com o tamanho e o checksum SHA-256 de cada um. Os pacotes trazem todas as tabelas de create_ibge.sql exceto alteracoes, o log de sincronização do seed, que é servido por /v1/alteracoes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Downloads"
+                ],
+                "summary": "Lista os pacotes para download do conjunto de dados",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Página (a partir de 1)",
+                        "name": "pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 100, máximo 1000)",
+                        "name": "por_pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor do header Link (vazio para começar a navegação por cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.respostaDownloads"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Links para as páginas first, prev, next e last (RFC 8288)"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total de pacotes"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/downloads/{formato}": {
+            "get": {
+                "description": "Baixa a edição completa do conjunto de dados como planilha XLSX (uma aba por tabela), ZIP de arquivos CSV ou dump SQL portável (SQLite, PostgreSQL e MySQL), sem a tabela alteracoes (ver /v1/alteracoes). O pacote é gerado uma vez por edição e mantido em cache; o checksum SHA-256 é informado no ETag e no header Content-Digest.",
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+                    "application/zip",
+                    "application/sql"
+                ],
+                "tags": [
+                    "Downloads"
+                ],
+                "summary": "Baixa o conjunto de dados completo",
+                "parameters": [
+                    {
+                        "enum": [
+                            "xlsx",
+                            "csv",
+                            "sql"
+                        ],
+                        "type": "string",
+                        "description": "Formato do pacote",
+                        "name": "formato",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Formato não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/estados": {
+            "get": {
+                "description": "Retorna um array com todos os 27 estados brasileiros, opcionalmente filtrados pelo nome e ordenados",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Estados"
+                ],
+                "summary": "Lista todos os estados",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "para",
+                        "description": "Parte do nome, sem diferenciar acentos e caixa",
+                        "name": "nome_contem",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "-codigo_ibge",
+                        "description": "Campos de ordenação separados por vírgula, com - para ordem decrescente (codigo_ibge, nome, sigla)",
+                        "name": "ordenar",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (a partir de 1)",
+                        "name": "pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 100, máximo 1000)",
+                        "name": "por_pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor do header Link (vazio para começar a navegação por cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lista de estados retornada com sucesso",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Estado"
+                            }
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Links para as páginas first, prev, next e last (RFC 8288)"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total de itens da lista"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Ordenação ou paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/estados/{uf}": {
+            "get": {
+                "description": "Retorna os dados completos de um único estado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Estados"
+                ],
+                "summary": "Busca um estado pela sua sigla (UF)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sigla do Estado (ex: SP, RJ, BA) ou Código IBGE do Estado (ex: 35, 33, 29)",
+                        "name": "uf",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dados do estado retornados com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Estado"
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetro data informado: o histórico cobre apenas os municípios",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "404": {
+                        "description": "Estado não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/estados/{uf}/cidades": {
+            "get": {
+                "description": "Retorna um array com todas as cidades pertencentes a um determinado estado (UF)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cidades"
+                ],
+                "summary": "Busca todas as cidades de um estado",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sigla do Estado (ex: SP, RJ, BA) ou Código IBGE do Estado (ex: 35, 33, 29)",
+                        "name": "uf",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "sao jose",
+                        "description": "Parte do nome, sem diferenciar acentos e caixa",
+                        "name": "nome_contem",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "35061",
+                        "description": "Código da microrregião",
+                        "name": "micro_regiao",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "350001",
+                        "description": "Código da região geográfica imediata",
+                        "name": "regiao_imediata",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filtra cidades com (ou sem) código TOM",
+                        "name": "tem_codigo_tom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nome,-codigo_ibge",
+                        "description": "Campos de ordenação separados por vírgula, com - para ordem decrescente (codigo_ibge, nome, estado_codigo_ibge, estado_sigla, codigo_tom, micro_regiao, regiao_imediata)",
+                        "name": "ordenar",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "aliases, estado e/ou regiao, separados por vírgula",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "codigo_ibge,nome",
+                        "description": "Campos de cada cidade, separados por vírgula",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época",
+                        "name": "data",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (a partir de 1)",
+                        "name": "pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 100, máximo 1000)",
+                        "name": "por_pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor do header Link (vazio para começar a navegação por cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lista de cidades retornada com sucesso",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Cidade"
+                            }
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Links para as páginas first, prev, next e last (RFC 8288)"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total de itens da lista"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Filtro, ordenação ou paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "404": {
+                        "description": "Estado não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/feriados": {
+            "get": {
+                "description": "Retorna os feriados nacionais e, conforme os filtros, os estaduais e municipais (fixos e móveis) de um ano",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feriados"
+                ],
+                "summary": "Lista os feriados de um ano",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 2026,
+                        "description": "Ano (padrão: ano corrente)",
+                        "name": "ano",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "3550308",
+                        "description": "Código IBGE da cidade",
+                        "name": "cidade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "SP",
+                        "description": "Sigla ou código IBGE do estado (ignorado se cidade for informada)",
+                        "name": "uf",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Feriado"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/graphql": {
+            "post": {
+                "description": "Executa uma consulta GraphQL com os campos estados, estado(uf|codigo), cidades(uf, filtro) e cidade(codigo|tom), e as relações estado → cidades → estado. Consultas com profundidade acima de 5 ou complexidade estimada acima de 25000 são rejeitadas.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GraphQL"
+                ],
+                "summary": "Consulta GraphQL sobre estados e cidades",
+                "parameters": [
+                    {
+                        "description": "Consulta GraphQL",
+                        "name": "requisicao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.requisicaoGraphQL"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Resposta GraphQL com data e errors",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Consulta inválida ou acima dos limites, com a lista errors",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/versoes": {
+            "get": {
+                "description": "Retorna as edições do conjunto de dados disponíveis (ex: DTB 2020, 2022, 2024) e a versão padrão. Escolha uma versão com o header X-Dataset-Version ou o parâmetro versao.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Versões"
+                ],
+                "summary": "Lista as versões do conjunto de dados",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.respostaVersoes"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/cep/{cep}": {
+            "get": {
+                "description": "Retorna a cidade cuja faixa de CEP contém o CEP informado",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cidades (v2)"
+                ],
+                "summary": "Busca cidade por CEP",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "01310-100",
+                        "description": "CEP com ou sem máscara",
+                        "name": "cep",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "codigo_ibge,nome",
+                        "description": "Campos da cidade, separados por vírgula",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2010-08-01",
+                        "description": "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época",
+                        "name": "data",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.v2Cidade"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/cidades": {
+            "get": {
+                "description": "Retorna as cidades do país, com os mesmos filtros e ordenação de /v2/estados/{uf}/cidades",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cidades (v2)"
+                ],
+                "summary": "Lista as cidades de todos os estados",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "sao jose",
+                        "description": "Parte do nome, sem diferenciar acentos e caixa",
+                        "name": "nome_contem",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "35061",
+                        "description": "Código da microrregião",
+                        "name": "micro_regiao",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "350001",
+                        "description": "Código da região geográfica imediata",
+                        "name": "regiao_imediata",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filtra cidades com (ou sem) código TOM",
+                        "name": "tem_codigo_tom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nome,-codigo_ibge",
+                        "description": "Campos de ordenação separados por vírgula, com - para ordem decrescente",
+                        "name": "ordenar",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "codigo_ibge,nome,estado.sigla",
+                        "description": "Campos de cada cidade, separados por vírgula",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época",
+                        "name": "data",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (a partir de 1)",
+                        "name": "pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 100, máximo 1000)",
+                        "name": "por_pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor de meta.links (vazio para começar a navegação por cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http.envelopeLista"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "dados": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/http.v2Cidade"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/cidades/busca": {
+            "get": {
+                "description": "Retorna as cidades cujo nome oficial ou nome alternativo contém o termo, sem diferenciar acentos e caixa. Correspondências exatas vêm primeiro.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cidades (v2)"
+                ],
+                "summary": "Busca cidades pelo nome",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "S. J. dos Campos",
+                        "description": "Nome ou parte do nome da cidade",
+                        "name": "nome",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "SP",
+                        "description": "Sigla do estado",
+                        "name": "uf",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "codigo_ibge,nome",
+                        "description": "Campos de cada cidade, separados por vírgula",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2010-08-01",
+                        "description": "Data de referência (AAAA-MM-DD); inclui municípios extintos depois dela",
+                        "name": "data",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (a partir de 1)",
+                        "name": "pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 100, máximo 1000)",
+                        "name": "por_pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor de meta.links (vazio para começar a navegação por cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http.envelopeLista"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "dados": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/http.v2Cidade"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/cidades/{codigo_ibge}": {
+            "get": {
+                "description": "Retorna uma cidade pelo código IBGE, com o estado e a Grande Região aninhados",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cidades (v2)"
+                ],
+                "summary": "Busca cidade por código IBGE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "3550308",
+                        "description": "Código IBGE da cidade",
+                        "name": "codigo_ibge",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "codigo_ibge,nome",
+                        "description": "Campos da cidade, separados por vírgula",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2010-08-01",
+                        "description": "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época",
+                        "name": "data",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.v2Cidade"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "410": {
+                        "description": "Município extinto; a resposta lista os sucessores",
+                        "schema": {
+                            "$ref": "#/definitions/http.v2RespostaCidadeExtinta"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/cidades/{codigo_tom}/tom": {
+            "get": {
+                "description": "Retorna uma cidade pelo código TOM",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cidades (v2)"
+                ],
+                "summary": "Busca cidade por código TOM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "7107",
+                        "description": "Código TOM da cidade",
+                        "name": "codigo_tom",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "codigo_ibge,nome",
+                        "description": "Campos da cidade, separados por vírgula",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2010-08-01",
+                        "description": "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época",
+                        "name": "data",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.v2Cidade"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/estados": {
+            "get": {
+                "description": "Retorna os 27 estados, opcionalmente filtrados pelo nome e ordenados, com a Grande Região de cada um",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Estados (v2)"
+                ],
+                "summary": "Lista os estados",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "para",
+                        "description": "Parte do nome, sem diferenciar acentos e caixa",
+                        "name": "nome_contem",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "-codigo_ibge",
+                        "description": "Campos de ordenação separados por vírgula, com - para ordem decrescente (codigo_ibge, nome, sigla)",
+                        "name": "ordenar",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "sigla,regiao.sigla",
+                        "description": "Campos de cada estado, separados por vírgula",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (a partir de 1)",
+                        "name": "pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 100, máximo 1000)",
+                        "name": "por_pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor de meta.links (vazio para começar a navegação por cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http.envelopeLista"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "dados": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/http.v2Estado"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/estados/{uf}": {
+            "get": {
+                "description": "Retorna um estado pela sigla ou pelo código IBGE, com a sua Grande Região",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Estados (v2)"
+                ],
+                "summary": "Busca um estado",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sigla (ex: SP) ou código IBGE (ex: 35) do estado",
+                        "name": "uf",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.v2Estado"
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetro data informado: o histórico cobre apenas os municípios",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/estados/{uf}/cidades": {
+            "get": {
+                "description": "Retorna as cidades do estado, com os mesmos filtros e ordenação de /v1/estados/{uf}/cidades",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cidades (v2)"
+                ],
+                "summary": "Lista as cidades de um estado",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sigla (ex: SP) ou código IBGE (ex: 35) do estado",
+                        "name": "uf",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "sao jose",
+                        "description": "Parte do nome, sem diferenciar acentos e caixa",
+                        "name": "nome_contem",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "35061",
+                        "description": "Código da microrregião",
+                        "name": "micro_regiao",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "350001",
+                        "description": "Código da região geográfica imediata",
+                        "name": "regiao_imediata",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filtra cidades com (ou sem) código TOM",
+                        "name": "tem_codigo_tom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nome,-codigo_ibge",
+                        "description": "Campos de ordenação separados por vírgula, com - para ordem decrescente",
+                        "name": "ordenar",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "codigo_ibge,nome,estado.sigla",
+                        "description": "Campos de cada cidade, separados por vírgula",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época",
+                        "name": "data",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (a partir de 1)",
+                        "name": "pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 100, máximo 1000)",
+                        "name": "por_pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor de meta.links (vazio para começar a navegação por cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http.envelopeLista"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "dados": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/http.v2Cidade"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/regioes": {
+            "get": {
+                "description": "Retorna as cinco Grandes Regiões do Brasil, ordenadas pelo código IBGE",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regiões (v2)"
+                ],
+                "summary": "Lista as Grandes Regiões",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http.envelopeLista"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "dados": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.Regiao"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/regioes/{regiao}": {
+            "get": {
+                "description": "Retorna uma Grande Região pelo código IBGE ou pela sigla",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regiões (v2)"
+                ],
+                "summary": "Busca uma Grande Região",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Código IBGE (ex: 3) ou sigla (ex: SE) da região",
+                        "name": "regiao",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Regiao"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/regioes/{regiao}/estados": {
+            "get": {
+                "description": "Retorna os estados da região, ordenados pelo código IBGE",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regiões (v2)"
+                ],
+                "summary": "Lista os estados de uma Grande Região",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Código IBGE (ex: 3) ou sigla (ex: SE) da região",
+                        "name": "regiao",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (a partir de 1)",
+                        "name": "pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 100, máximo 1000)",
+                        "name": "por_pagina",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor de meta.links (vazio para começar a navegação por cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http.envelopeLista"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "dados": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/http.v2Estado"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.problema"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "domain.Alteracao": {
+            "type": "object",
+            "properties": {
+                "campos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DiferencaCampo"
+                    }
+                },
+                "codigo_ibge": {
+                    "type": "integer"
+                },
+                "entidade": {
+                    "type": "string"
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "tipo": {
+                    "type": "string"
+                },
+                "uf": {
+                    "type": "string"
+                },
+                "versao": {
+                    "type": "integer"
+                }
+            }
+        },
         "domain.Cidade": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "amazonia_legal": {
+                    "description": "Classificações territoriais: nil quando a lista oficial completa do recorte não foi carregada",
+                    "type": "boolean"
+                },
+                "codigo_ibge": {
+                    "type": "integer"
+                },
+                "codigo_tom": {
+                    "type": "string"
+                },
+                "estado_codigo_ibge": {
+                    "type": "integer"
+                },
+                "estado_nome": {
+                    "type": "string"
+                },
+                "estado_sigla": {
+                    "type": "string"
+                },
+                "faixa_fronteira": {
+                    "type": "boolean"
+                },
+                "feriados": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.RegraFeriado"
+                    }
+                },
+                "fuso_horario": {
+                    "type": "string"
+                },
+                "gentilico": {
+                    "type": "string"
+                },
+                "historico": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.EventoMunicipio"
+                    }
+                },
+                "matopiba": {
+                    "type": "boolean"
+                },
+                "micro_regiao": {
+                    "type": "string"
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "paises_fronteira": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "regiao_imediata": {
+                    "type": "string"
+                },
+                "semiarido": {
+                    "type": "boolean"
+                },
+                "zona_costeira": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "domain.DiasUteis": {
+            "type": "object",
+            "properties": {
+                "codigo_ibge": {
+                    "type": "integer"
+                },
+                "data_final": {
+                    "type": "string"
+                },
+                "dias": {
+                    "type": "integer"
+                },
+                "feriados_considerados": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Feriado"
+                    }
+                },
+                "inicio": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.DiferencaCampo": {
+            "type": "object",
+            "properties": {
+                "antes": {
+                    "type": "string"
+                },
+                "campo": {
+                    "type": "string"
+                },
+                "depois": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Estado": {
             "type": "object",
             "properties": {
                 "codigo_ibge": {
                     "type": "integer"
                 },
-                "codigo_tom": {
+                "feriados": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.RegraFeriado"
+                    }
+                },
+                "fuso_horario": {
                     "type": "string"
                 },
-                "estado_codigo_ibge": {
+                "nome": {
+                    "type": "string"
+                },
+                "sigla": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.EventoMunicipio": {
+            "type": "object",
+            "properties": {
+                "codigo_ibge": {
                     "type": "integer"
                 },
-                "estado_nome": {
+                "data": {
+                    "type": "string"
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "nome_anterior": {
+                    "type": "string"
+                },
+                "origem": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "sucessores": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "tipo": {
+                    "type": "string"
+                },
+                "uf": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.FeedAlteracoes": {
+            "type": "object",
+            "properties": {
+                "alteracoes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Alteracao"
+                    }
+                },
+                "desde": {
+                    "type": "integer"
+                },
+                "versao_atual": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.Feriado": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "string"
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "tipo": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.HoraLocal": {
+            "type": "object",
+            "properties": {
+                "abreviacao": {
                     "type": "string"
                 },
+                "codigo_ibge": {
+                    "type": "integer"
+                },
                 "estado_sigla": {
                     "type": "string"
                 },
-                "micro_regiao": {
+                "fuso_horario": {
+                    "type": "string"
+                },
+                "hora_local": {
                     "type": "string"
                 },
+                "horario_verao": {
+                    "type": "boolean"
+                },
                 "nome": {
                     "type": "string"
                 },
-                "regiao_imediata": {
+                "utc_offset": {
                     "type": "string"
                 }
             }
         },
-        "domain.Estado": {
+        "domain.Regiao": {
             "type": "object",
             "properties": {
                 "codigo_ibge": {
@@ -289,6 +2250,405 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "domain.RegraFeriado": {
+            "type": "object",
+            "properties": {
+                "desde_ano": {
+                    "type": "integer"
+                },
+                "dia": {
+                    "type": "integer"
+                },
+                "dias_apos_pascoa": {
+                    "type": "integer"
+                },
+                "mes": {
+                    "type": "integer"
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "tipo": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ValidacaoEndereco": {
+            "type": "object",
+            "properties": {
+                "cep": {
+                    "type": "string"
+                },
+                "cidade_confere": {
+                    "type": "boolean"
+                },
+                "cidade_esperada": {
+                    "$ref": "#/definitions/domain.Cidade"
+                },
+                "estado_esperado": {
+                    "$ref": "#/definitions/domain.Estado"
+                },
+                "inconsistencias": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "uf_confere": {
+                    "type": "boolean"
+                },
+                "valido": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "http.downloadDisponivel": {
+            "type": "object",
+            "properties": {
+                "arquivo": {
+                    "type": "string"
+                },
+                "formato": {
+                    "type": "string"
+                },
+                "sha256": {
+                    "type": "string"
+                },
+                "tamanho": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.envelopeLista": {
+            "type": "object",
+            "properties": {
+                "dados": {},
+                "meta": {
+                    "$ref": "#/definitions/http.metadadosLista"
+                }
+            }
+        },
+        "http.linksLista": {
+            "type": "object",
+            "properties": {
+                "first": {
+                    "type": "string",
+                    "example": "/api/v2/estados/SP/cidades?pagina=1\u0026por_pagina=100\u0026versao=2022"
+                },
+                "last": {
+                    "type": "string",
+                    "example": "/api/v2/estados/SP/cidades?pagina=7\u0026por_pagina=100\u0026versao=2022"
+                },
+                "next": {
+                    "type": "string",
+                    "example": "/api/v2/estados/SP/cidades?pagina=2\u0026por_pagina=100\u0026versao=2022"
+                },
+                "prev": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.metadadosLista": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "$ref": "#/definitions/http.linksLista"
+                },
+                "pagina": {
+                    "description": "null sem paginação ou na navegação por cursor",
+                    "type": "integer",
+                    "example": 1
+                },
+                "por_pagina": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 645
+                },
+                "versao": {
+                    "type": "string",
+                    "example": "2022"
+                }
+            }
+        },
+        "http.problema": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "estado_sigla_nao_encontrado"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "estado com a sigla XX não encontrado"
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/api/v1/estados/XX"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "servidor/abc123-000001"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Recurso não encontrado"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "urn:ibge-service:problema:nao-encontrado"
+                }
+            }
+        },
+        "http.requisicaoGraphQL": {
+            "type": "object",
+            "properties": {
+                "operationName": {
+                    "type": "string"
+                },
+                "query": {
+                    "type": "string"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "http.respostaCidadeExtinta": {
+            "type": "object",
+            "properties": {
+                "cidade": {
+                    "$ref": "#/definitions/domain.Cidade"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "estado_sigla_nao_encontrado"
+                },
+                "data_extincao": {
+                    "type": "string"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "estado com a sigla XX não encontrado"
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/api/v1/estados/XX"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "servidor/abc123-000001"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "sucessores": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Cidade"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Recurso não encontrado"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "urn:ibge-service:problema:nao-encontrado"
+                }
+            }
+        },
+        "http.respostaDownloads": {
+            "type": "object",
+            "properties": {
+                "downloads": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.downloadDisponivel"
+                    }
+                },
+                "versao_dataset": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.respostaVersoes": {
+            "type": "object",
+            "properties": {
+                "padrao": {
+                    "type": "string"
+                },
+                "versoes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "http.v2Cidade": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "amazonia_legal": {
+                    "type": "boolean"
+                },
+                "codigo_ibge": {
+                    "type": "integer",
+                    "example": 3550308
+                },
+                "codigo_tom": {
+                    "type": "integer",
+                    "example": 7107
+                },
+                "estado": {
+                    "$ref": "#/definitions/http.v2EstadoResumo"
+                },
+                "faixa_fronteira": {
+                    "type": "boolean"
+                },
+                "fuso_horario": {
+                    "type": "string",
+                    "example": "America/Sao_Paulo"
+                },
+                "gentilico": {
+                    "type": "string",
+                    "example": "paulistano"
+                },
+                "matopiba": {
+                    "type": "boolean"
+                },
+                "micro_regiao": {
+                    "type": "integer",
+                    "example": 35061
+                },
+                "nome": {
+                    "type": "string",
+                    "example": "São Paulo"
+                },
+                "paises_fronteira": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "regiao": {
+                    "$ref": "#/definitions/domain.Regiao"
+                },
+                "regiao_imediata": {
+                    "type": "integer",
+                    "example": 350001
+                },
+                "semiarido": {
+                    "type": "boolean"
+                },
+                "zona_costeira": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "http.v2Estado": {
+            "type": "object",
+            "properties": {
+                "codigo_ibge": {
+                    "type": "integer",
+                    "example": 35
+                },
+                "fuso_horario": {
+                    "type": "string",
+                    "example": "America/Sao_Paulo"
+                },
+                "nome": {
+                    "type": "string",
+                    "example": "São Paulo"
+                },
+                "regiao": {
+                    "$ref": "#/definitions/domain.Regiao"
+                },
+                "sigla": {
+                    "type": "string",
+                    "example": "SP"
+                }
+            }
+        },
+        "http.v2EstadoResumo": {
+            "type": "object",
+            "properties": {
+                "codigo_ibge": {
+                    "type": "integer",
+                    "example": 35
+                },
+                "nome": {
+                    "type": "string",
+                    "example": "São Paulo"
+                },
+                "sigla": {
+                    "type": "string",
+                    "example": "SP"
+                }
+            }
+        },
+        "http.v2RespostaCidadeExtinta": {
+            "type": "object",
+            "properties": {
+                "cidade": {
+                    "$ref": "#/definitions/http.v2Cidade"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "estado_sigla_nao_encontrado"
+                },
+                "data_extincao": {
+                    "type": "string"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "estado com a sigla XX não encontrado"
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/api/v1/estados/XX"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "servidor/abc123-000001"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "sucessores": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.v2Cidade"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Recurso não encontrado"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "urn:ibge-service:problema:nao-encontrado"
+                }
+            }
         }
     }
 }`
@@ -297,10 +2657,10 @@ const docTemplate = `{
 var SwaggerInfo = &swag.Spec{
 	Version:          "1.0",
 	Host:             "",
-	BasePath:         "/api/v1",
+	BasePath:         "/api",
 	Schemes:          []string{},
 	Title:            "API de Dados do IBGE",
-	Description:      "Este é um microserviço para consulta de estados e cidades do Brasil, baseado nos dados do IBGE.",
+	Description:      "Este é um microserviço para consulta de estados e cidades do Brasil, baseado nos dados do IBGE.\nA edição do conjunto de dados pode ser escolhida com o header X-Dataset-Version ou o parâmetro versao.\nAs respostas podem ser em JSON, CSV, XML, YAML, MessagePack, CBOR ou Protobuf, pelo header Accept ou pelo parâmetro formato.\nO parâmetro fields seleciona os campos dos recursos e, na v1, expand=estado,regiao aninha o estado e a Grande Região.\nA v2 tem um contrato estável: estado e região aninhados, códigos numéricos, campos ausentes como null e listas em envelopes com metadados.\nAs respostas levam ETag e Last-Modified e atendem If-None-Match e If-Modified-Since com 304.",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 }
@@ -0,0 +1,241 @@
+// Package grpc expõe o caso de uso do IBGE como o serviço gRPC ibge.v1.IBGEService,
+// com reflexão e health checking, ao lado do roteador HTTP.
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/brauliohms/ibge-service/internal/adapter/protobuf"
+	"github.com/brauliohms/ibge-service/internal/domain"
+	"github.com/brauliohms/ibge-service/internal/usecase"
+	ibgev1 "github.com/brauliohms/ibge-service/pkg/pb/ibge/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// DominioErros identifica o serviço no detalhe ErrorInfo dos erros.
+const DominioErros = "ibge-service"
+
+// MetadadoVersaoDataset é o metadado usado para escolher e informar a edição do conjunto de
+// dados, equivalente ao header X-Dataset-Version da API HTTP.
+const MetadadoVersaoDataset = "x-dataset-version"
+
+// IBGEServer implementa ibge.v1.IBGEService sobre o registro de versões do caso de uso.
+type IBGEServer struct {
+	ibgev1.UnimplementedIBGEServiceServer
+	versoes *usecase.RegistroVersoes
+}
+
+// NewIBGEServer cria o serviço a partir do registro de versões compartilhado com a API HTTP.
+func NewIBGEServer(versoes *usecase.RegistroVersoes) *IBGEServer {
+	return &IBGEServer{versoes: versoes}
+}
+
+// NewServer cria o servidor gRPC com o IBGEService, o serviço de health checking
+// (grpc.health.v1.Health) e a reflexão, usada por ferramentas como grpcurl.
+func NewServer(versoes *usecase.RegistroVersoes, opts ...grpc.ServerOption) *grpc.Server {
+	s := grpc.NewServer(opts...)
+	ibgev1.RegisterIBGEServiceServer(s, NewIBGEServer(versoes))
+
+	saude := health.NewServer()
+	saude.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	saude.SetServingStatus(ibgev1.IBGEService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(s, saude)
+
+	reflection.Register(s)
+	return s
+}
+
+// statusDoErro traduz os erros do domínio nos códigos de status gRPC, com a mensagem no idioma do
+// metadado accept-language e o código da mensagem no detalhe ErrorInfo (campo reason), estável entre
+// idiomas. Municípios extintos respondem FailedPrecondition, com o detalhe CidadeExtinta trazendo os
+// sucessores, como a resposta 410 da API HTTP. Erros sem classificação no domínio são falhas internas.
+func statusDoErro(ctx context.Context, err error) error {
+	var codigo codes.Code
+	switch {
+	case errors.Is(err, domain.ErrInvalidCode), errors.Is(err, domain.ErrInvalidArgument):
+		codigo = codes.InvalidArgument
+	case errors.Is(err, domain.ErrGone):
+		codigo = codes.FailedPrecondition
+	case errors.Is(err, domain.ErrNotFound):
+		codigo = codes.NotFound
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	var traduzivel domain.ErroTraduzivel
+	if !errors.As(err, &traduzivel) {
+		return status.Error(codigo, err.Error())
+	}
+	detalhes := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason: string(traduzivel.CodigoMensagem()),
+		Domain: DominioErros,
+	}}
+	var extinta *domain.CidadeExtintaError
+	if errors.As(err, &extinta) {
+		detalhes = append(detalhes, &ibgev1.CidadeExtinta{
+			DataExtincao: extinta.Data,
+			Cidade:       protobuf.ParaCidade(extinta.Cidade),
+			Sucessores:   protobuf.ParaCidades(extinta.Sucessores),
+		})
+	}
+
+	st := status.New(codigo, traduzivel.Mensagem(idiomaDoContexto(ctx)))
+	if comDetalhes, detalhesErr := st.WithDetails(detalhes...); detalhesErr == nil {
+		st = comDetalhes
+	}
+	return st.Err()
+}
+
+// idiomaDoContexto escolhe o idioma das mensagens pelo metadado accept-language, equivalente ao
+// header Accept-Language da API HTTP.
+func idiomaDoContexto(ctx context.Context) domain.Idioma {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return domain.IdiomaPreferido(strings.Join(md.Get("accept-language"), ","))
+}
+
+// casoDeUso resolve a edição pedida no metadado x-dataset-version (ou a padrão) e a informa
+// de volta no header da resposta.
+func (s *IBGEServer) casoDeUso(ctx context.Context) (*usecase.IBGEUseCase, error) {
+	var versao string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if valores := md.Get(MetadadoVersaoDataset); len(valores) > 0 {
+			versao = valores[0]
+		}
+	}
+
+	uc, versao, err := s.versoes.Versao(versao)
+	if err != nil {
+		return nil, statusDoErro(ctx, err)
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(MetadadoVersaoDataset, versao))
+	return uc, nil
+}
+
+// ListEstados retorna todos os estados.
+func (s *IBGEServer) ListEstados(ctx context.Context, _ *ibgev1.ListEstadosRequest) (*ibgev1.ListEstadosResponse, error) {
+	uc, err := s.casoDeUso(ctx)
+	if err != nil {
+		return nil, err
+	}
+	estados, err := uc.GetAllEstados()
+	if err != nil {
+		return nil, statusDoErro(ctx, err)
+	}
+
+	return &ibgev1.ListEstadosResponse{Estados: protobuf.ParaEstados(estados)}, nil
+}
+
+// GetEstado busca um estado pela sigla ou pelo código IBGE.
+func (s *IBGEServer) GetEstado(ctx context.Context, req *ibgev1.GetEstadoRequest) (*ibgev1.Estado, error) {
+	uc, err := s.casoDeUso(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var estado *domain.Estado
+	switch id := req.GetIdentificador().(type) {
+	case *ibgev1.GetEstadoRequest_Uf:
+		estado, err = uc.GetEstadoByUF(strings.ToUpper(id.Uf))
+	case *ibgev1.GetEstadoRequest_CodigoIbge:
+		estado, err = uc.GetEstadoByCodigoIbge(strconv.Itoa(int(id.CodigoIbge)))
+	default:
+		return nil, statusDoErro(ctx, domain.ArgumentoInvalido(domain.MsgGRPCEstadoSemIdentificador))
+	}
+	if err != nil {
+		return nil, statusDoErro(ctx, err)
+	}
+	return protobuf.ParaEstado(*estado), nil
+}
+
+// GetCidade busca uma cidade pelo código IBGE ou pelo código TOM.
+func (s *IBGEServer) GetCidade(ctx context.Context, req *ibgev1.GetCidadeRequest) (*ibgev1.Cidade, error) {
+	uc, err := s.casoDeUso(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var cidade *domain.Cidade
+	switch id := req.GetIdentificador().(type) {
+	case *ibgev1.GetCidadeRequest_CodigoIbge:
+		cidade, err = uc.GetCidadeByCodigo(strconv.Itoa(int(id.CodigoIbge)))
+	case *ibgev1.GetCidadeRequest_CodigoTom:
+		cidade, err = uc.GetCidadeByCodigoTOM(id.CodigoTom)
+	default:
+		return nil, statusDoErro(ctx, domain.ArgumentoInvalido(domain.MsgGRPCCidadeSemIdentificador))
+	}
+	if err != nil {
+		return nil, statusDoErro(ctx, err)
+	}
+	return protobuf.ParaCidade(*cidade), nil
+}
+
+// GetCidadeByCEP busca a cidade cuja faixa de CEP contém o CEP informado.
+func (s *IBGEServer) GetCidadeByCEP(ctx context.Context, req *ibgev1.GetCidadeByCEPRequest) (*ibgev1.Cidade, error) {
+	uc, err := s.casoDeUso(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cidade, err := uc.GetCidadeByCEP(req.GetCep())
+	if err != nil {
+		return nil, statusDoErro(ctx, err)
+	}
+	return protobuf.ParaCidade(*cidade), nil
+}
+
+// BuscarCidades busca cidades pelo nome oficial, histórico ou alternativo.
+func (s *IBGEServer) BuscarCidades(ctx context.Context, req *ibgev1.BuscarCidadesRequest) (*ibgev1.BuscarCidadesResponse, error) {
+	uc, err := s.casoDeUso(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetNome()) == "" {
+		return nil, statusDoErro(ctx, domain.ArgumentoInvalido(domain.MsgGRPCNomeObrigatorio))
+	}
+
+	cidades, err := uc.BuscarCidades(req.GetNome(), strings.ToUpper(req.GetUf()))
+	if err != nil {
+		return nil, statusDoErro(ctx, err)
+	}
+
+	return &ibgev1.BuscarCidadesResponse{Cidades: protobuf.ParaCidades(cidades)}, nil
+}
+
+// ListCidades envia as cidades de um estado, ou de todo o país se a UF for vazia, uma a uma.
+func (s *IBGEServer) ListCidades(req *ibgev1.ListCidadesRequest, stream grpc.ServerStreamingServer[ibgev1.Cidade]) error {
+	uc, err := s.casoDeUso(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	var cidades []domain.Cidade
+	if uf := strings.ToUpper(req.GetUf()); uf != "" {
+		cidades, err = uc.GetCidadesByEstadoUF(uf)
+		if err != nil {
+			return statusDoErro(stream.Context(), err)
+		}
+	} else {
+		cidades, err = uc.GetAllCidades()
+		if err != nil {
+			return statusDoErro(stream.Context(), err)
+		}
+	}
+
+	for _, c := range cidades {
+		if err := stream.Send(protobuf.ParaCidade(c)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
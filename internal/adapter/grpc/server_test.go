@@ -0,0 +1,449 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+	"github.com/brauliohms/ibge-service/internal/usecase"
+	ibgev1 "github.com/brauliohms/ibge-service/pkg/pb/ibge/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// mockIBGERepository é um mock do repositório com dois estados e três cidades.
+type mockIBGERepository struct {
+	estados []domain.Estado
+}
+
+func novoMock() *mockIBGERepository {
+	return &mockIBGERepository{estados: []domain.Estado{
+		{CodigoIBGE: 35, Sigla: "SP", Nome: "São Paulo", FusoHorario: "America/Sao_Paulo"},
+		{CodigoIBGE: 33, Sigla: "RJ", Nome: "Rio de Janeiro", FusoHorario: "America/Sao_Paulo"},
+	}}
+}
+
+var sim, nao = true, false
+
+var cidadesMock = []domain.Cidade{
+	{CodigoIBGE: 3550308, Nome: "São Paulo", CodigoTOM: "7107", EstadoCodigoIBGE: 35, EstadoSigla: "SP", Aliases: []string{"Sampa"}, ZonaCosteira: &nao},
+	{CodigoIBGE: 3509502, Nome: "Campinas", CodigoTOM: "6291", EstadoCodigoIBGE: 35, EstadoSigla: "SP", ZonaCosteira: &nao},
+	{CodigoIBGE: 3304557, Nome: "Rio de Janeiro", CodigoTOM: "6001", EstadoCodigoIBGE: 33, EstadoSigla: "RJ", ZonaCosteira: &sim},
+}
+
+func (m *mockIBGERepository) FindAllEstados() ([]domain.Estado, error) {
+	return m.estados, nil
+}
+
+func (m *mockIBGERepository) FindEstadoByUF(uf string) (*domain.Estado, error) {
+	for _, e := range m.estados {
+		if e.Sigla == uf {
+			return &e, nil
+		}
+	}
+	return nil, domain.NaoEncontrado(domain.MsgEstadoSiglaNaoEncontrado, uf)
+}
+
+func (m *mockIBGERepository) FindEstadoByCodigoIbge(codigo_ibge string) (*domain.Estado, error) {
+	for _, e := range m.estados {
+		if fmt.Sprint(e.CodigoIBGE) == codigo_ibge {
+			return &e, nil
+		}
+	}
+	return nil, domain.NaoEncontrado(domain.MsgEstadoCodigoNaoEncontrado, codigo_ibge)
+}
+
+func (m *mockIBGERepository) FindCidadesByEstadoUF(uf string) ([]domain.Cidade, error) {
+	estado, err := m.FindEstadoByUF(uf)
+	if err != nil {
+		return nil, err
+	}
+	return m.FindCidadesByEstadoCodigoIbge(fmt.Sprint(estado.CodigoIBGE))
+}
+
+func (m *mockIBGERepository) FindCidadesByEstadoCodigoIbge(codigo_ibge string) ([]domain.Cidade, error) {
+	var cidades []domain.Cidade
+	for _, c := range cidadesMock {
+		if fmt.Sprint(c.EstadoCodigoIBGE) == codigo_ibge {
+			cidades = append(cidades, c)
+		}
+	}
+	return cidades, nil
+}
+
+// vilaExtinta é um município extinto, cujo território foi absorvido por Campinas.
+var vilaExtinta = domain.Cidade{CodigoIBGE: 3500000, Nome: "Vila Extinta", EstadoCodigoIBGE: 35, EstadoSigla: "SP", Historico: []domain.EventoMunicipio{
+	{CodigoIBGE: 3500000, Tipo: domain.EventoExtincao, Data: "2005-01-01", Sucessores: []int{3509502}},
+}}
+
+func (m *mockIBGERepository) FindCidadeByCodigo(codigo string) (*domain.Cidade, error) {
+	for _, c := range cidadesMock {
+		if fmt.Sprint(c.CodigoIBGE) == codigo {
+			return &c, nil
+		}
+	}
+	if codigo == fmt.Sprint(vilaExtinta.CodigoIBGE) {
+		return nil, &domain.CidadeExtintaError{Cidade: vilaExtinta, Data: "2005-01-01", Sucessores: cidadesMock[1:2]}
+	}
+	return nil, domain.NaoEncontrado(domain.MsgCidadeNaoEncontrada, codigo)
+}
+
+func (m *mockIBGERepository) FindCidadeByCodigoTOM(codigo_tom string) (*domain.Cidade, error) {
+	for _, c := range cidadesMock {
+		if c.CodigoTOM == codigo_tom {
+			return &c, nil
+		}
+	}
+	return nil, domain.NaoEncontrado(domain.MsgCidadeTOMNaoEncontrada, codigo_tom)
+}
+
+func (m *mockIBGERepository) FindCidadeByCEP(cep string) (*domain.Cidade, error) {
+	if strings.HasPrefix(cep, "01") {
+		return m.FindCidadeByCodigo("3550308")
+	}
+	return nil, domain.NaoEncontrado(domain.MsgCidadeCEPNaoEncontrada, cep)
+}
+
+func (m *mockIBGERepository) FindEstadoByCEP(cep string) (*domain.Estado, error) {
+	return nil, domain.NaoEncontrado(domain.MsgEstadoCEPNaoEncontrado, cep)
+}
+
+func (m *mockIBGERepository) FindCidadesByNome(nome string, uf string) ([]domain.Cidade, error) {
+	var cidades []domain.Cidade
+	for _, c := range cidadesMock {
+		if c.ConhecidaComo(nome) && (uf == "" || c.EstadoSigla == uf) {
+			cidades = append(cidades, c)
+		}
+	}
+	return cidades, nil
+}
+
+func (m *mockIBGERepository) FindEstados(consulta domain.ConsultaEstados) ([]domain.Estado, error) {
+	return consulta.Filtrar(m.estados), nil
+}
+
+func (m *mockIBGERepository) FindCidades(consulta domain.ConsultaCidades) ([]domain.Cidade, error) {
+	return consulta.Filtrar(cidadesMock), nil
+}
+
+func (m *mockIBGERepository) FindAllFaixasCEP() ([]domain.FaixaCEP, error) {
+	return nil, nil
+}
+
+func (m *mockIBGERepository) FindCidadesExtintas() ([]domain.Cidade, error) {
+	return nil, nil
+}
+
+func (m *mockIBGERepository) FindAlteracoesDesde(versao int) ([]domain.Alteracao, error) {
+	return nil, nil
+}
+
+func (m *mockIBGERepository) FindVersaoDataset() (int, error) {
+	return 1, nil
+}
+
+func (m *mockIBGERepository) FindAtualizadoEm() (time.Time, error) {
+	return time.Time{}, nil
+}
+
+// novoCliente sobe o servidor em um listener bufconn, em memória, e retorna uma conexão com ele.
+// A versão 2020 do conjunto de dados tem apenas o estado de São Paulo.
+func novoCliente(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+
+	versoes := usecase.NewRegistroVersoes()
+	versoes.Registrar("2024", usecase.NewIBGEUseCase(novoMock()))
+	antigo := novoMock()
+	antigo.estados = antigo.estados[:1]
+	versoes.Registrar("2020", usecase.NewIBGEUseCase(antigo))
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := NewServer(versoes)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Erro ao conectar: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestIBGEServer(t *testing.T) {
+	conn := novoCliente(t)
+	cliente := ibgev1.NewIBGEServiceClient(conn)
+	ctx := context.Background()
+
+	t.Run("ListEstados - deve retornar os estados com a região", func(t *testing.T) {
+		resposta, err := cliente.ListEstados(ctx, &ibgev1.ListEstadosRequest{})
+		if err != nil {
+			t.Fatalf("Esperava não ter erro, mas recebi: %v", err)
+		}
+		if len(resposta.GetEstados()) != 2 || resposta.GetEstados()[0].GetRegiao().GetSigla() != "SE" {
+			t.Errorf("Estados incorretos: %v", resposta.GetEstados())
+		}
+	})
+
+	t.Run("GetEstado - deve buscar por sigla ou código", func(t *testing.T) {
+		testCases := []struct {
+			name         string
+			req          *ibgev1.GetEstadoRequest
+			expectedCode codes.Code
+			expectedUF   string
+		}{
+			{"sigla", &ibgev1.GetEstadoRequest{Identificador: &ibgev1.GetEstadoRequest_Uf{Uf: "rj"}}, codes.OK, "RJ"},
+			{"código", &ibgev1.GetEstadoRequest{Identificador: &ibgev1.GetEstadoRequest_CodigoIbge{CodigoIbge: 35}}, codes.OK, "SP"},
+			{"inexistente", &ibgev1.GetEstadoRequest{Identificador: &ibgev1.GetEstadoRequest_Uf{Uf: "XX"}}, codes.NotFound, ""},
+			{"sem identificador", &ibgev1.GetEstadoRequest{}, codes.InvalidArgument, ""},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				estado, err := cliente.GetEstado(ctx, tc.req)
+				if code := status.Code(err); code != tc.expectedCode {
+					t.Fatalf("Código incorreto: got %v want %v (%v)", code, tc.expectedCode, err)
+				}
+				if tc.expectedUF != "" && estado.GetSigla() != tc.expectedUF {
+					t.Errorf("UF incorreta: got %s want %s", estado.GetSigla(), tc.expectedUF)
+				}
+			})
+		}
+	})
+
+	t.Run("GetCidade - deve buscar pelo código TOM", func(t *testing.T) {
+		cidade, err := cliente.GetCidade(ctx, &ibgev1.GetCidadeRequest{Identificador: &ibgev1.GetCidadeRequest_CodigoTom{CodigoTom: "6291"}})
+		if err != nil {
+			t.Fatalf("Esperava não ter erro, mas recebi: %v", err)
+		}
+		if cidade.GetCodigoIbge() != 3509502 || cidade.GetEstadoSigla() != "SP" {
+			t.Errorf("Cidade incorreta: %v", cidade)
+		}
+	})
+
+	t.Run("GetCidade - deve informar os sucessores de um município extinto", func(t *testing.T) {
+		_, err := cliente.GetCidade(ctx, &ibgev1.GetCidadeRequest{Identificador: &ibgev1.GetCidadeRequest_CodigoIbge{CodigoIbge: 3500000}})
+
+		st := status.Convert(err)
+		if st.Code() != codes.FailedPrecondition {
+			t.Fatalf("Código incorreto: got %v want %v", st.Code(), codes.FailedPrecondition)
+		}
+		var extinta *ibgev1.CidadeExtinta
+		for _, detalhe := range st.Details() {
+			if d, ok := detalhe.(*ibgev1.CidadeExtinta); ok {
+				extinta = d
+			}
+		}
+		if extinta == nil {
+			t.Fatalf("Esperava o detalhe CidadeExtinta, mas recebi: %v", st.Details())
+		}
+		if extinta.GetDataExtincao() != "2005-01-01" || extinta.GetCidade().GetCodigoIbge() != 3500000 ||
+			len(extinta.GetSucessores()) != 1 || extinta.GetSucessores()[0].GetCodigoIbge() != 3509502 {
+			t.Errorf("Detalhe CidadeExtinta incorreto: %v", extinta)
+		}
+	})
+
+	t.Run("GetCidadeByCEP - deve validar o CEP", func(t *testing.T) {
+		cidade, err := cliente.GetCidadeByCEP(ctx, &ibgev1.GetCidadeByCEPRequest{Cep: "01310-100"})
+		if err != nil || cidade.GetCodigoIbge() != 3550308 {
+			t.Errorf("Esperava São Paulo, mas recebi: %v (%v)", cidade, err)
+		}
+
+		_, err = cliente.GetCidadeByCEP(ctx, &ibgev1.GetCidadeByCEPRequest{Cep: "123"})
+		if code := status.Code(err); code != codes.InvalidArgument {
+			t.Errorf("Código incorreto: got %v want %v", code, codes.InvalidArgument)
+		}
+	})
+
+	t.Run("BuscarCidades - deve encontrar por nome alternativo", func(t *testing.T) {
+		resposta, err := cliente.BuscarCidades(ctx, &ibgev1.BuscarCidadesRequest{Nome: "sampa"})
+		if err != nil {
+			t.Fatalf("Esperava não ter erro, mas recebi: %v", err)
+		}
+		if len(resposta.GetCidades()) != 1 || resposta.GetCidades()[0].GetNome() != "São Paulo" {
+			t.Errorf("Cidades incorretas: %v", resposta.GetCidades())
+		}
+	})
+
+	t.Run("ListCidades - deve enviar as cidades em stream", func(t *testing.T) {
+		testCases := []struct {
+			uf       string
+			expected []int32
+		}{
+			{"sp", []int32{3550308, 3509502}},
+			{"", []int32{3304557, 3509502, 3550308}},
+		}
+
+		for _, tc := range testCases {
+			t.Run("UF_"+tc.uf, func(t *testing.T) {
+				stream, err := cliente.ListCidades(ctx, &ibgev1.ListCidadesRequest{Uf: tc.uf})
+				if err != nil {
+					t.Fatalf("Esperava não ter erro, mas recebi: %v", err)
+				}
+				var codigos []int32
+				for {
+					cidade, err := stream.Recv()
+					if err == io.EOF {
+						break
+					}
+					if err != nil {
+						t.Fatalf("Erro ao receber cidade: %v", err)
+					}
+					codigos = append(codigos, cidade.GetCodigoIbge())
+				}
+				if tc.uf == "" && !sort.SliceIsSorted(codigos, func(i, j int) bool { return codigos[i] < codigos[j] }) {
+					t.Errorf("Cidades fora de ordem: %v", codigos)
+				}
+				if fmt.Sprint(codigos) != fmt.Sprint(tc.expected) {
+					t.Errorf("Cidades incorretas: got %v want %v", codigos, tc.expected)
+				}
+			})
+		}
+
+		stream, _ := cliente.ListCidades(ctx, &ibgev1.ListCidadesRequest{Uf: "XX"})
+		if _, err := stream.Recv(); status.Code(err) != codes.NotFound {
+			t.Errorf("Esperava NotFound para UF inexistente, mas recebi: %v", err)
+		}
+	})
+
+	t.Run("x-dataset-version - deve servir a versão escolhida", func(t *testing.T) {
+		var header metadata.MD
+		ctx2020 := metadata.AppendToOutgoingContext(ctx, MetadadoVersaoDataset, "2020")
+		resposta, err := cliente.ListEstados(ctx2020, &ibgev1.ListEstadosRequest{}, grpc.Header(&header))
+		if err != nil {
+			t.Fatalf("Esperava não ter erro, mas recebi: %v", err)
+		}
+		if len(resposta.GetEstados()) != 1 {
+			t.Errorf("Esperava 1 estado na versão 2020, mas recebi %d", len(resposta.GetEstados()))
+		}
+		if v := header.Get(MetadadoVersaoDataset); len(v) != 1 || v[0] != "2020" {
+			t.Errorf("Versão informada incorreta: %v", v)
+		}
+
+		ctxInvalido := metadata.AppendToOutgoingContext(ctx, MetadadoVersaoDataset, "1999")
+		if _, err := cliente.ListEstados(ctxInvalido, &ibgev1.ListEstadosRequest{}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Esperava InvalidArgument para versão inexistente, mas recebi: %v", err)
+		}
+	})
+
+	t.Run("accept-language - deve traduzir a mensagem e manter o código do erro", func(t *testing.T) {
+		testCases := []struct {
+			idioma          string
+			expectedMessage string
+		}{
+			{"", "estado com a sigla XX não encontrado"},
+			{"en-US,en;q=0.9", "state with abbreviation XX not found"},
+			{"fr", "estado com a sigla XX não encontrado"},
+		}
+
+		for _, tc := range testCases {
+			t.Run("idioma_"+tc.idioma, func(t *testing.T) {
+				ctxIdioma := ctx
+				if tc.idioma != "" {
+					ctxIdioma = metadata.AppendToOutgoingContext(ctx, "accept-language", tc.idioma)
+				}
+				_, err := cliente.GetEstado(ctxIdioma, &ibgev1.GetEstadoRequest{Identificador: &ibgev1.GetEstadoRequest_Uf{Uf: "XX"}})
+
+				st := status.Convert(err)
+				if st.Code() != codes.NotFound || st.Message() != tc.expectedMessage {
+					t.Errorf("Erro incorreto: got %v %q want %q", st.Code(), st.Message(), tc.expectedMessage)
+				}
+				if len(st.Details()) != 1 {
+					t.Fatalf("Esperava o detalhe ErrorInfo, mas recebi: %v", st.Details())
+				}
+				info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+				if !ok || info.GetReason() != string(domain.MsgEstadoSiglaNaoEncontrado) || info.GetDomain() != DominioErros {
+					t.Errorf("ErrorInfo incorreto: %v", st.Details()[0])
+				}
+			})
+		}
+	})
+
+	t.Run("argumentos ausentes - devem vir com o código da mensagem", func(t *testing.T) {
+		ctxIdioma := metadata.AppendToOutgoingContext(ctx, "accept-language", "en")
+		testCases := []struct {
+			name         string
+			chamar       func() error
+			expectedCode domain.CodigoMensagem
+			expectedMsg  string
+		}{
+			{"GetEstado", func() error {
+				_, err := cliente.GetEstado(ctxIdioma, &ibgev1.GetEstadoRequest{})
+				return err
+			}, domain.MsgGRPCEstadoSemIdentificador, "provide uf or codigo_ibge"},
+			{"GetCidade", func() error {
+				_, err := cliente.GetCidade(ctxIdioma, &ibgev1.GetCidadeRequest{})
+				return err
+			}, domain.MsgGRPCCidadeSemIdentificador, "provide codigo_ibge or codigo_tom"},
+			{"BuscarCidades", func() error {
+				_, err := cliente.BuscarCidades(ctxIdioma, &ibgev1.BuscarCidadesRequest{Nome: " "})
+				return err
+			}, domain.MsgGRPCNomeObrigatorio, "the name is required"},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				st := status.Convert(tc.chamar())
+				if st.Code() != codes.InvalidArgument || st.Message() != tc.expectedMsg {
+					t.Errorf("Erro incorreto: got %v %q want %q", st.Code(), st.Message(), tc.expectedMsg)
+				}
+				if len(st.Details()) != 1 {
+					t.Fatalf("Esperava o detalhe ErrorInfo, mas recebi: %v", st.Details())
+				}
+				if info, ok := st.Details()[0].(*errdetails.ErrorInfo); !ok || info.GetReason() != string(tc.expectedCode) {
+					t.Errorf("ErrorInfo incorreto: %v", st.Details()[0])
+				}
+			})
+		}
+	})
+
+	t.Run("Health - deve informar o serviço como disponível", func(t *testing.T) {
+		resposta, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: ibgev1.IBGEService_ServiceDesc.ServiceName})
+		if err != nil {
+			t.Fatalf("Esperava não ter erro, mas recebi: %v", err)
+		}
+		if resposta.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			t.Errorf("Status incorreto: %v", resposta.GetStatus())
+		}
+	})
+
+	t.Run("Reflection - deve listar os serviços registrados", func(t *testing.T) {
+		stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+		if err != nil {
+			t.Fatalf("Esperava não ter erro, mas recebi: %v", err)
+		}
+		if err := stream.Send(&reflectionpb.ServerReflectionRequest{
+			MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+		}); err != nil {
+			t.Fatalf("Erro ao enviar requisição de reflexão: %v", err)
+		}
+		resposta, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Erro ao receber resposta de reflexão: %v", err)
+		}
+
+		var servicos []string
+		for _, s := range resposta.GetListServicesResponse().GetService() {
+			servicos = append(servicos, s.GetName())
+		}
+		if !strings.Contains(strings.Join(servicos, ","), "ibge.v1.IBGEService") {
+			t.Errorf("IBGEService não listado na reflexão: %v", servicos)
+		}
+	})
+}
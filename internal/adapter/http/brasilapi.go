@@ -0,0 +1,101 @@
+package http
+
+import (
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+	"github.com/go-chi/chi/v5"
+)
+
+// Rotas compatíveis com os endpoints de IBGE da BrasilAPI (brasilapi.com.br/api/ibge/...).
+// Clientes da BrasilAPI passam a usar este serviço trocando apenas a URL base.
+
+// brasilAPIUF é um estado no formato de /ibge/uf/v1.
+type brasilAPIUF struct {
+	ID     int               `json:"id"`
+	Sigla  string            `json:"sigla"`
+	Nome   string            `json:"nome"`
+	Regiao localidadesRegiao `json:"regiao"`
+}
+
+// brasilAPIMunicipio é um município no formato de /ibge/municipios/v1/{siglaUF}:
+// nome em caixa alta e código IBGE como texto.
+type brasilAPIMunicipio struct {
+	Nome       string `json:"nome"`
+	CodigoIBGE string `json:"codigo_ibge"`
+}
+
+// brasilAPIErro é o corpo das respostas de erro da BrasilAPI.
+type brasilAPIErro struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+}
+
+func novaUFBrasilAPI(e domain.Estado) brasilAPIUF {
+	return brasilAPIUF{ID: e.CodigoIBGE, Sigla: e.Sigla, Nome: e.Nome, Regiao: novaRegiaoLocalidades(e.Regiao())}
+}
+
+func respondBrasilAPINaoEncontrado(w http.ResponseWriter, r *http.Request, message string) {
+	respond(w, r, http.StatusNotFound, brasilAPIErro{Message: message, Type: "not_found", Name: "NotFoundError"})
+}
+
+func respondBrasilAPIErroInterno(w http.ResponseWriter, r *http.Request) {
+	respond(w, r, http.StatusInternalServerError, brasilAPIErro{Message: "Erro interno do servidor", Type: "internal_error", Name: "InternalError"})
+}
+
+// BrasilAPIEstados lista os estados ordenados pelo código IBGE (GET /brasilapi/ibge/uf/v1).
+func (h *IBGEHandler) BrasilAPIEstados(w http.ResponseWriter, r *http.Request) {
+	estados, err := h.casoDeUso(r).GetAllEstados()
+	if err != nil {
+		log.Printf("Erro ao buscar estados: %v", err)
+		respondBrasilAPIErroInterno(w, r)
+		return
+	}
+
+	ufs := make([]brasilAPIUF, 0, len(estados))
+	for _, e := range estados {
+		ufs = append(ufs, novaUFBrasilAPI(e))
+	}
+	sort.Slice(ufs, func(i, j int) bool { return ufs[i].ID < ufs[j].ID })
+	respond(w, r, http.StatusOK, ufs)
+}
+
+// BrasilAPIEstado busca um estado pela sigla ou pelo código IBGE (GET /brasilapi/ibge/uf/v1/{code}).
+func (h *IBGEHandler) BrasilAPIEstado(w http.ResponseWriter, r *http.Request) {
+	estados := h.estadosPorIdentificador(r, []string{chi.URLParam(r, "code")})
+	if len(estados) == 0 {
+		respondBrasilAPINaoEncontrado(w, r, "UF não encontrada.")
+		return
+	}
+	respond(w, r, http.StatusOK, novaUFBrasilAPI(estados[0]))
+}
+
+// BrasilAPIMunicipios lista os municípios de um estado ordenados pelo nome
+// (GET /brasilapi/ibge/municipios/v1/{siglaUF}).
+func (h *IBGEHandler) BrasilAPIMunicipios(w http.ResponseWriter, r *http.Request) {
+	sigla := strings.ToUpper(chi.URLParam(r, "siglaUF"))
+	cidades, err := h.casoDeUso(r).GetCidadesByEstadoUF(sigla)
+	if err != nil {
+		if _, estadoErr := h.casoDeUso(r).GetEstadoByUF(sigla); estadoErr != nil {
+			respondBrasilAPINaoEncontrado(w, r, "UF não encontrada.")
+			return
+		}
+		log.Printf("Erro ao buscar municípios do estado %s: %v", sigla, err)
+		respondBrasilAPIErroInterno(w, r)
+		return
+	}
+
+	municipios := make([]brasilAPIMunicipio, 0, len(cidades))
+	for _, c := range cidades {
+		municipios = append(municipios, brasilAPIMunicipio{Nome: strings.ToUpper(c.Nome), CodigoIBGE: strconv.Itoa(c.CodigoIBGE)})
+	}
+	sort.SliceStable(municipios, func(i, j int) bool {
+		return domain.NormalizarNome(municipios[i].Nome) < domain.NormalizarNome(municipios[j].Nome)
+	})
+	respond(w, r, http.StatusOK, municipios)
+}
@@ -0,0 +1,57 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestBrasilAPICompatibilidade compara o JSON exato das rotas /brasilapi com os arquivos
+// golden em testdata/brasilapi, que reproduzem o formato das respostas da BrasilAPI.
+func TestBrasilAPICompatibilidade(t *testing.T) {
+	repo := &mockIBGERepository{}
+	handler := NewIBGEHandler(novoRegistroVersoes(repo))
+	router := SetupRouter(handler)
+
+	testCases := []struct {
+		path           string
+		golden         string
+		expectedStatus int
+	}{
+		{"/brasilapi/ibge/uf/v1", "uf.json", http.StatusOK},
+		{"/brasilapi/ibge/uf/v1/SP", "uf-sp.json", http.StatusOK},
+		{"/brasilapi/ibge/uf/v1/33", "uf-33.json", http.StatusOK},
+		{"/brasilapi/ibge/uf/v1/XX", "uf-nao-encontrada.json", http.StatusNotFound},
+		{"/brasilapi/ibge/municipios/v1/sp", "municipios-sp.json", http.StatusOK},
+		{"/brasilapi/ibge/municipios/v1/XX", "municipios-nao-encontrada.json", http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tc.path, nil)
+			rr := httptest.NewRecorder()
+
+			router.ServeHTTP(rr, req)
+
+			if status := rr.Code; status != tc.expectedStatus {
+				t.Fatalf("Status code incorreto: got %v want %v", status, tc.expectedStatus)
+			}
+
+			arquivo := filepath.Join("testdata", "brasilapi", tc.golden)
+			if *atualizarGolden {
+				if err := os.WriteFile(arquivo, append(rr.Body.Bytes(), '\n'), 0o644); err != nil {
+					t.Fatalf("Erro ao gravar %s: %v", arquivo, err)
+				}
+			}
+			esperado, err := os.ReadFile(arquivo)
+			if err != nil {
+				t.Fatalf("Erro ao ler %s: %v", arquivo, err)
+			}
+			if got := rr.Body.String() + "\n"; got != string(esperado) {
+				t.Errorf("JSON diferente de %s.\ngot:  %s\nwant: %s", arquivo, got, esperado)
+			}
+		})
+	}
+}
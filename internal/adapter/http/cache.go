@@ -0,0 +1,221 @@
+package http
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// chaveValidacao marca as requisições cujas respostas levam ETag e atendem requisições
+// condicionais (If-None-Match e If-Modified-Since).
+const chaveValidacao chaveContexto = "validacao"
+
+// CacheHTTP aplica às respostas a política de Cache-Control da rota e informa em Last-Modified a
+// data da última execução do seed da edição escolhida, já que o conjunto de dados só muda quando é
+// recarregado. Deve vir depois de SelecionarVersao. Rotas com a política no-store (ex: hora local)
+// não levam validadores, pois a resposta muda sem que o conjunto de dados mude.
+func (h *IBGEHandler) CacheHTTP(politica func(caminho string) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cacheControl := politica(r.URL.Path)
+			if cacheControl != "" {
+				w.Header().Set("Cache-Control", cacheControl)
+			}
+			// A edição também pode ser escolhida pelo header, então caches compartilhados precisam diferenciá-la
+			w.Header().Add("Vary", HeaderVersaoDataset)
+			if semArmazenamento(cacheControl) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			atualizadoEm, err := h.casoDeUso(r).GetAtualizadoEm()
+			if err != nil {
+				log.Printf("Erro ao buscar a data de atualização do conjunto de dados: %v", err)
+			} else if !atualizadoEm.IsZero() {
+				w.Header().Set("Last-Modified", atualizadoEm.UTC().Format(http.TimeFormat))
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), chaveValidacao, true)))
+		})
+	}
+}
+
+func semArmazenamento(cacheControl string) bool {
+	for _, diretiva := range strings.Split(cacheControl, ",") {
+		if strings.EqualFold(strings.TrimSpace(diretiva), "no-store") {
+			return true
+		}
+	}
+	return false
+}
+
+// validavel informa se a resposta da requisição deve levar ETag (ver CacheHTTP).
+func validavel(r *http.Request) bool {
+	marcada, _ := r.Context().Value(chaveValidacao).(bool)
+	return marcada
+}
+
+// etagRepresentacao monta o ETag forte de uma representação: a edição do conjunto de dados mais o
+// hash do tipo de conteúdo e do corpo, que já refletem o formato e os parâmetros pedidos.
+func etagRepresentacao(versao, tipoConteudo string, corpo []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(tipoConteudo))
+	hash.Write([]byte{0})
+	hash.Write(corpo)
+	return `"` + versao + "-" + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+// codificacoesETag são as codificações de conteúdo que podem ser aplicadas às respostas, pelo
+// middleware de compressão (gzip e deflate) ou pelas respostas pré-comprimidas (br e gzip).
+var codificacoesETag = []string{"gzip", "deflate", "br"}
+
+// etagCodificada acrescenta ao ETag o sufixo da codificação do corpo (ex: "2024-abc" vira
+// "2024-abc-gzip"), pois um ETag forte identifica os bytes enviados (RFC 9110, seção 8.8.3).
+func etagCodificada(etag, codificacao string) string {
+	if codificacao == "" || codificacao == codificacaoIdentidade || !strings.HasSuffix(etag, `"`) ||
+		strings.HasSuffix(etag, "-"+codificacao+`"`) {
+		return etag
+	}
+	return strings.TrimSuffix(etag, `"`) + "-" + codificacao + `"`
+}
+
+// ETagPorCodificacao diferencia o ETag de cada codificação do corpo: com Content-Encoding definido
+// pelo middleware de compressão ou pelas respostas pré-comprimidas, o ETag recebe o sufixo da
+// codificação, como faz o Apache. Deve vir antes de middleware.Compress, para ver os headers que
+// ele define ao enviar a resposta.
+func ETagPorCodificacao(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(&respostaETagCodificada{ResponseWriter: w}, r)
+	})
+}
+
+type respostaETagCodificada struct {
+	http.ResponseWriter
+	cabecalhoEnviado bool
+}
+
+func (w *respostaETagCodificada) WriteHeader(code int) {
+	if !w.cabecalhoEnviado {
+		w.cabecalhoEnviado = true
+		if etag := w.Header().Get("ETag"); etag != "" {
+			w.Header().Set("ETag", etagCodificada(etag, w.Header().Get("Content-Encoding")))
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *respostaETagCodificada) Write(dados []byte) (int, error) {
+	if !w.cabecalhoEnviado {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(dados)
+}
+
+// Flush atende os envios em partes (ex: o export), que passam por http.ResponseController.
+func (w *respostaETagCodificada) Flush() {
+	if !w.cabecalhoEnviado {
+		w.WriteHeader(http.StatusOK)
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *respostaETagCodificada) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// naoModificado avalia as precondições da requisição (RFC 9110, seção 13) contra os validadores já
+// definidos na resposta. If-Modified-Since só é considerado quando não há If-None-Match. O ETag de
+// qualquer codificação do corpo é aceito, e a resposta 304 repete o que o cliente enviou, para que
+// ele continue associado à cópia que o cliente guardou.
+func naoModificado(w http.ResponseWriter, r *http.Request) bool {
+	if valor := r.Header.Get("If-None-Match"); valor != "" {
+		etag := w.Header().Get("ETag")
+		if etag == "" {
+			return false
+		}
+		correspondente, found := etagCorresponde(valor, etag)
+		if found && correspondente != "*" {
+			w.Header().Set("ETag", correspondente)
+		}
+		return found
+	}
+	desde, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	modificadoEm := ultimaModificacao(w)
+	return !modificadoEm.IsZero() && !modificadoEm.After(desde)
+}
+
+// etagCorresponde compara a lista de If-None-Match com o ETag e as suas variantes codificadas, na
+// comparação fraca exigida por esse header (o prefixo W/ é ignorado). Retorna a variante que
+// correspondeu, ou * se a lista o incluir.
+func etagCorresponde(lista, etag string) (string, bool) {
+	etag = strings.TrimPrefix(etag, "W/")
+	variantes := []string{etag}
+	for _, codificacao := range codificacoesETag {
+		variantes = append(variantes, etagCodificada(etag, codificacao))
+	}
+	for _, candidato := range strings.Split(lista, ",") {
+		candidato = strings.TrimSpace(candidato)
+		if candidato == "*" {
+			return candidato, true
+		}
+		if i := slices.Index(variantes, strings.TrimPrefix(candidato, "W/")); i >= 0 {
+			return variantes[i], true
+		}
+	}
+	return "", false
+}
+
+// ultimaModificacao retorna a data informada em Last-Modified na resposta, ou zero se não houver.
+func ultimaModificacao(w http.ResponseWriter) time.Time {
+	modificadoEm, err := http.ParseTime(w.Header().Get("Last-Modified"))
+	if err != nil {
+		return time.Time{}
+	}
+	return modificadoEm
+}
+
+// naoArmazenar impede que respostas de erro fiquem em cache. Falhas (5xx) são transitórias, e os
+// problemas 4xx levam o request_id da requisição e dependem de parâmetros que o cliente vai corrigir:
+// a política da rota e os validadores definidos por CacheHTTP valem só para as respostas de sucesso.
+func naoArmazenar(w http.ResponseWriter) {
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Del("Last-Modified")
+	w.Header().Del("ETag")
+}
+
+// errosSemCache aplica naoArmazenar às respostas de erro escritas fora de respond (ex: o 416 de
+// http.ServeContent para um Range inválido).
+type errosSemCache struct {
+	http.ResponseWriter
+}
+
+func (w errosSemCache) WriteHeader(code int) {
+	if code >= http.StatusBadRequest {
+		naoArmazenar(w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w errosSemCache) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// responderNaoModificado envia 304 sem corpo, mantendo os validadores e a política de cache.
+func responderNaoModificado(w http.ResponseWriter) {
+	w.Header().Del("Content-Type")
+	w.WriteHeader(http.StatusNotModified)
+}
@@ -0,0 +1,167 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestCacheHTTP cobre os headers de cache e as requisições condicionais.
+func TestCacheHTTP(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("Cache HTTP - deve informar ETag, Last-Modified e Cache-Control e atender requisições condicionais", func(t *testing.T) {
+		get := func(url string, headers map[string]string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest("GET", url, nil)
+			for nome, valor := range headers {
+				req.Header.Set(nome, valor)
+			}
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+			return rr
+		}
+
+		rr := get("/api/v1/estados/SP/cidades", nil)
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Status code incorreto: got %v want %v", rr.Code, http.StatusOK)
+		}
+		etag := rr.Header().Get("ETag")
+		if !strings.HasPrefix(etag, `"2024-`) || !strings.HasSuffix(etag, `"`) {
+			t.Errorf("ETag deveria ser forte e levar a versão do conjunto de dados, got %q", etag)
+		}
+		if got, want := rr.Header().Get("Last-Modified"), atualizacaoMock.Format(http.TimeFormat); got != want {
+			t.Errorf("Last-Modified incorreto: got %q want %q", got, want)
+		}
+		if got := rr.Header().Get("Cache-Control"); got != "public, max-age=3600" {
+			t.Errorf("Cache-Control incorreto: got %q", got)
+		}
+		if vary := strings.Join(rr.Header().Values("Vary"), ", "); !strings.Contains(vary, HeaderVersaoDataset) || !strings.Contains(vary, "Accept") {
+			t.Errorf("Vary deveria incluir Accept e %s, got %q", HeaderVersaoDataset, vary)
+		}
+
+		if repetida := get("/api/v1/estados/SP/cidades", nil); repetida.Header().Get("ETag") != etag {
+			t.Errorf("ETag deveria ser o mesmo para a mesma representação: got %q want %q", repetida.Header().Get("ETag"), etag)
+		}
+		for _, url := range []string{"/api/v1/estados/SP/cidades?formato=csv", "/api/v1/estados/SP/cidades?fields=nome", "/api/v1/estados/RJ/cidades"} {
+			if outra := get(url, nil).Header().Get("ETag"); outra == "" || outra == etag {
+				t.Errorf("%s deveria ter um ETag próprio, got %q", url, outra)
+			}
+		}
+		if etag2020 := get("/api/v1/estados?versao=2020", nil).Header().Get("ETag"); !strings.HasPrefix(etag2020, `"2020-`) {
+			t.Errorf("ETag da versão 2020 deveria levar a versão, got %q", etag2020)
+		}
+
+		anterior := atualizacaoMock.Add(-time.Hour).Format(http.TimeFormat)
+		testCases := []struct {
+			name           string
+			headers        map[string]string
+			expectedStatus int
+		}{
+			{"If-None-Match igual", map[string]string{"If-None-Match": etag}, http.StatusNotModified},
+			{"If-None-Match fraco", map[string]string{"If-None-Match": `"outro", W/` + etag}, http.StatusNotModified},
+			{"If-None-Match *", map[string]string{"If-None-Match": "*"}, http.StatusNotModified},
+			{"If-None-Match diferente", map[string]string{"If-None-Match": `"outro"`}, http.StatusOK},
+			{"If-Modified-Since igual", map[string]string{"If-Modified-Since": atualizacaoMock.Format(http.TimeFormat)}, http.StatusNotModified},
+			{"If-Modified-Since anterior", map[string]string{"If-Modified-Since": anterior}, http.StatusOK},
+			{"If-None-Match tem precedência", map[string]string{"If-None-Match": `"outro"`, "If-Modified-Since": atualizacaoMock.Format(http.TimeFormat)}, http.StatusOK},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := get("/api/v1/estados/SP/cidades", tc.headers)
+				if rr.Code != tc.expectedStatus {
+					t.Fatalf("Status code incorreto: got %v want %v", rr.Code, tc.expectedStatus)
+				}
+				if tc.expectedStatus != http.StatusNotModified {
+					return
+				}
+				if rr.Body.Len() != 0 {
+					t.Errorf("304 não deveria ter corpo, got %q", rr.Body.String())
+				}
+				if rr.Header().Get("ETag") != etag || rr.Header().Get("Cache-Control") == "" {
+					t.Errorf("304 deveria manter o ETag e o Cache-Control, got %v", rr.Header())
+				}
+			})
+		}
+
+		t.Run("políticas por rota", func(t *testing.T) {
+			rr := get("/api/v1/cidades/3550308/hora-local", nil)
+			if got := rr.Header().Get("Cache-Control"); got != "no-store" {
+				t.Errorf("Cache-Control da hora local incorreto: got %q", got)
+			}
+			if rr.Header().Get("ETag") != "" || rr.Header().Get("Last-Modified") != "" {
+				t.Errorf("Hora local não deveria ter validadores, got %v", rr.Header())
+			}
+			if got := get("/api/v1/alteracoes?desde=0", nil).Header().Get("Cache-Control"); got != "no-cache" {
+				t.Errorf("Cache-Control das alterações incorreto: got %q", got)
+			}
+		})
+
+		t.Run("feriados do ano corrente são validados apenas pelo ETag", func(t *testing.T) {
+			rr := get("/api/v1/feriados?uf=SP", nil)
+			if rr.Header().Get("Last-Modified") != "" || rr.Header().Get("ETag") == "" {
+				t.Errorf("Feriados sem ano deveriam ter apenas ETag, got %v", rr.Header())
+			}
+			if rr := get("/api/v1/feriados?uf=SP&ano=2024", nil); rr.Header().Get("Last-Modified") == "" {
+				t.Errorf("Feriados de um ano informado deveriam ter Last-Modified")
+			}
+		})
+
+		t.Run("cada codificação do corpo tem o seu ETag", func(t *testing.T) {
+			identidade := get("/api/v1/cidades/3550308", nil)
+			comprimida := get("/api/v1/cidades/3550308", map[string]string{"Accept-Encoding": "gzip"})
+			if comprimida.Header().Get("Content-Encoding") != "gzip" {
+				t.Fatalf("A resposta deveria ser comprimida, got %v", comprimida.Header())
+			}
+			etag := comprimida.Header().Get("ETag")
+			if want := etagCodificada(identidade.Header().Get("ETag"), "gzip"); etag != want || etag == identidade.Header().Get("ETag") {
+				t.Errorf("ETag da resposta gzip incorreto: got %q want %q", etag, want)
+			}
+
+			rr := get("/api/v1/cidades/3550308", map[string]string{"Accept-Encoding": "gzip", "If-None-Match": etag})
+			if rr.Code != http.StatusNotModified || rr.Header().Get("ETag") != etag {
+				t.Errorf("Revalidação da cópia gzip incorreta: got %d com ETag %q", rr.Code, rr.Header().Get("ETag"))
+			}
+		})
+
+		t.Run("erros não levam ETag", func(t *testing.T) {
+			rr := get("/api/v1/estados/XX/cidades", map[string]string{"If-None-Match": "*"})
+			if rr.Code != http.StatusNotFound || rr.Header().Get("ETag") != "" {
+				t.Errorf("Resposta incorreta para estado inexistente: %d %v", rr.Code, rr.Header())
+			}
+		})
+
+		t.Run("erros não podem ser armazenados em cache", func(t *testing.T) {
+			testCases := []struct {
+				url    string
+				status int
+			}{
+				{"/api/v1/cidades/abc", http.StatusBadRequest},
+				{"/api/v1/estados/XX", http.StatusNotFound},
+				{"/api/v1/estados?versao=1999", http.StatusBadRequest},
+				{"/api/v2/cidades/9999999", http.StatusNotFound},
+				{"/api/v1/estados?formato=pdf", http.StatusBadRequest},
+			}
+			for _, tc := range testCases {
+				rr := get(tc.url, nil)
+				if rr.Code != tc.status {
+					t.Errorf("%s: status incorreto: got %d want %d", tc.url, rr.Code, tc.status)
+				}
+				if got := rr.Header().Get("Cache-Control"); got != "no-store" {
+					t.Errorf("%s: Cache-Control incorreto: got %q want no-store", tc.url, got)
+				}
+				if rr.Header().Get("Last-Modified") != "" || rr.Header().Get("ETag") != "" {
+					t.Errorf("%s: erros não deveriam ter validadores, got %v", tc.url, rr.Header())
+				}
+			}
+		})
+
+		t.Run("export atende If-Modified-Since", func(t *testing.T) {
+			rr := get("/api/v1/cidades/export", map[string]string{"If-Modified-Since": atualizacaoMock.Format(http.TimeFormat)})
+			if rr.Code != http.StatusNotModified || rr.Body.Len() != 0 {
+				t.Errorf("Export não modificado deveria responder 304 sem corpo, got %d", rr.Code)
+			}
+		})
+	})
+}
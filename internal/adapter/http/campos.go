@@ -0,0 +1,511 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// ajustesRecursos são os ajustes pedidos pelo cliente no formato dos recursos da resposta:
+// ?fields= seleciona os campos e ?expand=estado,regiao troca os campos desnormalizados do
+// estado por objetos aninhados. Eles são aplicados em respond, para todos os endpoints.
+type ajustesRecursos struct {
+	campos         arvoreCampos // nil: todos os campos
+	expandirEstado bool
+	expandirRegiao bool
+}
+
+// arvoreCampos guarda os caminhos pedidos em ?fields=: "estado.sigla" vira {"estado": {"sigla": nil}}.
+// Um campo sem subcampos (valor nil) é mantido por inteiro.
+type arvoreCampos map[string]arvoreCampos
+
+var (
+	tipoCidade = reflect.TypeOf(domain.Cidade{})
+	tipoEstado = reflect.TypeOf(domain.Estado{})
+)
+
+// expansoes lê os valores de ?expand=, que aceita vários valores separados por vírgula ou repetidos.
+func expansoes(r *http.Request) map[string]bool {
+	pedidas := map[string]bool{}
+	for _, valor := range r.URL.Query()["expand"] {
+		for _, campo := range strings.Split(valor, ",") {
+			if campo = strings.TrimSpace(campo); campo != "" {
+				pedidas[campo] = true
+			}
+		}
+	}
+	return pedidas
+}
+
+func lerAjustesRecursos(r *http.Request) ajustesRecursos {
+	pedidas := expansoes(r)
+	ajustes := ajustesRecursos{expandirEstado: pedidas["estado"], expandirRegiao: pedidas["regiao"]}
+
+	for _, valor := range r.URL.Query()["fields"] {
+		for _, caminho := range strings.Split(valor, ",") {
+			if caminho = strings.TrimSpace(caminho); caminho == "" {
+				continue
+			}
+			if ajustes.campos == nil {
+				ajustes.campos = arvoreCampos{}
+			}
+			no := ajustes.campos
+			for _, parte := range strings.Split(caminho, ".") {
+				if no[parte] == nil {
+					no[parte] = arvoreCampos{}
+				}
+				no = no[parte]
+			}
+		}
+	}
+	return ajustes
+}
+
+func (a ajustesRecursos) vazios() bool {
+	return a.campos == nil && !a.expandirEstado && !a.expandirRegiao
+}
+
+// ajustarRecursos aplica ?expand= e ?fields= ao payload. Sem esses parâmetros, o payload é
+// retornado intacto; com eles, a resposta passa a ser montada a partir da sua representação
+// JSON, em mapas que preservam a ordem dos campos.
+func ajustarRecursos(r *http.Request, payload interface{}) (interface{}, error) {
+	ajustes := lerAjustesRecursos(r)
+	if ajustes.vazios() {
+		return payload, nil
+	}
+
+	valor, err := valorGenerico(payload)
+	if err != nil {
+		return nil, err
+	}
+	if ajustes.expandirEstado || ajustes.expandirRegiao {
+		valor = ajustes.expandir(reflect.TypeOf(payload), valor)
+	}
+	if ajustes.campos != nil {
+		if _, ok := payload.(envelopeLista); ok {
+			valor = selecionarDadosEnvelope(valor, ajustes.campos)
+		} else {
+			valor = selecionarRecursos(valor, ajustes.campos)
+		}
+	}
+	return paraMapa(valor), nil
+}
+
+// selecionarDadosEnvelope aplica ?fields= aos itens de um envelopeLista, mantendo os metadados.
+func selecionarDadosEnvelope(valor interface{}, campos arvoreCampos) interface{} {
+	envelope, ok := valor.(objetoOrdenado)
+	if !ok {
+		return valor
+	}
+	for i := range envelope {
+		if envelope[i].chave == "dados" {
+			envelope[i].valor = selecionarRecursos(envelope[i].valor, campos)
+		}
+	}
+	return envelope
+}
+
+// expandir percorre o valor genérico guiado pelo tipo Go de origem, para reconhecer as cidades
+// e os estados onde quer que apareçam (ex: em listas ou em campos de outras respostas).
+func (a ajustesRecursos) expandir(tipo reflect.Type, valor interface{}) interface{} {
+	if tipo == nil {
+		return valor
+	}
+	for tipo.Kind() == reflect.Pointer {
+		tipo = tipo.Elem()
+	}
+
+	switch tipo.Kind() {
+	case reflect.Slice, reflect.Array:
+		if lista, ok := valor.([]interface{}); ok {
+			for i := range lista {
+				lista[i] = a.expandir(tipo.Elem(), lista[i])
+			}
+		}
+	case reflect.Map:
+		if objeto, ok := valor.(objetoOrdenado); ok {
+			for i := range objeto {
+				objeto[i].valor = a.expandir(tipo.Elem(), objeto[i].valor)
+			}
+		}
+	case reflect.Struct:
+		objeto, ok := valor.(objetoOrdenado)
+		if !ok {
+			return valor
+		}
+		for i := range objeto {
+			if campo, found := campoPorNomeJSON(tipo, objeto[i].chave); found {
+				objeto[i].valor = a.expandir(campo.Type, objeto[i].valor)
+			}
+		}
+		switch tipo {
+		case tipoCidade:
+			return a.expandirCidade(objeto)
+		case tipoEstado:
+			if a.expandirRegiao {
+				return inserirApos(objeto, "sigla", "regiao", regiaoDoEstado(objeto, "codigo_ibge"))
+			}
+		}
+		return objeto
+	}
+	return valor
+}
+
+// expandirCidade troca estado_codigo_ibge, estado_sigla e estado_nome pelo objeto estado e,
+// com ?expand=regiao, inclui a Grande Região (dentro do estado, se ele também for expandido).
+func (a ajustesRecursos) expandirCidade(cidade objetoOrdenado) objetoOrdenado {
+	regiao := regiaoDoEstado(cidade, "estado_codigo_ibge")
+	if !a.expandirEstado {
+		if a.expandirRegiao {
+			return inserirApos(cidade, "estado_nome", "regiao", regiao)
+		}
+		return cidade
+	}
+
+	estado := objetoOrdenado{}
+	expandida := make(objetoOrdenado, 0, len(cidade))
+	posicao := -1
+	for _, campo := range cidade {
+		switch campo.chave {
+		case "estado_codigo_ibge", "estado_sigla", "estado_nome":
+			estado = append(estado, campoOrdenado{strings.TrimPrefix(campo.chave, "estado_"), campo.valor})
+			if posicao < 0 {
+				posicao = len(expandida)
+			}
+		default:
+			expandida = append(expandida, campo)
+		}
+	}
+	if posicao < 0 {
+		return cidade
+	}
+	if a.expandirRegiao {
+		estado = append(estado, campoOrdenado{"regiao", regiao})
+	}
+	return append(expandida[:posicao], append(objetoOrdenado{{"estado", estado}}, expandida[posicao:]...)...)
+}
+
+// regiaoDoEstado monta o objeto da Grande Região a partir do código IBGE do estado no campo informado.
+func regiaoDoEstado(objeto objetoOrdenado, campoCodigo string) interface{} {
+	for _, campo := range objeto {
+		if campo.chave != campoCodigo {
+			continue
+		}
+		numero, _ := campo.valor.(json.Number)
+		codigo, err := numero.Int64()
+		if err != nil {
+			return nil
+		}
+		regiao, err := valorGenerico(domain.Estado{CodigoIBGE: int(codigo)}.Regiao())
+		if err != nil {
+			return nil
+		}
+		return regiao
+	}
+	return nil
+}
+
+// inserirApos inclui o campo logo depois de outro campo do objeto, ou no final se ele não existir.
+func inserirApos(objeto objetoOrdenado, anterior, chave string, valor interface{}) objetoOrdenado {
+	posicao := len(objeto)
+	for i, campo := range objeto {
+		if campo.chave == anterior {
+			posicao = i + 1
+			break
+		}
+	}
+	resultado := make(objetoOrdenado, 0, len(objeto)+1)
+	resultado = append(resultado, objeto[:posicao]...)
+	resultado = append(resultado, campoOrdenado{chave, valor})
+	return append(resultado, objeto[posicao:]...)
+}
+
+// campoPorNomeJSON busca o campo da struct serializado com o nome informado, inclusive em
+// structs embutidas.
+func campoPorNomeJSON(tipo reflect.Type, nome string) (reflect.StructField, bool) {
+	for i := 0; i < tipo.NumField(); i++ {
+		campo := tipo.Field(i)
+		if !campo.IsExported() {
+			continue
+		}
+		tag, _, _ := strings.Cut(campo.Tag.Get("json"), ",")
+		if tag == "-" {
+			continue
+		}
+		if campo.Anonymous && tag == "" {
+			embutido := campo.Type
+			if embutido.Kind() == reflect.Pointer {
+				embutido = embutido.Elem()
+			}
+			if embutido.Kind() == reflect.Struct {
+				if encontrado, found := campoPorNomeJSON(embutido, nome); found {
+					return encontrado, true
+				}
+			}
+			continue
+		}
+		if tag == "" {
+			tag = campo.Name
+		}
+		if tag == nome {
+			return campo, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// selecionarRecursos aplica ?fields= aos recursos da resposta: ao objeto retornado ou a cada
+// item da lista. Os nomes pedidos já foram conferidos por validarAjustesRecursos.
+func selecionarRecursos(valor interface{}, campos arvoreCampos) interface{} {
+	if lista, ok := valor.([]interface{}); ok {
+		for i := range lista {
+			lista[i] = selecionarCampos(lista[i], campos)
+		}
+		return lista
+	}
+	return selecionarCampos(valor, campos)
+}
+
+func selecionarCampos(valor interface{}, campos arvoreCampos) interface{} {
+	objeto, ok := valor.(objetoOrdenado)
+	if !ok || len(campos) == 0 {
+		return valor
+	}
+	selecionados := objetoOrdenado{}
+	for _, campo := range objeto {
+		subcampos, pedido := campos[campo.chave]
+		if !pedido {
+			continue
+		}
+		selecionados = append(selecionados, campoOrdenado{campo.chave, selecionarRecursos(campo.valor, subcampos)})
+	}
+	return selecionados
+}
+
+// esquemaCampos descreve os campos JSON que um recurso pode ter, para validar ?fields=. Um
+// campo escalar não tem subcampos; um valor livre (interface, mapa ou tipo com MarshalJSON
+// próprio) não tem estrutura conhecida e aceita qualquer subcampo.
+type esquemaCampos struct {
+	nomes     []string
+	subcampos map[string]*esquemaCampos
+	livre     bool
+	lista     bool // Valor é uma lista (de objetos, se houver subcampos)
+}
+
+var tipoMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// esquemaDoTipo monta o esquema do tipo Go, já com os campos trocados ou incluídos por ?expand=.
+func (a ajustesRecursos) esquemaDoTipo(tipo reflect.Type, visitados map[reflect.Type]bool) *esquemaCampos {
+	lista := false
+	for tipo.Kind() == reflect.Pointer || tipo.Kind() == reflect.Slice || tipo.Kind() == reflect.Array {
+		lista = lista || tipo.Kind() != reflect.Pointer
+		tipo = tipo.Elem()
+	}
+	esquema := a.esquemaDoElemento(tipo, visitados)
+	esquema.lista = lista
+	return esquema
+}
+
+func (a ajustesRecursos) esquemaDoElemento(tipo reflect.Type, visitados map[reflect.Type]bool) *esquemaCampos {
+	if tipo.Implements(tipoMarshaler) || reflect.PointerTo(tipo).Implements(tipoMarshaler) {
+		return &esquemaCampos{livre: true}
+	}
+	switch tipo.Kind() {
+	case reflect.Interface, reflect.Map:
+		return &esquemaCampos{livre: true}
+	case reflect.Struct:
+	default:
+		return &esquemaCampos{}
+	}
+	if visitados[tipo] {
+		return &esquemaCampos{livre: true}
+	}
+	visitados[tipo] = true
+	defer delete(visitados, tipo)
+
+	esquema := &esquemaCampos{subcampos: map[string]*esquemaCampos{}}
+	a.incluirCamposStruct(esquema, tipo, visitados)
+	switch tipo {
+	case tipoCidade:
+		regiao := a.esquemaDoTipo(reflect.TypeOf(domain.Regiao{}), visitados)
+		if a.expandirEstado {
+			estado := &esquemaCampos{subcampos: map[string]*esquemaCampos{}}
+			for _, nome := range []string{"estado_codigo_ibge", "estado_sigla", "estado_nome"} {
+				estado.incluir(strings.TrimPrefix(nome, "estado_"), esquema.remover(nome))
+			}
+			if a.expandirRegiao {
+				estado.incluir("regiao", regiao)
+			}
+			esquema.incluir("estado", estado)
+		} else if a.expandirRegiao {
+			esquema.incluir("regiao", regiao)
+		}
+	case tipoEstado:
+		if a.expandirRegiao {
+			esquema.incluir("regiao", a.esquemaDoTipo(reflect.TypeOf(domain.Regiao{}), visitados))
+		}
+	}
+	return esquema
+}
+
+// incluirCamposStruct inclui no esquema os campos serializados da struct, com as mesmas regras
+// de campoPorNomeJSON para tags e structs embutidas.
+func (a ajustesRecursos) incluirCamposStruct(esquema *esquemaCampos, tipo reflect.Type, visitados map[reflect.Type]bool) {
+	for i := 0; i < tipo.NumField(); i++ {
+		campo := tipo.Field(i)
+		if !campo.IsExported() {
+			continue
+		}
+		tag, _, _ := strings.Cut(campo.Tag.Get("json"), ",")
+		if tag == "-" {
+			continue
+		}
+		if campo.Anonymous && tag == "" {
+			embutido := campo.Type
+			if embutido.Kind() == reflect.Pointer {
+				embutido = embutido.Elem()
+			}
+			if embutido.Kind() == reflect.Struct {
+				a.incluirCamposStruct(esquema, embutido, visitados)
+			}
+			continue
+		}
+		if tag == "" {
+			tag = campo.Name
+		}
+		esquema.incluir(tag, a.esquemaDoTipo(campo.Type, visitados))
+	}
+}
+
+func (e *esquemaCampos) incluir(nome string, campo *esquemaCampos) {
+	if campo == nil {
+		return
+	}
+	if _, found := e.subcampos[nome]; !found {
+		e.nomes = append(e.nomes, nome)
+	}
+	e.subcampos[nome] = campo
+}
+
+func (e *esquemaCampos) remover(nome string) *esquemaCampos {
+	campo, found := e.subcampos[nome]
+	if !found {
+		return nil
+	}
+	delete(e.subcampos, nome)
+	for i, existente := range e.nomes {
+		if existente == nome {
+			e.nomes = append(e.nomes[:i], e.nomes[i+1:]...)
+			break
+		}
+	}
+	return campo
+}
+
+// validar confere os caminhos pedidos em ?fields= contra o esquema; prefixo é o caminho do
+// objeto validado (ex: "estado."), usado nas mensagens de erro.
+func (e *esquemaCampos) validar(campos arvoreCampos, prefixo string) error {
+	if e.livre || len(campos) == 0 {
+		return nil
+	}
+	nomes := make([]string, 0, len(campos))
+	for nome := range campos {
+		nomes = append(nomes, nome)
+	}
+	sort.Strings(nomes)
+
+	for _, nome := range nomes {
+		campo, found := e.subcampos[nome]
+		if !found {
+			validos := make([]string, len(e.nomes))
+			for i, valido := range e.nomes {
+				validos[i] = prefixo + valido
+			}
+			return domain.ArgumentoInvalido(domain.MsgCampoInvalido, prefixo+nome, strings.Join(validos, ", "))
+		}
+		if len(campos[nome]) == 0 {
+			continue
+		}
+		if campo.subcampos == nil && !campo.livre {
+			return domain.ArgumentoInvalido(domain.MsgCampoSemSubcampos, prefixo+nome)
+		}
+		if err := campo.validar(campos[nome], prefixo+nome+"."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// contemTipo informa se o tipo Go inclui, em algum nível, valores do tipo procurado.
+func contemTipo(tipo, procurado reflect.Type, visitados map[reflect.Type]bool) bool {
+	for tipo.Kind() == reflect.Pointer || tipo.Kind() == reflect.Slice || tipo.Kind() == reflect.Array || tipo.Kind() == reflect.Map {
+		tipo = tipo.Elem()
+	}
+	if tipo == procurado {
+		return true
+	}
+	if tipo.Kind() != reflect.Struct || visitados[tipo] {
+		return false
+	}
+	visitados[tipo] = true
+	for i := 0; i < tipo.NumField(); i++ {
+		if campo := tipo.Field(i); campo.IsExported() && contemTipo(campo.Type, procurado, visitados) {
+			return true
+		}
+	}
+	return false
+}
+
+// expansoesDisponiveis lista os valores de ?expand= que fazem sentido para o tipo: aliases,
+// estado e regiao onde há cidades, e apenas regiao onde há somente estados.
+func expansoesDisponiveis(tipo reflect.Type) []string {
+	if contemTipo(tipo, tipoCidade, map[reflect.Type]bool{}) {
+		return []string{"aliases", "estado", "regiao"}
+	}
+	if contemTipo(tipo, tipoEstado, map[reflect.Type]bool{}) {
+		return []string{"regiao"}
+	}
+	return nil
+}
+
+// validarAjustesRecursos rejeita nomes desconhecidos em ?fields= e ?expand=, que de outra forma
+// resultariam em uma resposta vazia ou sem a expansão pedida. recursos é o payload na forma em
+// que ajustarRecursos o recebe.
+func validarAjustesRecursos(r *http.Request, recursos interface{}) error {
+	ajustes := lerAjustesRecursos(r)
+	pedidas := expansoes(r)
+	if ajustes.campos == nil && len(pedidas) == 0 {
+		return nil
+	}
+
+	tipo := reflect.TypeOf(recursos)
+	if envelope, ok := recursos.(envelopeLista); ok {
+		tipo = reflect.TypeOf(envelope.Dados)
+	}
+	if tipo == nil {
+		return nil
+	}
+
+	disponiveis := expansoesDisponiveis(tipo)
+	nomes := make([]string, 0, len(pedidas))
+	for nome := range pedidas {
+		nomes = append(nomes, nome)
+	}
+	sort.Strings(nomes)
+	for _, nome := range nomes {
+		if slices.Contains(disponiveis, nome) {
+			continue
+		}
+		if len(disponiveis) == 0 {
+			return domain.ArgumentoInvalido(domain.MsgExpansaoIndisponivel, nome)
+		}
+		return domain.ArgumentoInvalido(domain.MsgExpansaoInvalida, nome, strings.Join(disponiveis, ", "))
+	}
+
+	return ajustes.esquemaDoTipo(tipo, map[reflect.Type]bool{}).validar(ajustes.campos, "")
+}
@@ -0,0 +1,202 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// TestCamposExpansoes cobre a seleção de campos (fields) e as expansões (expand).
+func TestCamposExpansoes(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("GET /api/v1/estados/{uf}/cidades - deve selecionar os campos com fields", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/estados/RJ/cidades?fields=codigo_ibge,nome", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusOK {
+			t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+		}
+		esperado := `[{"codigo_ibge":3304557,"nome":"Rio de Janeiro"},{"codigo_ibge":3301702,"nome":"Niterói"}]`
+		if corpo := rr.Body.String(); corpo != esperado {
+			t.Errorf("Corpo incorreto: got %s want %s", corpo, esperado)
+		}
+	})
+
+	t.Run("GET /api/v1/estados/{uf}/cidades - deve aninhar estado e região com expand", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			url      string
+			esperado string
+		}{
+			{
+				"estado",
+				"/api/v1/estados/MG/cidades?expand=estado&fields=codigo_ibge,estado",
+				`[{"codigo_ibge":3106200,"estado":{"codigo_ibge":31,"sigla":"","nome":""}}]`,
+			},
+			{
+				"estado e região",
+				"/api/v1/estados/MG/cidades?expand=estado,regiao&fields=nome,estado.codigo_ibge,estado.regiao",
+				`[{"nome":"Belo Horizonte","estado":{"codigo_ibge":31,"regiao":{"codigo_ibge":3,"sigla":"SE","nome":"Sudeste"}}}]`,
+			},
+			{
+				"região sem estado",
+				"/api/v1/estados/MG/cidades?expand=regiao&expand=aliases&fields=estado_codigo_ibge,estado_nome,regiao.sigla",
+				`[{"estado_codigo_ibge":31,"estado_nome":"","regiao":{"sigla":"SE"}}]`,
+			},
+			{
+				"região no estado",
+				"/api/v1/estados/SP?expand=regiao&fields=sigla,regiao",
+				`{"sigla":"SP","regiao":{"codigo_ibge":3,"sigla":"SE","nome":"Sudeste"}}`,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := httptest.NewRequest("GET", tc.url, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != http.StatusOK {
+					t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+				}
+				if corpo := rr.Body.String(); corpo != tc.esperado {
+					t.Errorf("Corpo incorreto: got %s want %s", corpo, tc.esperado)
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/estados/{uf}/cidades - expand=estado deve valer nos outros formatos", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/estados/MG/cidades?expand=estado&fields=codigo_ibge,estado.codigo_ibge", nil)
+		req.Header.Set("Accept", "application/msgpack")
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusOK {
+			t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+		}
+		type estadoAninhado struct {
+			CodigoIBGE int `msgpack:"codigo_ibge"`
+		}
+		var cidades []struct {
+			CodigoIBGE int            `msgpack:"codigo_ibge"`
+			Estado     estadoAninhado `msgpack:"estado"`
+		}
+		if err := msgpack.Unmarshal(rr.Body.Bytes(), &cidades); err != nil {
+			t.Fatalf("MessagePack inválido: %v", err)
+		}
+		if len(cidades) != 1 || cidades[0].CodigoIBGE != 3106200 || cidades[0].Estado.CodigoIBGE != 31 {
+			t.Errorf("Cidades incorretas: got %+v", cidades)
+		}
+
+		req = httptest.NewRequest("GET", "/api/v1/estados/MG/cidades?expand=estado&fields=nome&formato=cbor", nil)
+		rr = httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		var nomes []map[string]string
+		if err := cbor.Unmarshal(rr.Body.Bytes(), &nomes); err != nil || len(nomes) != 1 || nomes[0]["nome"] != "Belo Horizonte" {
+			t.Errorf("CBOR incorreto: %v (%v)", nomes, err)
+		}
+
+		req = httptest.NewRequest("GET", "/api/v1/estados/MG/cidades?fields=codigo_ibge&formato=csv", nil)
+		rr = httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		if corpo := rr.Body.String(); corpo != "codigo_ibge\n3106200\n" {
+			t.Errorf("CSV incorreto: got %q", corpo)
+		}
+	})
+
+	t.Run("GET /api/v1/... - deve rejeitar campos e expansões desconhecidos", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			url    string
+			codigo string
+			detail string
+		}{
+			{
+				"campo inexistente",
+				"/api/v1/cidades/3550308?fields=foo",
+				"campo_invalido",
+				`campo \"foo\" inválido em fields (use codigo_ibge, nome,`,
+			},
+			{
+				"subcampo inexistente",
+				"/api/v1/estados/MG/cidades?expand=estado&fields=estado.foo",
+				"campo_invalido",
+				`campo \"estado.foo\" inválido em fields (use estado.codigo_ibge, estado.sigla, estado.nome)`,
+			},
+			{
+				"subcampo de campo escalar",
+				"/api/v1/estados/MG/cidades?fields=nome.foo",
+				"campo_sem_subcampos",
+				`o campo \"nome\" de fields não tem subcampos`,
+			},
+			{
+				"campo desnormalizado com expand=estado",
+				"/api/v1/estados/MG/cidades?expand=estado&fields=estado_sigla",
+				"campo_invalido",
+				`campo \"estado_sigla\" inválido em fields`,
+			},
+			{
+				"expansão inexistente",
+				"/api/v1/cidades/3550308?expand=bogus",
+				"expansao_invalida",
+				`expansão \"bogus\" inválida (use aliases, estado, regiao)`,
+			},
+			{
+				"expansão de cidade em estado",
+				"/api/v1/estados/SP?expand=estado",
+				"expansao_invalida",
+				`expansão \"estado\" inválida (use regiao)`,
+			},
+			{
+				"expansão em recurso sem estados e cidades",
+				"/api/v1/versoes?expand=regiao",
+				"expansao_indisponivel",
+				`este recurso não aceita expand (recebido \"regiao\")`,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := httptest.NewRequest("GET", tc.url, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != http.StatusBadRequest {
+					t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusBadRequest)
+				}
+				corpo := rr.Body.String()
+				if !strings.Contains(corpo, `"code":"`+tc.codigo+`"`) || !strings.Contains(corpo, tc.detail) {
+					t.Errorf("Corpo incorreto: got %s", corpo)
+				}
+				if rr.Header().Get("X-Total-Count") != "" || rr.Header().Get("Link") != "" {
+					t.Errorf("Erro não deveria ter os headers de paginação: %v", rr.Header())
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/estados/{uf} - fields não deve afetar as respostas de erro", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/estados/XX?fields=nome", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusNotFound {
+			t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusNotFound)
+		}
+		if corpo := rr.Body.String(); !strings.Contains(corpo, `"detail":"estado com a sigla XX não encontrado"`) {
+			t.Errorf("Corpo incorreto: got %s", corpo)
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+)
+
+// Descontinuacao anuncia que as rotas estão descontinuadas, com os headers Deprecation (RFC 9745)
+// e Sunset (RFC 8594). As datas são informadas no formato AAAA-MM-DD: deprecacao é a data a partir
+// da qual as rotas estão descontinuadas e remocao, a data prevista para a sua retirada. Datas vazias
+// não enviam o header correspondente; datas inválidas são registradas no log e ignoradas.
+func Descontinuacao(deprecacao, remocao string) func(http.Handler) http.Handler {
+	headers := map[string]string{}
+	if data, ok := dataDescontinuacao("deprecação", deprecacao); ok {
+		headers["Deprecation"] = fmt.Sprintf("@%d", data.Unix())
+	}
+	if data, ok := dataDescontinuacao("remoção", remocao); ok {
+		headers["Sunset"] = data.Format(http.TimeFormat)
+	}
+
+	return func(next http.Handler) http.Handler {
+		if len(headers) == 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for nome, valor := range headers {
+				w.Header().Set(nome, valor)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func dataDescontinuacao(descricao, valor string) (time.Time, bool) {
+	if valor == "" {
+		return time.Time{}, false
+	}
+	data, err := time.Parse("2006-01-02", valor)
+	if err != nil {
+		log.Printf("Data de %s das rotas descontinuadas inválida (use AAAA-MM-DD): %q", descricao, valor)
+		return time.Time{}, false
+	}
+	return data, true
+}
@@ -0,0 +1,66 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestDescontinuacao cobre os headers de descontinuação das rotas da v1.
+func TestDescontinuacao(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("GET /api/v1 - sem datas configuradas, não deve enviar Deprecation nem Sunset", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/estados", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if rr.Header().Get("Deprecation") != "" || rr.Header().Get("Sunset") != "" {
+			t.Errorf("Headers de descontinuação inesperados: %v", rr.Header())
+		}
+	})
+}
+
+// TestDescontinuacaoV1 verifica os headers Deprecation e Sunset configurados para as rotas da v1
+// que têm equivalente na v2.
+func TestDescontinuacaoV1(t *testing.T) {
+	t.Setenv("API_V1_DEPRECATION", "2026-07-01")
+	t.Setenv("API_V1_SUNSET", "2027-07-01")
+	repo := &mockIBGERepository{}
+	handler := NewIBGEHandler(novoRegistroVersoes(repo))
+	router := SetupRouter(handler)
+
+	testCases := []struct {
+		path           string
+		deprecation    string
+		sunset         string
+		expectedStatus int
+	}{
+		{"/api/v1/estados", "@1782864000", "Thu, 01 Jul 2027 00:00:00 GMT", http.StatusOK},
+		{"/api/v1/cidades/3550308", "@1782864000", "Thu, 01 Jul 2027 00:00:00 GMT", http.StatusOK},
+		{"/api/v1/estados/XX", "@1782864000", "Thu, 01 Jul 2027 00:00:00 GMT", http.StatusNotFound},
+		// Sem equivalente na v2, as demais rotas da v1 não são descontinuadas
+		{"/api/v1/feriados?ano=2026", "", "", http.StatusOK},
+		{"/api/v2/estados", "", "", http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tc.path, nil)
+			rr := httptest.NewRecorder()
+
+			router.ServeHTTP(rr, req)
+
+			if rr.Code != tc.expectedStatus {
+				t.Fatalf("Status code incorreto: got %v want %v", rr.Code, tc.expectedStatus)
+			}
+			if got := rr.Header().Get("Deprecation"); got != tc.deprecation {
+				t.Errorf("Deprecation incorreto: got %q want %q", got, tc.deprecation)
+			}
+			if got := rr.Header().Get("Sunset"); got != tc.sunset {
+				t.Errorf("Sunset incorreto: got %q want %q", got, tc.sunset)
+			}
+		})
+	}
+}
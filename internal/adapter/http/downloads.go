@@ -0,0 +1,164 @@
+package http
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+	"github.com/brauliohms/ibge-service/internal/pacote"
+	"github.com/go-chi/chi/v5"
+)
+
+// pacoteGerado é o conteúdo de um pacote para download, com o seu checksum SHA-256.
+type pacoteGerado struct {
+	mu       sync.Mutex
+	conteudo []byte
+	sha256   []byte
+}
+
+// cachePacotes guarda os pacotes por edição do conjunto de dados e formato: cada um é gerado
+// uma única vez, no primeiro pedido, e servido da memória nos seguintes. Uma falha na geração
+// não é guardada, para que o próximo pedido tente de novo.
+type cachePacotes struct {
+	mu      sync.Mutex
+	pacotes map[string]*pacoteGerado
+}
+
+func novoCachePacotes() *cachePacotes {
+	return &cachePacotes{pacotes: make(map[string]*pacoteGerado)}
+}
+
+func (c *cachePacotes) obter(versao string, formato pacote.Formato, gerar func() ([]byte, error)) (*pacoteGerado, error) {
+	chave := versao + "/" + formato.Nome
+	c.mu.Lock()
+	p, found := c.pacotes[chave]
+	if !found {
+		p = &pacoteGerado{}
+		c.pacotes[chave] = p
+	}
+	c.mu.Unlock()
+
+	// O lock por pacote faz pedidos simultâneos aguardarem uma única geração
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.conteudo == nil {
+		conteudo, err := gerar()
+		if err != nil {
+			return nil, err
+		}
+		soma := sha256.Sum256(conteudo)
+		p.conteudo, p.sha256 = conteudo, soma[:]
+	}
+	return p, nil
+}
+
+// pacoteDaVersao retorna o pacote do formato para a edição selecionada na requisição.
+func (h *IBGEHandler) pacoteDaVersao(w http.ResponseWriter, r *http.Request, formato pacote.Formato) (*pacoteGerado, error) {
+	return h.pacotes.obter(h.versaoSelecionada(w), formato, func() ([]byte, error) {
+		dados, err := h.casoDeUso(r).GetConjuntoDados()
+		if err != nil {
+			return nil, err
+		}
+		return pacote.Gerar(formato, dados)
+	})
+}
+
+// downloadDisponivel descreve um pacote para download da edição selecionada.
+type downloadDisponivel struct {
+	Formato string `json:"formato"`
+	Arquivo string `json:"arquivo"`
+	URL     string `json:"url"`
+	Tamanho int    `json:"tamanho"`
+	SHA256  string `json:"sha256"`
+}
+
+// respostaDownloads é o corpo da resposta de /downloads.
+type respostaDownloads struct {
+	VersaoDataset string               `json:"versao_dataset"`
+	Downloads     []downloadDisponivel `json:"downloads"`
+}
+
+func (d respostaDownloads) itensLista() interface{} {
+	return d.Downloads
+}
+
+func (d respostaDownloads) comItens(itens interface{}) interface{} {
+	d.Downloads = itens.([]downloadDisponivel)
+	return d
+}
+
+// GetDownloads godoc
+// @Summary Lista os pacotes para download do conjunto de dados
+// @Description Retorna os pacotes com a edição completa do conjunto de dados (planilha XLSX com uma aba por tabela, ZIP de CSVs e dump SQL com CREATE TABLE e INSERT no esquema de create_ibge.sql), com o tamanho e o checksum SHA-256 de cada um. Os pacotes trazem todas as tabelas de create_ibge.sql exceto alteracoes, o log de sincronização do seed, que é servido por /v1/alteracoes.
+// @Tags Downloads
+// @Produce json
+// @Param pagina query int false "Página (a partir de 1)"
+// @Param por_pagina query int false "Itens por página (padrão 100, máximo 1000)"
+// @Param cursor query string false "Cursor do header Link (vazio para começar a navegação por cursor)"
+// @Success 200 {object} respostaDownloads
+// @Header 200 {integer} X-Total-Count "Total de pacotes"
+// @Header 200 {string} Link "Links para as páginas first, prev, next e last (RFC 8288)"
+// @Failure 500 {object} problema
+// @Router /v1/downloads [get]
+func (h *IBGEHandler) GetDownloads(w http.ResponseWriter, r *http.Request) {
+	versao := h.versaoSelecionada(w)
+	resposta := respostaDownloads{VersaoDataset: versao, Downloads: make([]downloadDisponivel, 0, len(pacote.Formatos))}
+	for _, formato := range pacote.Formatos {
+		p, err := h.pacoteDaVersao(w, r, formato)
+		if err != nil {
+			log.Printf("Erro ao gerar o pacote %s da versão %s: %v", formato.Nome, versao, err)
+			respondWithError(w, r, http.StatusInternalServerError, domain.MsgErroInterno)
+			return
+		}
+		resposta.Downloads = append(resposta.Downloads, downloadDisponivel{
+			Formato: formato.Nome,
+			Arquivo: formato.NomeArquivo(versao),
+			URL:     fmt.Sprintf("/api/v1/downloads/%s?versao=%s", formato.Nome, versao),
+			Tamanho: len(p.conteudo),
+			SHA256:  hex.EncodeToString(p.sha256),
+		})
+	}
+	respond(w, r, http.StatusOK, resposta)
+}
+
+// GetDownload godoc
+// @Summary Baixa o conjunto de dados completo
+// @Description Baixa a edição completa do conjunto de dados como planilha XLSX (uma aba por tabela), ZIP de arquivos CSV ou dump SQL portável (SQLite, PostgreSQL e MySQL), sem a tabela alteracoes (ver /v1/alteracoes). O pacote é gerado uma vez por edição e mantido em cache; o checksum SHA-256 é informado no ETag e no header Content-Digest.
+// @Tags Downloads
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce application/zip
+// @Produce application/sql
+// @Param formato path string true "Formato do pacote" Enums(xlsx, csv, sql)
+// @Success 200 {file} file
+// @Failure 404 {object} problema "Formato não encontrado"
+// @Failure 500 {object} problema
+// @Router /v1/downloads/{formato} [get]
+func (h *IBGEHandler) GetDownload(w http.ResponseWriter, r *http.Request) {
+	nome := chi.URLParam(r, "formato")
+	formato, found := pacote.FormatoPorNome(nome)
+	if !found {
+		responderErro(w, r, domain.NaoEncontrado(domain.MsgFormatoDownloadNaoEncontrado, nome))
+		return
+	}
+
+	p, err := h.pacoteDaVersao(w, r, formato)
+	if err != nil {
+		log.Printf("Erro ao gerar o pacote %s: %v", formato.Nome, err)
+		respondWithError(w, r, http.StatusInternalServerError, domain.MsgErroInterno)
+		return
+	}
+
+	arquivo := formato.NomeArquivo(h.versaoSelecionada(w))
+	w.Header().Set("Content-Type", formato.TipoConteudo)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, arquivo))
+	w.Header().Set("ETag", `"`+hex.EncodeToString(p.sha256)+`"`)
+	w.Header().Set("Content-Digest", "sha-256=:"+base64.StdEncoding.EncodeToString(p.sha256)+":")
+	// ServeContent atende If-None-Match, If-Modified-Since e pedidos parciais (Range), úteis para retomar downloads
+	http.ServeContent(errosSemCache{w}, r, arquivo, ultimaModificacao(w), bytes.NewReader(p.conteudo))
+}
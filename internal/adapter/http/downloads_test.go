@@ -0,0 +1,88 @@
+package http
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestDownloads cobre a listagem e o download dos pacotes de dados.
+func TestDownloads(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("GET /api/v1/downloads - deve listar e servir os pacotes com checksum", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/downloads", nil)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusOK {
+			t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+		}
+		var lista respostaDownloads
+		if err := json.Unmarshal(rr.Body.Bytes(), &lista); err != nil {
+			t.Fatalf("Erro ao decodificar a lista: %v", err)
+		}
+		if lista.VersaoDataset != "2024" || len(lista.Downloads) != 3 {
+			t.Fatalf("Lista incorreta: %+v", lista)
+		}
+
+		for _, d := range lista.Downloads {
+			req := httptest.NewRequest("GET", d.URL, nil)
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			if status := rr.Code; status != http.StatusOK {
+				t.Fatalf("Status code incorreto para %s: got %v want %v", d.Formato, status, http.StatusOK)
+			}
+			soma := sha256.Sum256(rr.Body.Bytes())
+			if checksum := hex.EncodeToString(soma[:]); checksum != d.SHA256 || rr.Body.Len() != d.Tamanho {
+				t.Errorf("Pacote %s difere da lista: sha256 %s, tamanho %d", d.Formato, checksum, rr.Body.Len())
+			}
+			if etag := rr.Header().Get("ETag"); etag != `"`+d.SHA256+`"` {
+				t.Errorf("ETag incorreto para %s: got %v", d.Formato, etag)
+			}
+			if disposition := rr.Header().Get("Content-Disposition"); disposition != `attachment; filename="`+d.Arquivo+`"` {
+				t.Errorf("Content-Disposition incorreto para %s: got %v", d.Formato, disposition)
+			}
+
+			// O checksum também serve para revalidar o download
+			req = httptest.NewRequest("GET", d.URL, nil)
+			req.Header.Set("If-None-Match", `"`+d.SHA256+`"`)
+			rr = httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+			if status := rr.Code; status != http.StatusNotModified {
+				t.Errorf("Status code incorreto na revalidação de %s: got %v want %v", d.Formato, status, http.StatusNotModified)
+			}
+		}
+
+		req = httptest.NewRequest("GET", "/api/v1/downloads/sql?versao=2020", nil)
+		rr = httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		if disposition := rr.Header().Get("Content-Disposition"); disposition != `attachment; filename="ibge-2020.sql"` {
+			t.Errorf("Content-Disposition incorreto para a versão 2020: got %v", disposition)
+		}
+		if strings.Contains(rr.Body.String(), "Minas Gerais") {
+			t.Error("O pacote da versão 2020 não deveria conter MG")
+		}
+
+		// Um Range fora do pacote é recusado com 416, que não pode ficar em cache
+		req = httptest.NewRequest("GET", "/api/v1/downloads/sql", nil)
+		req.Header.Set("Range", "bytes=999999999-")
+		rr = httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusRequestedRangeNotSatisfiable || rr.Header().Get("Cache-Control") != "no-store" || rr.Header().Get("ETag") != "" {
+			t.Errorf("Range inválido deveria responder 416 sem cache: got %d %v", rr.Code, rr.Header())
+		}
+
+		req = httptest.NewRequest("GET", "/api/v1/downloads/pdf", nil)
+		rr = httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		if status := rr.Code; status != http.StatusNotFound {
+			t.Errorf("Status code incorreto para formato inexistente: got %v want %v", status, http.StatusNotFound)
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// TrailerTotalExportado é o trailer HTTP com a quantidade de municípios enviados pelo export.
+const TrailerTotalExportado = "X-Export-Total"
+
+// metadadosExport é a primeira linha do export NDJSON, antes dos municípios.
+type metadadosExport struct {
+	VersaoDataset string   `json:"versao_dataset"`
+	GeradoEm      string   `json:"gerado_em"`
+	UFs           []string `json:"ufs,omitempty"`
+	Regioes       []string `json:"regioes,omitempty"`
+}
+
+// linhaMetadadosExport envolve os metadados na chave _meta, para distingui-los das linhas de municípios.
+type linhaMetadadosExport struct {
+	Meta metadadosExport `json:"_meta"`
+}
+
+// valoresLista lê um parâmetro que aceita vários valores separados por vírgula ou repetidos
+// (ex: ?uf=SP,RJ ou ?uf=SP&uf=RJ).
+func valoresLista(r *http.Request, parametro string) []string {
+	var valores []string
+	for _, valor := range r.URL.Query()[parametro] {
+		for _, v := range strings.Split(valor, ",") {
+			if v = strings.ToUpper(strings.TrimSpace(v)); v != "" {
+				valores = append(valores, v)
+			}
+		}
+	}
+	return valores
+}
+
+// estadosExport resolve os estados a exportar a partir dos filtros ?uf= e ?regiao=, ordenados
+// pelo código IBGE. Sem filtros, todos os estados são exportados; com os dois, a interseção.
+func (h *IBGEHandler) estadosExport(r *http.Request, ufs, regioes []string) ([]domain.Estado, error) {
+	estados, err := h.casoDeUso(r).GetAllEstados()
+	if err != nil {
+		return nil, err
+	}
+
+	siglas := map[string]bool{}
+	for _, uf := range ufs {
+		estado, err := h.casoDeUso(r).GetEstadoByUF(uf)
+		if err != nil {
+			return nil, domain.ArgumentoInvalido(domain.MsgUFFiltroNaoEncontrada, uf)
+		}
+		siglas[estado.Sigla] = true
+	}
+	codigosRegioes := map[int]bool{}
+	for _, id := range regioes {
+		regiao, err := h.casoDeUso(r).GetRegiao(id)
+		if err != nil {
+			// Nos filtros, uma região inexistente é um parâmetro inválido, não um recurso ausente
+			return nil, domain.ArgumentoInvalido(domain.MsgRegiaoFiltroNaoEncontrada, id)
+		}
+		codigosRegioes[regiao.CodigoIBGE] = true
+	}
+
+	selecionados := []domain.Estado{}
+	for _, e := range estados {
+		if len(siglas) > 0 && !siglas[e.Sigla] {
+			continue
+		}
+		if len(codigosRegioes) > 0 && !codigosRegioes[e.Regiao().CodigoIBGE] {
+			continue
+		}
+		selecionados = append(selecionados, e)
+	}
+	sort.Slice(selecionados, func(i, j int) bool { return selecionados[i].CodigoIBGE < selecionados[j].CodigoIBGE })
+	return selecionados, nil
+}
+
+// ExportCidades godoc
+// @Summary Exporta todos os municípios em NDJSON
+// @Description Envia os municípios em JSON delimitado por linha (application/x-ndjson), estado a estado, sem montar a lista completa em memória. A primeira linha traz os metadados do export na chave _meta (versão do conjunto de dados, data de geração e filtros) e cada linha seguinte é um município. A quantidade de municípios enviados é informada no trailer X-Export-Total.
+// @Tags Cidades
+// @Produce application/x-ndjson
+// @Param uf query string false "Siglas dos estados, separadas por vírgula" example(SP,RJ)
+// @Param regiao query string false "Códigos ou siglas das Grandes Regiões, separados por vírgula" example(SE)
+// @Param expand query string false "aliases, estado e/ou regiao, separados por vírgula"
+// @Param fields query string false "Campos de cada cidade, separados por vírgula" example(codigo_ibge,nome)
+// @Success 200 {object} domain.Cidade "Um município por linha"
+// @Failure 400 {object} problema "Filtro inválido"
+// @Router /v1/cidades/export [get]
+func (h *IBGEHandler) ExportCidades(w http.ResponseWriter, r *http.Request) {
+	if err := validarAjustesRecursos(r, domain.Cidade{}); err != nil {
+		responderErro(w, r, err)
+		return
+	}
+
+	ufs, regioes := valoresLista(r, "uf"), valoresLista(r, "regiao")
+	estados, err := h.estadosExport(r, ufs, regioes)
+	if err != nil {
+		responderErro(w, r, err)
+		return
+	}
+
+	// O export reflete apenas o conjunto de dados, então Last-Modified basta para evitar reenviá-lo
+	if naoModificado(w, r) {
+		responderNaoModificado(w)
+		return
+	}
+
+	versao := h.versaoSelecionada(w)
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="cidades-%s.ndjson"`, versao))
+	w.Header().Set("Trailer", TrailerTotalExportado)
+	w.WriteHeader(http.StatusOK)
+
+	controlador := http.NewResponseController(w)
+	encoder := json.NewEncoder(w)
+	if err := encoder.Encode(linhaMetadadosExport{Meta: metadadosExport{
+		VersaoDataset: versao,
+		GeradoEm:      time.Now().UTC().Format(time.RFC3339),
+		UFs:           ufs,
+		Regioes:       regioes,
+	}}); err != nil {
+		return
+	}
+
+	// O status 200 já foi enviado: a partir daqui, um erro só pode interromper o stream,
+	// e o trailer ausente indica ao cliente que o export está incompleto.
+	total := 0
+	for _, estado := range estados {
+		if r.Context().Err() != nil {
+			return
+		}
+
+		cidades, err := h.casoDeUso(r).GetCidadesByEstadoUF(estado.Sigla)
+		if err != nil {
+			log.Printf("Erro ao exportar cidades do estado %s: %v", estado.Sigla, err)
+			return
+		}
+		cidades = cidadesParaResposta(r, cidades)
+		sort.Slice(cidades, func(i, j int) bool { return cidades[i].CodigoIBGE < cidades[j].CodigoIBGE })
+
+		for _, cidade := range cidades {
+			linha, err := ajustarRecursos(r, cidade)
+			if err != nil {
+				log.Printf("Erro ao ajustar os campos da cidade %d: %v", cidade.CodigoIBGE, err)
+				return
+			}
+			if err := encoder.Encode(linha); err != nil {
+				return
+			}
+		}
+		total += len(cidades)
+		_ = controlador.Flush()
+	}
+
+	w.Header().Set(TrailerTotalExportado, strconv.Itoa(total))
+}
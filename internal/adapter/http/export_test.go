@@ -0,0 +1,121 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// TestExportCidades cobre a exportação das cidades em stream.
+func TestExportCidades(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("GET /api/v1/cidades/export - deve exportar as cidades em NDJSON", func(t *testing.T) {
+		testCases := []struct {
+			name            string
+			query           string
+			expectedStatus  int
+			expectedCodigos []int
+		}{
+			{"todos os estados", "", http.StatusOK, []int{3106200, 3301702, 3304557, 3509502, 3550308, 3552205}},
+			{"filtro por UF", "?uf=rj", http.StatusOK, []int{3301702, 3304557}},
+			{"região sem estados no conjunto", "?regiao=N", http.StatusOK, []int{}},
+			{"UF inexistente", "?uf=XX", http.StatusBadRequest, nil},
+			{"região inexistente", "?regiao=9", http.StatusBadRequest, nil},
+			{"campo inexistente", "?fields=foo", http.StatusBadRequest, nil},
+			{"expansão inexistente", "?expand=bogus", http.StatusBadRequest, nil},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := httptest.NewRequest("GET", "/api/v1/cidades/export"+tc.query, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != tc.expectedStatus {
+					t.Fatalf("Status code incorreto: got %v want %v", status, tc.expectedStatus)
+				}
+				if tc.expectedStatus != http.StatusOK {
+					return
+				}
+				if contentType := rr.Header().Get("Content-Type"); contentType != "application/x-ndjson" {
+					t.Errorf("Content-Type incorreto: got %v", contentType)
+				}
+				if disposition := rr.Header().Get("Content-Disposition"); disposition != `attachment; filename="cidades-2024.ndjson"` {
+					t.Errorf("Content-Disposition incorreto: got %v", disposition)
+				}
+
+				linhas := strings.Split(strings.TrimSuffix(rr.Body.String(), "\n"), "\n")
+				var meta linhaMetadadosExport
+				if err := json.Unmarshal([]byte(linhas[0]), &meta); err != nil || meta.Meta.VersaoDataset != "2024" {
+					t.Fatalf("Primeira linha deveria trazer os metadados da versão 2024: %s", linhas[0])
+				}
+
+				codigos := []int{}
+				for _, linha := range linhas[1:] {
+					var cidade domain.Cidade
+					if err := json.Unmarshal([]byte(linha), &cidade); err != nil {
+						t.Fatalf("Linha inválida %q: %v", linha, err)
+					}
+					codigos = append(codigos, cidade.CodigoIBGE)
+				}
+				if !reflect.DeepEqual(codigos, tc.expectedCodigos) {
+					t.Errorf("Cidades incorretas: got %v want %v", codigos, tc.expectedCodigos)
+				}
+				if total := rr.Result().Trailer.Get(TrailerTotalExportado); total != strconv.Itoa(len(tc.expectedCodigos)) {
+					t.Errorf("Trailer %s incorreto: got %q want %d", TrailerTotalExportado, total, len(tc.expectedCodigos))
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/cidades/export - deve aplicar fields e expand em cada linha", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/cidades/export?uf=MG&expand=estado&fields=codigo_ibge,estado.codigo_ibge", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		linhas := strings.Split(strings.TrimSpace(rr.Body.String()), "\n")
+		if len(linhas) != 2 || linhas[1] != `{"codigo_ibge":3106200,"estado":{"codigo_ibge":31}}` {
+			t.Errorf("Linhas incorretas: got %v", linhas)
+		}
+	})
+}
+
+// mockRepositorioLento demora a listar as cidades de cada estado, como um banco sob carga.
+type mockRepositorioLento struct {
+	mockIBGERepository
+	atraso time.Duration
+}
+
+func (m *mockRepositorioLento) FindCidadesByEstadoUF(uf string) ([]domain.Cidade, error) {
+	time.Sleep(m.atraso)
+	return m.mockIBGERepository.FindCidadesByEstadoUF(uf)
+}
+
+// TestExportCidadesPrazo verifica que o export tem o seu próprio prazo, e não é truncado pelo
+// timeout das demais requisições.
+func TestExportCidadesPrazo(t *testing.T) {
+	t.Setenv("REQUEST_TIMEOUT", "20ms")
+	t.Setenv("EXPORT_TIMEOUT", "1m")
+	router := SetupRouter(NewIBGEHandler(novoRegistroVersoes(&mockRepositorioLento{atraso: 30 * time.Millisecond})))
+
+	t.Run("GET /api/v1/cidades/export - deve enviar todas as cidades além do timeout das requisições", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/cidades/export", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if total := rr.Result().Trailer.Get(TrailerTotalExportado); total != "6" {
+			t.Errorf("Export truncado: trailer %s = %q, want 6", TrailerTotalExportado, total)
+		}
+	})
+}
@@ -0,0 +1,478 @@
+package http
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+	"gopkg.in/yaml.v2"
+)
+
+// formatoResposta é um formato em que as respostas podem ser codificadas.
+type formatoResposta struct {
+	nome      string   // Valor aceito em ?formato=
+	tipos     []string // Tipos MIME reconhecidos no header Accept
+	conteudo  string   // Content-Type da resposta
+	tabular   bool     // Codifica apenas listas de recursos, sem envelope (ex: CSV)
+	codificar func(w io.Writer, r *http.Request, payload interface{}) error
+	// converter, se definido, troca o payload pela representação própria do formato antes de
+	// codificá-lo (ex: a mensagem protobuf) e retorna os parâmetros do Content-Type.
+	converter func(payload interface{}) (convertido interface{}, parametros string, err error)
+}
+
+// preparar aplica o converter do formato, se houver, e retorna o payload a codificar e o Content-Type.
+func (f *formatoResposta) preparar(payload interface{}) (interface{}, string, error) {
+	if f.converter == nil {
+		return payload, f.conteudo, nil
+	}
+	convertido, parametros, err := f.converter(payload)
+	return convertido, f.conteudo + parametros, err
+}
+
+// formatosResposta lista os formatos suportados; o primeiro é o padrão. Um novo formato só
+// precisa de um codificador registrado aqui: os handlers continuam chamando respond.
+var formatosResposta = []*formatoResposta{
+	{nome: "json", tipos: []string{"application/json"}, conteudo: "application/json", codificar: codificarJSON},
+	{nome: "csv", tipos: []string{"text/csv"}, conteudo: "text/csv; charset=utf-8", tabular: true, codificar: codificarCSV},
+	{nome: "xml", tipos: []string{"application/xml", "text/xml"}, conteudo: "application/xml; charset=utf-8", codificar: codificarXML},
+	{nome: "yaml", tipos: []string{"application/yaml", "application/x-yaml", "text/yaml"}, conteudo: "application/yaml; charset=utf-8", codificar: codificarYAML},
+	{nome: "msgpack", tipos: []string{"application/msgpack", "application/x-msgpack", "application/vnd.msgpack"}, conteudo: "application/msgpack", codificar: codificarMsgpack},
+	{nome: "cbor", tipos: []string{"application/cbor"}, conteudo: "application/cbor", codificar: codificarCBOR},
+	{nome: "protobuf", tipos: []string{"application/x-protobuf", "application/protobuf"}, conteudo: "application/x-protobuf", codificar: codificarProtobuf, converter: converterProtobuf},
+}
+
+// negociarFormato escolhe o formato da resposta. O parâmetro ?formato= tem precedência sobre
+// o header Accept, cujas preferências são respeitadas pelo fator q. Sem nenhum formato
+// reconhecido (ex: Accept: */* ou ausente), a resposta é em JSON.
+func negociarFormato(r *http.Request) (*formatoResposta, error) {
+	if nome := r.URL.Query().Get("formato"); nome != "" {
+		for _, f := range formatosResposta {
+			if strings.EqualFold(f.nome, nome) {
+				return f, nil
+			}
+		}
+		nomes := make([]string, 0, len(formatosResposta))
+		for _, f := range formatosResposta {
+			nomes = append(nomes, f.nome)
+		}
+		return nil, domain.ArgumentoInvalido(domain.MsgFormatoInvalido, nome, strings.Join(nomes, ", "))
+	}
+
+	for _, tipo := range tiposAceitos(r.Header.Get("Accept")) {
+		for _, f := range formatosResposta {
+			for _, t := range f.tipos {
+				if t == tipo {
+					return f, nil
+				}
+			}
+		}
+	}
+	return formatosResposta[0], nil
+}
+
+// tiposAceitos lê o header Accept e retorna os tipos MIME em ordem de preferência,
+// descartando os de q=0.
+func tiposAceitos(accept string) []string {
+	type preferencia struct {
+		tipo string
+		q    float64
+	}
+	var preferencias []preferencia
+	for _, parte := range strings.Split(accept, ",") {
+		tipo, params, err := mime.ParseMediaType(strings.TrimSpace(parte))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if valor, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(valor, 64); err != nil {
+				continue
+			}
+		}
+		if q > 0 {
+			preferencias = append(preferencias, preferencia{tipo, q})
+		}
+	}
+	sort.SliceStable(preferencias, func(i, j int) bool { return preferencias[i].q > preferencias[j].q })
+
+	tipos := make([]string, len(preferencias))
+	for i, p := range preferencias {
+		tipos[i] = p.tipo
+	}
+	return tipos
+}
+
+func codificarJSON(w io.Writer, _ *http.Request, payload interface{}) error {
+	dados, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(dados)
+	return err
+}
+
+// campoOrdenado e objetoOrdenado representam um objeto JSON mantendo a ordem dos campos,
+// para que as colunas do CSV e os elementos do XML sigam a ordem das structs do domínio.
+type campoOrdenado struct {
+	chave string
+	valor interface{}
+}
+
+type objetoOrdenado []campoOrdenado
+
+// valorGenerico converte o payload para a sua representação JSON (respeitando as tags json e
+// omitempty) em valores genéricos: objetoOrdenado, []interface{}, json.Number, string, bool ou nil.
+func valorGenerico(payload interface{}) (interface{}, error) {
+	dados, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(dados))
+	decoder.UseNumber()
+	return lerValor(decoder)
+}
+
+func lerValor(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch delim := token.(type) {
+	case json.Delim:
+		if delim == '[' {
+			lista := []interface{}{}
+			for decoder.More() {
+				valor, err := lerValor(decoder)
+				if err != nil {
+					return nil, err
+				}
+				lista = append(lista, valor)
+			}
+			_, err := decoder.Token() // ]
+			return lista, err
+		}
+
+		objeto := objetoOrdenado{}
+		for decoder.More() {
+			chave, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			valor, err := lerValor(decoder)
+			if err != nil {
+				return nil, err
+			}
+			objeto = append(objeto, campoOrdenado{chave.(string), valor})
+		}
+		_, err := decoder.Token() // }
+		return objeto, err
+	default:
+		return token, nil
+	}
+}
+
+// textoEscalar formata um valor escalar como texto; nil vira texto vazio.
+func textoEscalar(valor interface{}) string {
+	switch v := valor.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// codificarCSV escreve uma linha por item (ou uma única linha para um objeto), com uma linha
+// de cabeçalho. Objetos aninhados viram colunas com o caminho separado por ponto (ex:
+// regiao.sigla), listas de valores simples são unidas por "|" e listas de objetos são
+// escritas em JSON. Com ?bom=true, o arquivo começa com o BOM UTF-8 esperado pelo Excel.
+func codificarCSV(w io.Writer, r *http.Request, payload interface{}) error {
+	var colunas []string
+	var linhas []map[string]string
+	if tabela, ok := payload.(tabelaVazia); ok {
+		colunas = tabela.colunas
+	} else {
+		var err error
+		if colunas, linhas, err = linhasCSV(payload); err != nil {
+			return err
+		}
+	}
+
+	if bom, _ := strconv.ParseBool(r.URL.Query().Get("bom")); bom {
+		if _, err := io.WriteString(w, "\ufeff"); err != nil {
+			return err
+		}
+	}
+
+	escritor := csv.NewWriter(w)
+	if len(colunas) > 0 {
+		if err := escritor.Write(colunas); err != nil {
+			return err
+		}
+	}
+	for _, linha := range linhas {
+		registro := make([]string, len(colunas))
+		for i, coluna := range colunas {
+			registro[i] = linha[coluna]
+		}
+		if err := escritor.Write(registro); err != nil {
+			return err
+		}
+	}
+	escritor.Flush()
+	return escritor.Error()
+}
+
+// linhasCSV achata os itens do payload nas linhas do CSV, com as colunas na ordem em que aparecem.
+func linhasCSV(payload interface{}) ([]string, []map[string]string, error) {
+	valor, err := valorGenerico(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	itens, ok := valor.([]interface{})
+	if !ok {
+		itens = []interface{}{valor}
+	}
+
+	// Campos omitidos em alguns itens (omitempty) fazem colunas aparecerem só em itens
+	// posteriores; cada coluna nova entra logo após a coluna que a precede no item.
+	var colunas []string
+	vistas := map[string]bool{}
+	linhas := make([]map[string]string, 0, len(itens))
+	for _, item := range itens {
+		linha := map[string]string{}
+		anterior := -1
+		achatar("", item, linha, func(coluna string) {
+			if !vistas[coluna] {
+				vistas[coluna] = true
+				colunas = append(colunas[:anterior+1], append([]string{coluna}, colunas[anterior+1:]...)...)
+			}
+			for i, c := range colunas {
+				if c == coluna {
+					anterior = i
+					break
+				}
+			}
+		})
+		linhas = append(linhas, linha)
+	}
+	return colunas, linhas, nil
+}
+
+// tabelaVazia é o payload de uma lista sem itens no CSV: sem itens de onde tirar as colunas,
+// o cabeçalho é montado a partir do tipo dos itens.
+type tabelaVazia struct {
+	colunas []string
+}
+
+// tabelaDaListaVazia retorna a tabela vazia do payload, se ele for uma lista sem itens, com as
+// colunas do tipo dos itens já ajustadas por ?fields= e ?expand=.
+func tabelaDaListaVazia(r *http.Request, payload interface{}) (tabelaVazia, bool) {
+	lista := reflect.ValueOf(payload)
+	if !lista.IsValid() || lista.Kind() != reflect.Slice || lista.Len() > 0 {
+		return tabelaVazia{}, false
+	}
+	ajustes := lerAjustesRecursos(r)
+	esquema := ajustes.esquemaDoElemento(lista.Type().Elem(), map[reflect.Type]bool{})
+	colunas := esquema.colunasCSV("", ajustes.campos)
+	// Os aliases só vão nas cidades com ?expand=aliases
+	if lista.Type().Elem() == tipoCidade && !expandirAliases(r) {
+		colunas = slices.DeleteFunc(colunas, func(coluna string) bool { return coluna == "aliases" })
+	}
+	return tabelaVazia{colunas: colunas}, true
+}
+
+// colunasCSV lista as colunas do esquema como achatar as monta a partir dos valores: objetos
+// viram colunas com o caminho separado por ponto e listas de objetos ficam de fora.
+func (e *esquemaCampos) colunasCSV(prefixo string, campos arvoreCampos) []string {
+	if e.subcampos == nil || e.livre {
+		if prefixo == "" {
+			return []string{"valor"}
+		}
+		return []string{prefixo}
+	}
+	var colunas []string
+	for _, nome := range e.nomes {
+		subcampos, pedido := campos[nome]
+		campo := e.subcampos[nome]
+		if (len(campos) > 0 && !pedido) || (campo.lista && campo.subcampos != nil) {
+			continue
+		}
+		caminho := nome
+		if prefixo != "" {
+			caminho = prefixo + "." + nome
+		}
+		colunas = append(colunas, campo.colunasCSV(caminho, subcampos)...)
+	}
+	return colunas
+}
+
+// achatar preenche a linha do CSV com o valor, registrando cada coluna na ordem em que aparece.
+func achatar(prefixo string, valor interface{}, linha map[string]string, registrar func(string)) {
+	coluna := prefixo
+	if coluna == "" {
+		coluna = "valor"
+	}
+
+	switch v := valor.(type) {
+	case objetoOrdenado:
+		for _, campo := range v {
+			chave := campo.chave
+			if prefixo != "" {
+				chave = prefixo + "." + chave
+			}
+			achatar(chave, campo.valor, linha, registrar)
+		}
+		return
+	case []interface{}:
+		// Listas de objetos (ex: feriados, historico) não cabem em uma célula e ficam fora da
+		// tabela; as vazias também, pois não há como saber se seriam de objetos
+		if len(v) == 0 {
+			return
+		}
+		simples := make([]string, 0, len(v))
+		for _, item := range v {
+			switch item.(type) {
+			case objetoOrdenado, []interface{}:
+				return
+			}
+			simples = append(simples, textoEscalar(item))
+		}
+		registrar(coluna)
+		linha[coluna] = strings.Join(simples, "|")
+	default:
+		registrar(coluna)
+		linha[coluna] = textoEscalar(v)
+	}
+}
+
+// paraMapa converte os objetos ordenados de volta para mapas, para serialização em JSON, YAML,
+// MessagePack ou CBOR.
+func paraMapa(valor interface{}) interface{} {
+	switch v := valor.(type) {
+	case objetoOrdenado:
+		mapa := make(yaml.MapSlice, 0, len(v))
+		for _, campo := range v {
+			mapa = append(mapa, yaml.MapItem{Key: campo.chave, Value: paraMapa(campo.valor)})
+		}
+		return mapaOrdenado(mapa)
+	case []interface{}:
+		lista := make([]interface{}, len(v))
+		for i, item := range v {
+			lista[i] = paraMapa(item)
+		}
+		return lista
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	default:
+		return v
+	}
+}
+
+// mapaOrdenado é um yaml.MapSlice que também se serializa em JSON, MessagePack e CBOR mantendo
+// a ordem dos campos.
+type mapaOrdenado yaml.MapSlice
+
+func (m mapaOrdenado) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, item := range m {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		chave, _ := json.Marshal(item.Key)
+		valor, err := json.Marshal(item.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(chave)
+		buf.WriteByte(':')
+		buf.Write(valor)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (m mapaOrdenado) MarshalYAML() (interface{}, error) {
+	return yaml.MapSlice(m), nil
+}
+
+// codificarXML escreve o payload sob o elemento <resposta>. Cada campo vira um elemento com
+// o nome da chave JSON e cada item de lista vira um elemento <item>.
+func codificarXML(w io.Writer, _ *http.Request, payload interface{}) error {
+	valor, err := valorGenerico(payload)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	if err := escreverElementoXML(encoder, "resposta", valor); err != nil {
+		return err
+	}
+	return encoder.Flush()
+}
+
+func escreverElementoXML(encoder *xml.Encoder, nome string, valor interface{}) error {
+	inicio := xml.StartElement{Name: xml.Name{Local: nome}}
+	if err := encoder.EncodeToken(inicio); err != nil {
+		return err
+	}
+
+	switch v := valor.(type) {
+	case objetoOrdenado:
+		for _, campo := range v {
+			if err := escreverElementoXML(encoder, campo.chave, campo.valor); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if err := escreverElementoXML(encoder, "item", item); err != nil {
+				return err
+			}
+		}
+	default:
+		if err := encoder.EncodeToken(xml.CharData(textoEscalar(v))); err != nil {
+			return err
+		}
+	}
+	return encoder.EncodeToken(inicio.End())
+}
+
+func codificarYAML(w io.Writer, _ *http.Request, payload interface{}) error {
+	valor, err := valorGenerico(payload)
+	if err != nil {
+		return err
+	}
+	dados, err := yaml.Marshal(paraMapa(valor))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(dados)
+	return err
+}
@@ -0,0 +1,161 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/brauliohms/ibge-service/internal/adapter/protobuf"
+	"github.com/brauliohms/ibge-service/internal/domain"
+	ibgev1 "github.com/brauliohms/ibge-service/pkg/pb/ibge/v1"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+// Codificações binárias, mais compactas e rápidas de decodificar que o JSON, para consumidores
+// de alto volume e dispositivos embarcados. MessagePack e CBOR seguem as tags json das structs:
+// os nomes dos campos e os omitempty são os mesmos da resposta JSON.
+
+func codificarMsgpack(w io.Writer, _ *http.Request, payload interface{}) error {
+	encoder := msgpack.NewEncoder(w)
+	encoder.SetCustomStructTag("json")
+	encoder.UseCompactInts(true)
+	return encoder.Encode(payload)
+}
+
+func codificarCBOR(w io.Writer, _ *http.Request, payload interface{}) error {
+	return cbor.NewEncoder(w).Encode(payload)
+}
+
+func codificarProtobuf(w io.Writer, _ *http.Request, payload interface{}) error {
+	dados, err := proto.Marshal(payload.(proto.Message))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(dados)
+	return err
+}
+
+// errSemEsquemaProtobuf indica uma resposta sem mensagem correspondente em ibge.v1 (ex: v2, ?fields=,
+// ?expand=), que respond recusa com 406: sem esquema, o cliente não teria como decodificá-la.
+var errSemEsquemaProtobuf = errors.New("resposta sem mensagem protobuf em ibge.v1")
+
+// converterProtobuf converte as respostas da v1 nas mensagens de ibge.v1 (Estado, Cidade, Feriados,
+// FeedAlteracoes, Problema etc.). O nome da mensagem vai no parâmetro messageType do Content-Type,
+// para que o cliente saiba como decodificá-la.
+func converterProtobuf(payload interface{}) (interface{}, string, error) {
+	var mensagem proto.Message
+	switch p := payload.(type) {
+	case domain.Estado:
+		mensagem = protobuf.ParaEstado(p)
+	case *domain.Estado:
+		mensagem = protobuf.ParaEstado(*p)
+	case []domain.Estado:
+		mensagem = &ibgev1.Estados{Estados: protobuf.ParaEstados(p)}
+	case domain.Cidade:
+		mensagem = protobuf.ParaCidade(p)
+	case *domain.Cidade:
+		mensagem = protobuf.ParaCidade(*p)
+	case []domain.Cidade:
+		mensagem = &ibgev1.Cidades{Cidades: protobuf.ParaCidades(p)}
+	case []domain.Feriado:
+		mensagem = &ibgev1.Feriados{Feriados: protobuf.ParaFeriados(p)}
+	case *domain.DiasUteis:
+		mensagem = protobuf.ParaDiasUteis(*p)
+	case *domain.HoraLocal:
+		mensagem = protobuf.ParaHoraLocal(*p)
+	case *domain.ValidacaoEndereco:
+		mensagem = protobuf.ParaValidacaoEndereco(*p)
+	case respostaAlteracoes:
+		mensagem = protobuf.ParaFeedAlteracoes(domain.FeedAlteracoes(p))
+	case respostaVersoes:
+		mensagem = &ibgev1.Versoes{Padrao: p.Padrao, Versoes: p.Versoes}
+	case respostaDownloads:
+		mensagem = paraDownloads(p)
+	case respostaCidadeExtinta:
+		problema := paraProblema(p.problema)
+		problema.DataExtincao = p.DataExtincao
+		if p.Cidade != nil {
+			problema.Cidade = protobuf.ParaCidade(*p.Cidade)
+		}
+		problema.Sucessores = protobuf.ParaCidades(p.Sucessores)
+		mensagem = problema
+	case detalhesProblema:
+		mensagem = paraProblema(p.detalhes())
+	default:
+		return nil, "", errSemEsquemaProtobuf
+	}
+	return mensagem, fmt.Sprintf("; messageType=%q", proto.MessageName(mensagem)), nil
+}
+
+func paraDownloads(d respostaDownloads) *ibgev1.Downloads {
+	mensagem := &ibgev1.Downloads{VersaoDataset: d.VersaoDataset}
+	for _, download := range d.Downloads {
+		mensagem.Downloads = append(mensagem.Downloads, &ibgev1.Download{
+			Formato: download.Formato,
+			Arquivo: download.Arquivo,
+			Url:     download.URL,
+			Tamanho: int64(download.Tamanho),
+			Sha256:  download.SHA256,
+		})
+	}
+	return mensagem
+}
+
+func paraProblema(p problema) *ibgev1.Problema {
+	return &ibgev1.Problema{
+		Type:      p.Type,
+		Title:     p.Title,
+		Status:    int32(p.Status),
+		Detail:    p.Detail,
+		Code:      p.Code,
+		Instance:  p.Instance,
+		RequestId: p.RequestID,
+	}
+}
+
+// EncodeMsgpack escreve o mapa em MessagePack mantendo a ordem dos campos.
+func (m mapaOrdenado) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if err := encoder.EncodeMapLen(len(m)); err != nil {
+		return err
+	}
+	for _, item := range m {
+		if err := encoder.Encode(item.Key); err != nil {
+			return err
+		}
+		if err := encoder.Encode(item.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalCBOR escreve o mapa em CBOR mantendo a ordem dos campos: o cabeçalho do mapa (tipo
+// maior 5, com a quantidade de pares) seguido de cada chave e valor.
+func (m mapaOrdenado) MarshalCBOR() ([]byte, error) {
+	var buf bytes.Buffer
+	n := len(m)
+	switch {
+	case n < 24:
+		buf.WriteByte(0xa0 | byte(n))
+	case n <= 0xff:
+		buf.Write([]byte{0xb8, byte(n)})
+	case n <= 0xffff:
+		buf.Write([]byte{0xb9, byte(n >> 8), byte(n)})
+	default:
+		buf.Write([]byte{0xba, byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)})
+	}
+	for _, item := range m {
+		for _, valor := range []interface{}{item.Key, item.Value} {
+			dados, err := cbor.Marshal(valor)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(dados)
+		}
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,230 @@
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+	ibgev1 "github.com/brauliohms/ibge-service/pkg/pb/ibge/v1"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestFormatosBinarios cobre as codificações binárias (MessagePack, CBOR e Protocol Buffers).
+func TestFormatosBinarios(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("GET /api/v1/estados/{uf}/cidades - deve responder nas codificações binárias", func(t *testing.T) {
+		decodificadores := map[string]func(t *testing.T, corpo []byte) []int{
+			"application/msgpack": func(t *testing.T, corpo []byte) []int {
+				decoder := msgpack.NewDecoder(bytes.NewReader(corpo))
+				decoder.SetCustomStructTag("json")
+				var cidades []domain.Cidade
+				if err := decoder.Decode(&cidades); err != nil {
+					t.Fatalf("MessagePack inválido: %v", err)
+				}
+				return codigosCidades(cidades)
+			},
+			"application/cbor": func(t *testing.T, corpo []byte) []int {
+				var cidades []domain.Cidade
+				if err := cbor.Unmarshal(corpo, &cidades); err != nil {
+					t.Fatalf("CBOR inválido: %v", err)
+				}
+				return codigosCidades(cidades)
+			},
+			"application/x-protobuf": func(t *testing.T, corpo []byte) []int {
+				var mensagem ibgev1.Cidades
+				if err := proto.Unmarshal(corpo, &mensagem); err != nil {
+					t.Fatalf("Protobuf inválido: %v", err)
+				}
+				codigos := []int{}
+				for _, c := range mensagem.GetCidades() {
+					codigos = append(codigos, int(c.GetCodigoIbge()))
+				}
+				return codigos
+			},
+		}
+		contentTypes := map[string]string{
+			"application/msgpack":    "application/msgpack",
+			"application/cbor":       "application/cbor",
+			"application/x-protobuf": `application/x-protobuf; messageType="ibge.v1.Cidades"`,
+		}
+		esperados := []int{3550308, 3509502, 3552205}
+
+		for accept, decodificar := range decodificadores {
+			t.Run(accept, func(t *testing.T) {
+				req := httptest.NewRequest("GET", "/api/v1/estados/SP/cidades", nil)
+				req.Header.Set("Accept", accept)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != http.StatusOK {
+					t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+				}
+				if contentType := rr.Header().Get("Content-Type"); contentType != contentTypes[accept] {
+					t.Errorf("Content-Type incorreto: got %v want %v", contentType, contentTypes[accept])
+				}
+				if codigos := decodificar(t, rr.Body.Bytes()); !reflect.DeepEqual(codigos, esperados) {
+					t.Errorf("Cidades incorretas: got %v want %v", codigos, esperados)
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/estados/{uf} - deve responder em protobuf com a mensagem adequada", func(t *testing.T) {
+		testCases := []struct {
+			name                string
+			url                 string
+			expectedStatus      int
+			expectedContentType string
+		}{
+			{"estado", "/api/v1/estados/SP?formato=protobuf", http.StatusOK, `application/x-protobuf; messageType="ibge.v1.Estado"`},
+			{"erro", "/api/v1/estados/XX?formato=protobuf", http.StatusNotFound, `application/x-protobuf; messageType="ibge.v1.Problema"`},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := httptest.NewRequest("GET", tc.url, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != tc.expectedStatus {
+					t.Fatalf("Status code incorreto: got %v want %v", status, tc.expectedStatus)
+				}
+				if contentType := rr.Header().Get("Content-Type"); contentType != tc.expectedContentType {
+					t.Errorf("Content-Type incorreto: got %v want %v", contentType, tc.expectedContentType)
+				}
+			})
+		}
+
+		req := httptest.NewRequest("GET", "/api/v1/estados/SP?formato=protobuf", nil)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		var estado ibgev1.Estado
+		if err := proto.Unmarshal(rr.Body.Bytes(), &estado); err != nil || estado.GetSigla() != "SP" || estado.GetRegiao().GetSigla() != "SE" {
+			t.Errorf("Estado incorreto: %v (%v)", &estado, err)
+		}
+
+		req = httptest.NewRequest("GET", "/api/v1/estados/XX?formato=protobuf", nil)
+		rr = httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		var erro ibgev1.Problema
+		if err := proto.Unmarshal(rr.Body.Bytes(), &erro); err != nil {
+			t.Fatalf("Erro em protobuf inválido: %v", err)
+		}
+		if erro.GetDetail() != "estado com a sigla XX não encontrado" || erro.GetStatus() != http.StatusNotFound || erro.GetCode() != "estado_sigla_nao_encontrado" {
+			t.Errorf("Problema incorreto: %v", &erro)
+		}
+	})
+
+	t.Run("GET /api/v1/cidades/{codigo_ibge} - deve incluir os feriados municipais na mensagem", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/cidades/3550308?formato=protobuf", nil)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		var cidade ibgev1.Cidade
+		if err := proto.Unmarshal(rr.Body.Bytes(), &cidade); err != nil {
+			t.Fatalf("Protobuf inválido: %v", err)
+		}
+		feriados := cidade.GetFeriados()
+		if len(feriados) != 1 || feriados[0].GetNome() != "Aniversário de São Paulo" || feriados[0].GetDia() != 25 || feriados[0].GetMes() != 1 {
+			t.Errorf("Feriados incorretos: %v", feriados)
+		}
+	})
+
+	t.Run("GET /api/v1/... - deve responder em protobuf os demais recursos da v1", func(t *testing.T) {
+		testCases := []struct {
+			url      string
+			mensagem proto.Message
+			valido   func(m proto.Message) bool
+		}{
+			{"/api/v1/feriados?cidade=3550308&ano=2024", &ibgev1.Feriados{}, func(m proto.Message) bool {
+				feriados := m.(*ibgev1.Feriados).GetFeriados()
+				return len(feriados) > 0 && feriados[0].GetData() == "2024-01-01" && feriados[0].GetTipo() == "nacional"
+			}},
+			{"/api/v1/dias-uteis?cidade=3550308&inicio=2024-01-23&dias=1", &ibgev1.DiasUteis{}, func(m proto.Message) bool {
+				d := m.(*ibgev1.DiasUteis)
+				return d.GetCodigoIbge() == 3550308 && d.GetDataFinal() == "2024-01-24" && d.GetDias() == 1
+			}},
+			{"/api/v1/cidades/3550308/hora-local", &ibgev1.HoraLocal{}, func(m proto.Message) bool {
+				h := m.(*ibgev1.HoraLocal)
+				return h.GetFusoHorario() == "America/Sao_Paulo" && h.GetUtcOffset() == "-03:00" && h.GetHoraLocal() != ""
+			}},
+			{"/api/v1/cep/01310100/validacao?cidade=3550308&uf=SP", &ibgev1.ValidacaoEndereco{}, func(m proto.Message) bool {
+				v := m.(*ibgev1.ValidacaoEndereco)
+				return v.GetValido() && v.GetCidadeEsperada().GetCodigoIbge() == 3550308 && v.GetEstadoEsperado().GetSigla() == "SP"
+			}},
+			{"/api/v1/alteracoes?desde=0", &ibgev1.FeedAlteracoes{}, func(m proto.Message) bool {
+				f := m.(*ibgev1.FeedAlteracoes)
+				alteracoes := f.GetAlteracoes()
+				return f.GetVersaoAtual() == 2 && len(alteracoes) == 2 && alteracoes[1].GetCampos()[0].GetDepois() == "Campinas"
+			}},
+			{"/api/v1/versoes", &ibgev1.Versoes{}, func(m proto.Message) bool {
+				v := m.(*ibgev1.Versoes)
+				return v.GetPadrao() != "" && len(v.GetVersoes()) > 0
+			}},
+			{"/api/v1/downloads", &ibgev1.Downloads{}, func(m proto.Message) bool {
+				d := m.(*ibgev1.Downloads)
+				return len(d.GetDownloads()) > 0 && d.GetDownloads()[0].GetTamanho() > 0 && len(d.GetDownloads()[0].GetSha256()) == 64
+			}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.url, func(t *testing.T) {
+				req := httptest.NewRequest("GET", tc.url, nil)
+				req.Header.Set("Accept", "application/x-protobuf")
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != http.StatusOK {
+					t.Fatalf("Status code incorreto: got %v want %v (%s)", status, http.StatusOK, rr.Body.String())
+				}
+				esperado := fmt.Sprintf("application/x-protobuf; messageType=%q", proto.MessageName(tc.mensagem))
+				if contentType := rr.Header().Get("Content-Type"); contentType != esperado {
+					t.Errorf("Content-Type incorreto: got %v want %v", contentType, esperado)
+				}
+				if err := proto.Unmarshal(rr.Body.Bytes(), tc.mensagem); err != nil || !tc.valido(tc.mensagem) {
+					t.Errorf("Mensagem incorreta: %v (%v)", tc.mensagem, err)
+				}
+			})
+		}
+	})
+
+	t.Run("deve responder 406 às respostas sem mensagem em ibge.v1", func(t *testing.T) {
+		urls := []string{
+			"/api/v2/cidades/3550308",
+			"/api/v2/estados",
+			"/api/v1/cidades/3550308?fields=nome",
+			"/api/v1/cidades/3550308?expand=estado",
+			"/api/v1/estados/SP/cidades?fields=nome",
+		}
+		for _, url := range urls {
+			t.Run(url, func(t *testing.T) {
+				req := httptest.NewRequest("GET", url, nil)
+				req.Header.Set("Accept", "application/x-protobuf")
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != http.StatusNotAcceptable {
+					t.Fatalf("Status code incorreto: got %v want %v (%s)", status, http.StatusNotAcceptable, rr.Body.String())
+				}
+				var erro ibgev1.Problema
+				if err := proto.Unmarshal(rr.Body.Bytes(), &erro); err != nil || erro.GetCode() != "formato_sem_esquema" {
+					t.Errorf("Problema incorreto: %v (%v)", &erro, err)
+				}
+				if total := rr.Header().Get(HeaderTotalRegistros); total != "" {
+					t.Errorf("X-Total-Count não deveria ser enviado: %s", total)
+				}
+			})
+		}
+	})
+}
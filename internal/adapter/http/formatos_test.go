@@ -0,0 +1,128 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestFormatosResposta cobre a negociação do formato da resposta.
+func TestFormatosResposta(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("GET /api/v1/estados/{uf}/cidades - deve negociar o formato da resposta", func(t *testing.T) {
+		testCases := []struct {
+			name                string
+			query               string
+			accept              string
+			expectedStatus      int
+			expectedContentType string
+			expectedInicio      string
+		}{
+			{"CSV pelo parâmetro", "?formato=csv", "", http.StatusOK, "text/csv; charset=utf-8", "codigo_ibge,nome,codigo_tom,estado_codigo_ibge,estado_sigla,estado_nome,zona_costeira\n3550308,São Paulo,7107,35,,,false\n"},
+			{"CSV com BOM", "?formato=csv&bom=true", "", http.StatusOK, "text/csv; charset=utf-8", "\ufeffcodigo_ibge,nome"},
+			{"XML pelo Accept", "", "application/xml", http.StatusOK, "application/xml; charset=utf-8", `<?xml version="1.0" encoding="UTF-8"?>` + "\n<resposta><item><codigo_ibge>3550308</codigo_ibge>"},
+			{"YAML pelo fator q", "", "text/html, application/yaml;q=0.9, application/json;q=0.5", http.StatusOK, "application/yaml; charset=utf-8", "- codigo_ibge: 3550308\n  nome: São Paulo"},
+			{"parâmetro tem precedência", "?formato=json", "text/csv", http.StatusOK, "application/json", `[{"codigo_ibge":3550308`},
+			{"Accept genérico", "", "*/*", http.StatusOK, "application/json", `[{"codigo_ibge":3550308`},
+			{"formato inválido", "?formato=pdf", "", http.StatusBadRequest, "application/problem+json", `{"type":"urn:ibge-service:problema:parametro-invalido","title":"Parâmetro inválido","status":400,"detail":"formato \"pdf\" inválido`},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := httptest.NewRequest("GET", "/api/v1/estados/SP/cidades"+tc.query, nil)
+				if tc.accept != "" {
+					req.Header.Set("Accept", tc.accept)
+				}
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != tc.expectedStatus {
+					t.Fatalf("Status code incorreto: got %v want %v", status, tc.expectedStatus)
+				}
+				if contentType := rr.Header().Get("Content-Type"); contentType != tc.expectedContentType {
+					t.Errorf("Content-Type incorreto: got %v want %v", contentType, tc.expectedContentType)
+				}
+				if !strings.HasPrefix(rr.Body.String(), tc.expectedInicio) {
+					t.Errorf("Corpo incorreto.\ngot:  %s\nwant: %s...", rr.Body.String(), tc.expectedInicio)
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/cidades/{codigo} - o CSV não deve incluir listas de objetos", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/cidades/3550308?formato=csv&expand=aliases", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		esperado := "codigo_ibge,nome,aliases,codigo_tom,fuso_horario,estado_codigo_ibge,estado_sigla,estado_nome,zona_costeira\n" +
+			"3550308,São Paulo,Sampa,7107,America/Sao_Paulo,35,SP,,false\n"
+		if corpo := rr.Body.String(); corpo != esperado {
+			t.Errorf("Corpo incorreto.\ngot:  %s\nwant: %s", corpo, esperado)
+		}
+	})
+
+	t.Run("GET /api/v1/estados/{uf}/cidades - o CSV de uma lista vazia deve ter o cabeçalho", func(t *testing.T) {
+		for _, tc := range []struct {
+			query    string
+			esperado string
+		}{
+			{"", "codigo_ibge,nome,gentilico,codigo_tom,micro_regiao,regiao_imediata,fuso_horario,estado_codigo_ibge," +
+				"estado_sigla,estado_nome,amazonia_legal,semiarido,faixa_fronteira,paises_fronteira,zona_costeira,matopiba\n"},
+			{"&fields=nome,estado_sigla", "nome,estado_sigla\n"},
+			{"&fields=nome,aliases&expand=aliases", "nome,aliases\n"},
+		} {
+			req := httptest.NewRequest("GET", "/api/v1/estados/SP/cidades?formato=csv&nome_contem=zzz"+tc.query, nil)
+			rr := httptest.NewRecorder()
+
+			router.ServeHTTP(rr, req)
+
+			if corpo := rr.Body.String(); corpo != tc.esperado {
+				t.Errorf("Corpo incorreto para %q.\ngot:  %s\nwant: %s", tc.query, corpo, tc.esperado)
+			}
+		}
+	})
+
+	t.Run("GET /api/v1/... - deve comprimir os formatos textuais", func(t *testing.T) {
+		for _, path := range []string{
+			"/api/v1/estados/SP/cidades?formato=csv",
+			"/api/v1/estados/SP/cidades?formato=xml",
+			"/api/v1/estados/SP/cidades?formato=yaml",
+			"/api/v1/estados/XX",
+		} {
+			t.Run(path, func(t *testing.T) {
+				req := httptest.NewRequest("GET", path, nil)
+				req.Header.Set("Accept-Encoding", "gzip")
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if codificacao := rr.Header().Get("Content-Encoding"); codificacao != "gzip" {
+					t.Errorf("Content-Encoding incorreto para %s: got %q want gzip", rr.Header().Get("Content-Type"), codificacao)
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/estados/{uf} - deve responder erros no formato negociado", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/estados/XX?formato=csv", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusNotFound {
+			t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusNotFound)
+		}
+		expected := "type,title,status,detail,code,instance,request_id\n" +
+			"urn:ibge-service:problema:nao-encontrado,Recurso não encontrado,404,estado com a sigla XX não encontrado,estado_sigla_nao_encontrado,/api/v1/estados/XX?formato=csv,"
+		if !strings.HasPrefix(rr.Body.String(), expected) {
+			t.Errorf("Corpo incorreto: got %q want %q...", rr.Body.String(), expected)
+		}
+		if contentType := rr.Header().Get("Content-Type"); contentType != "text/csv; charset=utf-8" {
+			t.Errorf("Content-Type incorreto: got %v", contentType)
+		}
+	})
+}
@@ -0,0 +1,408 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+	"github.com/brauliohms/ibge-service/internal/usecase"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// Limites das consultas GraphQL, verificados antes da execução para proteger o serviço
+// de consultas que percorrem as relações estado → cidades → estado indefinidamente.
+const (
+	maxProfundidadeGraphQL = 5
+	maxComplexidadeGraphQL = 25000
+)
+
+// tamanhosGraphQL guarda quantos itens cada campo de lista pode retornar, para o cálculo da
+// complexidade: o custo de um campo é 1 mais o custo dos seus subcampos vezes o tamanho da lista.
+type tamanhosGraphQL struct {
+	estados          int // Query.estados
+	cidades          int // Query.cidades sem uf: todos os municípios
+	cidadesPorEstado int // Query.cidades(uf) e Estado.cidades: o estado com mais municípios
+}
+
+// tamanhosGraphQLMinimos são os da DTB 2024 (27 UFs, 5.570 municípios, 853 em Minas Gerais), usados
+// quando o conjunto de dados carregado é menor, para que o limite não dependa da edição servida.
+var tamanhosGraphQLMinimos = tamanhosGraphQL{estados: 27, cidades: 5570, cidadesPorEstado: 853}
+
+// medirTamanhosGraphQL conta os estados e municípios de todas as edições do conjunto de dados e
+// fica com os maiores valores, pois o schema é o mesmo para todas. Uma edição que falhe é ignorada.
+func medirTamanhosGraphQL(versoes *usecase.RegistroVersoes) tamanhosGraphQL {
+	tamanhos := tamanhosGraphQLMinimos
+	for _, versao := range versoes.Versoes() {
+		uc, _, err := versoes.Versao(versao)
+		if err != nil {
+			continue
+		}
+		estados, err := uc.GetAllEstados()
+		if err != nil {
+			log.Printf("Tamanhos GraphQL da versão %s não medidos: %v", versao, err)
+			continue
+		}
+		tamanhos.estados = max(tamanhos.estados, len(estados))
+		total := 0
+		for _, estado := range estados {
+			cidades, err := uc.GetCidadesByEstadoUF(estado.Sigla)
+			if err != nil {
+				log.Printf("Tamanhos GraphQL da versão %s não medidos: %v", versao, err)
+				break
+			}
+			total += len(cidades)
+			tamanhos.cidadesPorEstado = max(tamanhos.cidadesPorEstado, len(cidades))
+		}
+		tamanhos.cidades = max(tamanhos.cidades, total)
+	}
+	return tamanhos
+}
+
+// tamanhoLista retorna quantos itens o campo pode retornar, ou 0 se ele não for uma lista. Só a
+// raiz (Query) tem o campo cidades sem estado, que lista todos os municípios.
+func (t tamanhosGraphQL) tamanhoLista(campo *ast.Field, raiz bool) int {
+	switch campo.Name.Value {
+	case "estados":
+		return t.estados
+	case "cidades":
+		if raiz && !temArgumento(campo, "uf") {
+			return t.cidades
+		}
+		return t.cidadesPorEstado
+	}
+	return 0
+}
+
+func temArgumento(campo *ast.Field, nome string) bool {
+	for _, arg := range campo.Arguments {
+		if arg.Name.Value == nome {
+			return true
+		}
+	}
+	return false
+}
+
+// requisicaoGraphQL é o corpo de POST /graphql.
+type requisicaoGraphQL struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// respostaGraphQL é o corpo das respostas de POST /graphql.
+type respostaGraphQL struct {
+	Data   interface{}                `json:"data,omitempty"`
+	Errors []gqlerrors.FormattedError `json:"errors,omitempty"`
+}
+
+// novoSchemaGraphQL monta o schema com os resolvers sobre o caso de uso da versão selecionada.
+func (h *IBGEHandler) novoSchemaGraphQL() (graphql.Schema, error) {
+	regiaoType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Regiao",
+		Description: "Grande Região do Brasil",
+		Fields: graphql.Fields{
+			"codigo_ibge": &graphql.Field{Type: graphql.Int},
+			"sigla":       &graphql.Field{Type: graphql.String},
+			"nome":        &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	filtroType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "FiltroCidades",
+		Description: "Filtra cidades pelo nome (oficial ou alternativo)",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"nome": &graphql.InputObjectFieldConfig{Type: graphql.String},
+		},
+	})
+
+	var estadoType *graphql.Object
+	cidadeType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Cidade",
+		Description: "Município brasileiro",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"codigo_ibge":      &graphql.Field{Type: graphql.Int},
+				"nome":             &graphql.Field{Type: graphql.String},
+				"gentilico":        &graphql.Field{Type: graphql.String},
+				"aliases":          &graphql.Field{Type: graphql.NewList(graphql.String)},
+				"codigo_tom":       &graphql.Field{Type: graphql.String},
+				"micro_regiao":     &graphql.Field{Type: graphql.String},
+				"regiao_imediata":  &graphql.Field{Type: graphql.String},
+				"fuso_horario":     &graphql.Field{Type: graphql.String},
+				"amazonia_legal":   &graphql.Field{Type: graphql.Boolean},
+				"semiarido":        &graphql.Field{Type: graphql.Boolean},
+				"faixa_fronteira":  &graphql.Field{Type: graphql.Boolean},
+				"paises_fronteira": &graphql.Field{Type: graphql.NewList(graphql.String)},
+				"zona_costeira":    &graphql.Field{Type: graphql.Boolean},
+				"matopiba":         &graphql.Field{Type: graphql.Boolean},
+				"estado": &graphql.Field{
+					Type: estadoType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						cidade := p.Source.(domain.Cidade)
+						return h.casoDeUsoDoContexto(p.Context).GetEstadoByCodigoIbge(strconv.Itoa(cidade.EstadoCodigoIBGE))
+					},
+				},
+			}
+		}),
+	})
+
+	estadoType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Estado",
+		Description: "Unidade Federativa do Brasil",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"codigo_ibge":  &graphql.Field{Type: graphql.Int},
+				"nome":         &graphql.Field{Type: graphql.String},
+				"sigla":        &graphql.Field{Type: graphql.String},
+				"fuso_horario": &graphql.Field{Type: graphql.String},
+				"regiao": &graphql.Field{
+					Type: regiaoType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return estadoDaFonte(p.Source).Regiao(), nil
+					},
+				},
+				"cidades": &graphql.Field{
+					Type: graphql.NewList(cidadeType),
+					Args: graphql.FieldConfigArgument{
+						"filtro": &graphql.ArgumentConfig{Type: filtroType},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return h.resolverCidades(p.Context, estadoDaFonte(p.Source).Sigla, p.Args)
+					},
+				},
+			}
+		}),
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"estados": &graphql.Field{
+				Type:        graphql.NewList(estadoType),
+				Description: "Todos os estados",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return h.casoDeUsoDoContexto(p.Context).GetAllEstados()
+				},
+			},
+			"estado": &graphql.Field{
+				Type:        estadoType,
+				Description: "Um estado, pela sigla (uf) ou pelo código IBGE (codigo)",
+				Args: graphql.FieldConfigArgument{
+					"uf":     &graphql.ArgumentConfig{Type: graphql.String},
+					"codigo": &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					uc := h.casoDeUsoDoContexto(p.Context)
+					uf, temUF := p.Args["uf"].(string)
+					codigo, temCodigo := p.Args["codigo"].(int)
+					switch {
+					case temUF == temCodigo:
+						return nil, domain.ArgumentoInvalido(domain.MsgGraphQLEstadoSemArgumento)
+					case temUF:
+						return uc.GetEstadoByUF(strings.ToUpper(uf))
+					default:
+						return uc.GetEstadoByCodigoIbge(strconv.Itoa(codigo))
+					}
+				},
+			},
+			"cidades": &graphql.Field{
+				Type:        graphql.NewList(cidadeType),
+				Description: "Cidades de um estado (uf) ou de todo o país",
+				Args: graphql.FieldConfigArgument{
+					"uf":     &graphql.ArgumentConfig{Type: graphql.String},
+					"filtro": &graphql.ArgumentConfig{Type: filtroType},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					uf, _ := p.Args["uf"].(string)
+					return h.resolverCidades(p.Context, strings.ToUpper(uf), p.Args)
+				},
+			},
+			"cidade": &graphql.Field{
+				Type:        cidadeType,
+				Description: "Uma cidade, pelo código IBGE (codigo) ou pelo código TOM (tom)",
+				Args: graphql.FieldConfigArgument{
+					"codigo": &graphql.ArgumentConfig{Type: graphql.Int},
+					"tom":    &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					uc := h.casoDeUsoDoContexto(p.Context)
+					codigo, temCodigo := p.Args["codigo"].(int)
+					tom, temTOM := p.Args["tom"].(string)
+					var cidade *domain.Cidade
+					var err error
+					switch {
+					case temCodigo == temTOM:
+						return nil, domain.ArgumentoInvalido(domain.MsgGraphQLCidadeSemArgumento)
+					case temCodigo:
+						cidade, err = uc.GetCidadeByCodigo(strconv.Itoa(codigo))
+					default:
+						cidade, err = uc.GetCidadeByCodigoTOM(tom)
+					}
+					if err != nil {
+						return nil, err
+					}
+					return *cidade, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+// estadoDaFonte normaliza o estado resolvido pelo campo pai, que pode vir como valor ou ponteiro.
+func estadoDaFonte(fonte interface{}) domain.Estado {
+	if e, ok := fonte.(*domain.Estado); ok {
+		return *e
+	}
+	return fonte.(domain.Estado)
+}
+
+// resolverCidades lista as cidades de um estado (ou de todos, se uf for vazia) e aplica o filtro.
+func (h *IBGEHandler) resolverCidades(ctx context.Context, uf string, args map[string]interface{}) ([]domain.Cidade, error) {
+	uc := h.casoDeUsoDoContexto(ctx)
+	filtro, _ := args["filtro"].(map[string]interface{})
+
+	var cidades []domain.Cidade
+	var err error
+	switch nome, _ := filtro["nome"].(string); {
+	case nome != "":
+		cidades, err = uc.BuscarCidades(nome, uf)
+	case uf != "":
+		cidades, err = uc.GetCidadesByEstadoUF(uf)
+	default:
+		cidades, err = uc.GetAllCidades()
+	}
+	return cidades, err
+}
+
+// verificarLimites rejeita consultas mais profundas ou mais complexas que os limites.
+// Campos de introspecção (__schema, __type) não entram no cálculo.
+func (t tamanhosGraphQL) verificarLimites(doc *ast.Document) error {
+	fragmentos := map[string]*ast.FragmentDefinition{}
+	for _, def := range doc.Definitions {
+		if f, ok := def.(*ast.FragmentDefinition); ok {
+			fragmentos[f.Name.Value] = f
+		}
+	}
+
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		custo, profundidade := t.medirSelecao(op.SelectionSet, fragmentos, true)
+		if profundidade > maxProfundidadeGraphQL {
+			return domain.ArgumentoInvalido(domain.MsgGraphQLProfundidade, profundidade, maxProfundidadeGraphQL)
+		}
+		if custo > maxComplexidadeGraphQL {
+			return domain.ArgumentoInvalido(domain.MsgGraphQLComplexidade, custo, maxComplexidadeGraphQL)
+		}
+	}
+	return nil
+}
+
+// medirSelecao calcula o custo e a profundidade de um conjunto de seleções, expandindo os fragmentos.
+// Os ciclos entre fragmentos já foram rejeitados pela validação do documento.
+func (t tamanhosGraphQL) medirSelecao(selecao *ast.SelectionSet, fragmentos map[string]*ast.FragmentDefinition, raiz bool) (custo, profundidade int) {
+	if selecao == nil {
+		return 0, 0
+	}
+	for _, s := range selecao.Selections {
+		var c, p int
+		switch s := s.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name.Value, "__") {
+				continue
+			}
+			filhos, pf := t.medirSelecao(s.SelectionSet, fragmentos, false)
+			multiplicador := 1
+			if tamanho := t.tamanhoLista(s, raiz); tamanho > 0 {
+				multiplicador = tamanho
+			}
+			c, p = 1+filhos*multiplicador, pf+1
+		case *ast.InlineFragment:
+			c, p = t.medirSelecao(s.SelectionSet, fragmentos, raiz)
+		case *ast.FragmentSpread:
+			if f, ok := fragmentos[s.Name.Value]; ok {
+				c, p = t.medirSelecao(f.SelectionSet, fragmentos, raiz)
+			}
+		}
+		custo += c
+		profundidade = max(profundidade, p)
+	}
+	return custo, profundidade
+}
+
+// GraphQL godoc
+// @Summary Consulta GraphQL sobre estados e cidades
+// @Description Executa uma consulta GraphQL com os campos estados, estado(uf|codigo), cidades(uf, filtro) e cidade(codigo|tom), e as relações estado → cidades → estado. Consultas com profundidade acima de 5 ou complexidade estimada acima de 25000 são rejeitadas.
+// @Tags GraphQL
+// @Accept json
+// @Produce json
+// @Param requisicao body requisicaoGraphQL true "Consulta GraphQL" example({"query": "{ estados { sigla cidades { nome } } }"})
+// @Success 200 {object} map[string]interface{} "Resposta GraphQL com data e errors"
+// @Failure 400 {object} map[string]interface{} "Consulta inválida ou acima dos limites, com a lista errors"
+// @Router /v1/graphql [post]
+func (h *IBGEHandler) GraphQL(w http.ResponseWriter, r *http.Request) {
+	var req requisicaoGraphQL
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondGraphQLErro(w, r, domain.ArgumentoInvalido(domain.MsgCorpoInvalido, err))
+		return
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{Source: req.Query})
+	if err != nil {
+		respondGraphQLErro(w, r, err)
+		return
+	}
+	if validacao := graphql.ValidateDocument(&h.schemaGraphQL, doc, nil); !validacao.IsValid {
+		respondWithJSON(w, http.StatusBadRequest, respostaGraphQL{Errors: validacao.Errors})
+		return
+	}
+	if err := h.tamanhosGraphQL.verificarLimites(doc); err != nil {
+		respondGraphQLErro(w, r, err)
+		return
+	}
+
+	resultado := graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.schemaGraphQL,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       r.Context(),
+	})
+	respondWithJSON(w, http.StatusOK, respostaGraphQL{Data: resultado.Data, Errors: traduzirErrosGraphQL(r, resultado.Errors)})
+}
+
+func respondGraphQLErro(w http.ResponseWriter, r *http.Request, err error) {
+	respondWithJSON(w, http.StatusBadRequest, respostaGraphQL{Errors: traduzirErrosGraphQL(r, gqlerrors.FormatErrors(err))})
+}
+
+// traduzirErrosGraphQL escreve as mensagens dos erros do catálogo no idioma do header
+// Accept-Language e informa o código da mensagem em extensions.code. Os erros de sintaxe e de
+// validação do documento vêm da biblioteca GraphQL e ficam como estão.
+func traduzirErrosGraphQL(r *http.Request, erros []gqlerrors.FormattedError) []gqlerrors.FormattedError {
+	idioma := domain.IdiomaPreferido(r.Header.Get("Accept-Language"))
+	for i, erro := range erros {
+		original := erro.OriginalError()
+		// Os erros dos resolvers chegam envolvidos em *gqlerrors.Error, que não implementa Unwrap
+		if localizado, ok := original.(*gqlerrors.Error); ok {
+			original = localizado.OriginalError
+		}
+		var traduzivel domain.ErroTraduzivel
+		if errors.As(original, &traduzivel) {
+			erros[i].Message = traduzivel.Mensagem(idioma)
+			erros[i].Extensions = map[string]interface{}{"code": string(traduzivel.CodigoMensagem())}
+		}
+	}
+	return erros
+}
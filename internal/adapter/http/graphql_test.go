@@ -0,0 +1,130 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestGraphQL cobre o endpoint GraphQL.
+func TestGraphQL(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("POST /api/v1/graphql - deve executar consultas sobre estados e cidades", func(t *testing.T) {
+		testCases := []struct {
+			name           string
+			query          string
+			acceptLanguage string
+			expectedStatus int
+			expectedData   string
+			expectedErro   string
+		}{
+			{
+				name:           "estados com cidades aninhadas",
+				query:          `{ estado(uf: "sp") { sigla cidades { codigo_ibge } } }`,
+				expectedStatus: http.StatusOK,
+				expectedData:   `{"estado":{"cidades":[{"codigo_ibge":3550308},{"codigo_ibge":3509502},{"codigo_ibge":3552205}],"sigla":"SP"}}`,
+			},
+			{
+				name:           "cidade com estado e região",
+				query:          `{ cidade(codigo: 3550308) { nome estado { sigla regiao { sigla } } } }`,
+				expectedStatus: http.StatusOK,
+				expectedData:   `{"cidade":{"estado":{"regiao":{"sigla":"SE"},"sigla":"SP"},"nome":"São Paulo"}}`,
+			},
+			{
+				name:           "cidades filtradas",
+				query:          `{ cidades(uf: "SP", filtro: {nome: "Sampa"}) { nome } }`,
+				expectedStatus: http.StatusOK,
+				expectedData:   `{"cidades":[{"nome":"São Paulo"}]}`,
+			},
+			{
+				name:           "argumentos ausentes",
+				query:          `{ estado { sigla } }`,
+				expectedStatus: http.StatusOK,
+				expectedErro:   "informe uf ou codigo",
+			},
+			{
+				name:           "argumentos ausentes em inglês",
+				query:          `{ estado { sigla } }`,
+				acceptLanguage: "en",
+				expectedStatus: http.StatusOK,
+				expectedErro:   "provide uf or codigo",
+			},
+			{
+				name:           "estado inexistente em inglês",
+				query:          `{ estado(uf: "XX") { sigla } }`,
+				acceptLanguage: "en",
+				expectedStatus: http.StatusOK,
+				expectedErro:   "state with abbreviation XX not found",
+			},
+			{
+				name:           "profundidade acima do limite",
+				query:          `{ estados { cidades { estado { cidades { estado { sigla } } } } } }`,
+				expectedStatus: http.StatusBadRequest,
+				expectedErro:   "profundidade 6 excede o limite",
+			},
+			{
+				name:           "complexidade acima do limite",
+				query:          `{ estados { cidades { estado { cidades { nome } } } } }`,
+				expectedStatus: http.StatusBadRequest,
+				expectedErro:   "excede o limite de 25000",
+			},
+			{
+				name:           "todas as cidades com as cidades do estado de cada uma",
+				query:          `{ cidades { estado { cidades { nome } } } }`,
+				expectedStatus: http.StatusBadRequest,
+				expectedErro:   "excede o limite de 25000",
+			},
+			{
+				name:           "todas as cidades com o estado",
+				query:          `{ cidades { nome estado { sigla } } }`,
+				expectedStatus: http.StatusOK,
+			},
+			{
+				name:           "cidades de cada estado",
+				query:          `{ estados { sigla cidades { nome } } }`,
+				expectedStatus: http.StatusOK,
+			},
+			{
+				name:           "campo inexistente",
+				query:          `{ paises { nome } }`,
+				expectedStatus: http.StatusBadRequest,
+				expectedErro:   "paises",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				corpo, _ := json.Marshal(requisicaoGraphQL{Query: tc.query})
+				req := httptest.NewRequest("POST", "/api/v1/graphql", strings.NewReader(string(corpo)))
+				if tc.acceptLanguage != "" {
+					req.Header.Set("Accept-Language", tc.acceptLanguage)
+				}
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != tc.expectedStatus {
+					t.Fatalf("Status code incorreto: got %v want %v (%s)", status, tc.expectedStatus, rr.Body.String())
+				}
+				var resposta struct {
+					Data   json.RawMessage `json:"data"`
+					Errors []struct {
+						Message string `json:"message"`
+					} `json:"errors"`
+				}
+				if err := json.Unmarshal(rr.Body.Bytes(), &resposta); err != nil {
+					t.Fatalf("Erro ao decodificar JSON: %v", err)
+				}
+				if tc.expectedData != "" && string(resposta.Data) != tc.expectedData {
+					t.Errorf("Dados incorretos.\ngot:  %s\nwant: %s", resposta.Data, tc.expectedData)
+				}
+				if tc.expectedErro != "" && (len(resposta.Errors) == 0 || !strings.Contains(resposta.Errors[0].Message, tc.expectedErro)) {
+					t.Errorf("Esperava erro contendo %q, mas recebi: %s", tc.expectedErro, rr.Body.String())
+				}
+			})
+		}
+	})
+}
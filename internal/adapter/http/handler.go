@@ -1,42 +1,87 @@
 package http
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/brauliohms/ibge-service/internal/domain"
 	"github.com/brauliohms/ibge-service/internal/usecase"
 	"github.com/go-chi/chi/v5"
+	"github.com/graphql-go/graphql"
 )
 
 type IBGEHandler struct {
-	useCase *usecase.IBGEUseCase
+	versoes         *usecase.RegistroVersoes
+	schemaGraphQL   graphql.Schema
+	tamanhosGraphQL tamanhosGraphQL
+	pacotes         *cachePacotes
+	respostas       respostasPreSerializadas
 }
 
-func NewIBGEHandler(uc *usecase.IBGEUseCase) *IBGEHandler {
-	return &IBGEHandler{useCase: uc}
+// NewIBGEHandler cria o handler a partir do registro de versões do conjunto de dados.
+// Cada requisição usa o caso de uso da versão selecionada pelo middleware SelecionarVersao.
+func NewIBGEHandler(versoes *usecase.RegistroVersoes) *IBGEHandler {
+	h := &IBGEHandler{versoes: versoes, pacotes: novoCachePacotes(), respostas: preSerializarRespostas(versoes)}
+	schema, err := h.novoSchemaGraphQL()
+	if err != nil {
+		// O schema é fixo; um erro aqui é um defeito de programação, não de configuração
+		panic(fmt.Sprintf("schema GraphQL inválido: %v", err))
+	}
+	h.schemaGraphQL = schema
+	h.tamanhosGraphQL = medirTamanhosGraphQL(versoes)
+	return h
 }
 
 // GetAllEstados godoc
 // @Summary      Lista todos os estados
-// @Description  Retorna um array com todos os 27 estados brasileiros
+// @Description  Retorna um array com todos os 27 estados brasileiros, opcionalmente filtrados pelo nome e ordenados
 // @Tags         Estados
 // @Accept       json
 // @Produce      json
+// @Param        nome_contem      query  string  false  "Parte do nome, sem diferenciar acentos e caixa" example(para)
+// @Param        ordenar          query  string  false  "Campos de ordenação separados por vírgula, com - para ordem decrescente (codigo_ibge, nome, sigla)" example(-codigo_ibge)
+// @Param        pagina           query  int     false  "Página (a partir de 1)"
+// @Param        por_pagina       query  int     false  "Itens por página (padrão 100, máximo 1000)"
+// @Param        cursor           query  string  false  "Cursor do header Link (vazio para começar a navegação por cursor)"
 // @Success      200  {array}   domain.Estado "Lista de estados retornada com sucesso"
-// @Failure      500  {object}  map[string]string "Erro interno do servidor"
-// @Router       /estados [get]
+// @Header       200  {integer}  X-Total-Count  "Total de itens da lista"
+// @Header       200  {string}   Link           "Links para as páginas first, prev, next e last (RFC 8288)"
+// @Failure      400  {object}  problema "Ordenação ou paginação inválida"
+// @Failure      500  {object}  problema "Erro interno do servidor"
+// @Router       /v1/estados [get]
 func (h *IBGEHandler) GetAllEstados(w http.ResponseWriter, r *http.Request) {
-	estados, err := h.useCase.GetAllEstados()
+	if h.responderPreSerializada(w, r, "estados") {
+		return
+	}
+
+	estados, err := h.listarEstados(r)
 	if err != nil {
-		log.Printf("Erro ao buscar estados: %v", err)
-		respondWithError(w, http.StatusInternalServerError, "Erro interno do servidor")
+		responderErro(w, r, err)
 		return
 	}
-	respondWithJSON(w, http.StatusOK, estados)
+	respond(w, r, http.StatusOK, estados)
+}
+
+// listarEstados lista os estados com o filtro e a ordenação da query string.
+func (h *IBGEHandler) listarEstados(r *http.Request) ([]domain.Estado, error) {
+	if err := semDataReferencia(r); err != nil {
+		return nil, err
+	}
+	ordenacao, err := domain.ParseOrdenacao(r.URL.Query().Get("ordenar"), domain.CamposOrdenacaoEstados)
+	if err != nil {
+		return nil, err
+	}
+	return h.casoDeUso(r).ConsultarEstados(domain.ConsultaEstados{
+		NomeContem: r.URL.Query().Get("nome_contem"),
+		Ordenacao:  ordenacao,
+	})
 }
 
 // GetEstadoByUF godoc
@@ -47,28 +92,32 @@ func (h *IBGEHandler) GetAllEstados(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        uf   path      string  true  "Sigla do Estado (ex: SP, RJ, BA) ou Código IBGE do Estado (ex: 35, 33, 29)"
 // @Success      200  {object}  domain.Estado "Dados do estado retornados com sucesso"
-// @Failure      404  {object}  map[string]string "Estado não encontrado"
-// @Router       /estados/{uf} [get]
+// @Failure      400  {object}  problema "Parâmetro data informado: o histórico cobre apenas os municípios"
+// @Failure      404  {object}  problema "Estado não encontrado"
+// @Router       /v1/estados/{uf} [get]
 func (h *IBGEHandler) GetEstadoByUF(w http.ResponseWriter, r *http.Request) {
 	ufOuCodigo := chi.URLParam(r, "uf")
+	err := semDataReferencia(r)
 	var estado *domain.Estado
-	var err error
+	if err == nil {
+		estado, err = h.buscarEstado(r, ufOuCodigo)
+	}
+	if err != nil {
+		responderErro(w, r, err)
+		return
+	}
+	respond(w, r, http.StatusOK, estado)
+}
 
+// buscarEstado busca o estado pela sigla ou pelo código IBGE.
+func (h *IBGEHandler) buscarEstado(r *http.Request, ufOuCodigo string) (*domain.Estado, error) {
 	// Verifica se é um número (código IBGE) ou string (sigla)
 	if _, parseErr := strconv.Atoi(ufOuCodigo); parseErr == nil {
 		// É um número, busca por código IBGE
-		estado, err = h.useCase.GetEstadoByCodigoIbge(ufOuCodigo)
-	} else {
-		// É uma string, busca por sigla
-		estado, err = h.useCase.GetEstadoByUF(strings.ToUpper(ufOuCodigo))
+		return h.casoDeUso(r).GetEstadoByCodigoIbge(ufOuCodigo)
 	}
-
-	if err != nil {
-		log.Printf("Erro ao buscar estado %s: %v", ufOuCodigo, err)
-		respondWithError(w, http.StatusNotFound, err.Error())
-		return
-	}
-	respondWithJSON(w, http.StatusOK, estado)
+	// É uma string, busca por sigla
+	return h.casoDeUso(r).GetEstadoByUF(strings.ToUpper(ufOuCodigo))
 }
 
 // GetCidadesByEstadoUF godoc
@@ -78,29 +127,133 @@ func (h *IBGEHandler) GetEstadoByUF(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Param        uf   path      string  true  "Sigla do Estado (ex: SP, RJ, BA) ou Código IBGE do Estado (ex: 35, 33, 29)"
+// @Param        nome_contem      query  string  false  "Parte do nome, sem diferenciar acentos e caixa" example(sao jose)
+// @Param        micro_regiao     query  string  false  "Código da microrregião" example(35061)
+// @Param        regiao_imediata  query  string  false  "Código da região geográfica imediata" example(350001)
+// @Param        tem_codigo_tom   query  bool    false  "Filtra cidades com (ou sem) código TOM"
+// @Param        ordenar          query  string  false  "Campos de ordenação separados por vírgula, com - para ordem decrescente (codigo_ibge, nome, estado_codigo_ibge, estado_sigla, codigo_tom, micro_regiao, regiao_imediata)" example(nome,-codigo_ibge)
+// @Param        expand           query  string  false  "aliases, estado e/ou regiao, separados por vírgula"
+// @Param        fields           query  string  false  "Campos de cada cidade, separados por vírgula" example(codigo_ibge,nome)
+// @Param        data             query  string  false  "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época"
+// @Param        pagina           query  int     false  "Página (a partir de 1)"
+// @Param        por_pagina       query  int     false  "Itens por página (padrão 100, máximo 1000)"
+// @Param        cursor           query  string  false  "Cursor do header Link (vazio para começar a navegação por cursor)"
 // @Success      200  {array}   domain.Cidade "Lista de cidades retornada com sucesso"
-// @Failure      404  {object}  map[string]string "Estado não encontrado"
-// @Router       /estados/{uf}/cidades [get]
+// @Header       200  {integer}  X-Total-Count  "Total de itens da lista"
+// @Header       200  {string}   Link           "Links para as páginas first, prev, next e last (RFC 8288)"
+// @Failure      400  {object}  problema "Filtro, ordenação ou paginação inválida"
+// @Failure      404  {object}  problema "Estado não encontrado"
+// @Failure      500  {object}  problema "Erro interno do servidor"
+// @Router       /v1/estados/{uf}/cidades [get]
 func (h *IBGEHandler) GetCidadesByEstadoUF(w http.ResponseWriter, r *http.Request) {
 	ufOuCodigo := chi.URLParam(r, "uf")
-	var cidades []domain.Cidade
-	var err error
+	if h.responderPreSerializada(w, r, "estados/"+strings.ToUpper(ufOuCodigo)+"/cidades") {
+		return
+	}
 
-	// Verifica se é um número (código IBGE) ou string (sigla)
-	if _, parseErr := strconv.Atoi(ufOuCodigo); parseErr == nil {
-		// É um número, busca por código IBGE
-		cidades, err = h.useCase.GetCidadesByEstadoCodigoIbge(ufOuCodigo)
-	} else {
-		// É uma string, busca por sigla
-		cidades, err = h.useCase.GetCidadesByEstadoUF(strings.ToUpper(ufOuCodigo))
+	cidades, err := h.listarCidades(r, ufOuCodigo)
+	if err != nil {
+		responderErro(w, r, err)
+		return
 	}
+	respond(w, r, http.StatusOK, cidadesParaResposta(r, cidades))
+}
 
+// GetCidades godoc
+// @Summary      Lista as cidades de todos os estados
+// @Description  Retorna as cidades do país, com os mesmos filtros e ordenação de /estados/{uf}/cidades. Sem ordenação, a lista segue a ordem por UF e nome.
+// @Tags         Cidades
+// @Accept       json
+// @Produce      json
+// @Param        nome_contem      query  string  false  "Parte do nome, sem diferenciar acentos e caixa" example(sao jose)
+// @Param        micro_regiao     query  string  false  "Código da microrregião" example(35061)
+// @Param        regiao_imediata  query  string  false  "Código da região geográfica imediata" example(350001)
+// @Param        tem_codigo_tom   query  bool    false  "Filtra cidades com (ou sem) código TOM"
+// @Param        ordenar          query  string  false  "Campos de ordenação separados por vírgula, com - para ordem decrescente" example(nome,-codigo_ibge)
+// @Param        expand           query  string  false  "aliases, estado e/ou regiao, separados por vírgula"
+// @Param        fields           query  string  false  "Campos de cada cidade, separados por vírgula" example(codigo_ibge,nome)
+// @Param        data             query  string  false  "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época"
+// @Param        pagina           query  int     false  "Página (a partir de 1)"
+// @Param        por_pagina       query  int     false  "Itens por página (padrão 100, máximo 1000)"
+// @Param        cursor           query  string  false  "Cursor do header Link (vazio para começar a navegação por cursor)"
+// @Success      200  {array}   domain.Cidade "Lista de cidades retornada com sucesso"
+// @Header       200  {integer}  X-Total-Count  "Total de itens da lista"
+// @Header       200  {string}   Link           "Links para as páginas first, prev, next e last (RFC 8288)"
+// @Failure      400  {object}  problema "Filtro, ordenação ou paginação inválida"
+// @Failure      500  {object}  problema "Erro interno do servidor"
+// @Router       /v1/cidades [get]
+func (h *IBGEHandler) GetCidades(w http.ResponseWriter, r *http.Request) {
+	cidades, err := h.listarCidades(r, "")
 	if err != nil {
-		log.Printf("Erro ao buscar cidades do estado %s: %v", ufOuCodigo, err)
-		respondWithError(w, http.StatusNotFound, err.Error())
+		responderErro(w, r, err)
 		return
 	}
-	respondWithJSON(w, http.StatusOK, cidades)
+	respond(w, r, http.StatusOK, cidadesParaResposta(r, cidades))
+}
+
+// listarCidades lista as cidades do estado (pela sigla ou pelo código IBGE), ou do país se
+// ufOuCodigo for vazio, com os filtros, a ordenação e a data de referência da query string.
+func (h *IBGEHandler) listarCidades(r *http.Request, ufOuCodigo string) ([]domain.Cidade, error) {
+	consulta, err := parseConsultaCidades(r)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := dataReferencia(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if ufOuCodigo != "" {
+		estado, err := h.buscarEstado(r, ufOuCodigo)
+		if err != nil {
+			return nil, err
+		}
+		consulta.EstadoCodigoIBGE = estado.CodigoIBGE
+	}
+	return h.consultarCidades(r, consulta, data)
+}
+
+// consultarCidades executa a consulta na versão selecionada. Com ?data=, os filtros e a
+// ordenação são aplicados à situação das cidades do estado (ou do país) na data.
+func (h *IBGEHandler) consultarCidades(r *http.Request, consulta domain.ConsultaCidades, data string) ([]domain.Cidade, error) {
+	uc := h.casoDeUso(r)
+	if data == "" {
+		return uc.ConsultarCidades(consulta)
+	}
+
+	atuais, err := uc.ConsultarCidades(domain.ConsultaCidades{EstadoCodigoIBGE: consulta.EstadoCodigoIBGE})
+	if err != nil {
+		return nil, err
+	}
+	naData, err := uc.CidadesNaData(atuais, data, func(c domain.Cidade) bool {
+		return consulta.EstadoCodigoIBGE == 0 || c.EstadoCodigoIBGE == consulta.EstadoCodigoIBGE
+	})
+	if err != nil {
+		return nil, err
+	}
+	return consulta.Filtrar(naData), nil
+}
+
+// parseConsultaCidades lê os filtros e a ordenação de cidades da query string.
+func parseConsultaCidades(r *http.Request) (domain.ConsultaCidades, error) {
+	query := r.URL.Query()
+	consulta := domain.ConsultaCidades{
+		NomeContem:     query.Get("nome_contem"),
+		MicroRegiao:    query.Get("micro_regiao"),
+		RegiaoImediata: query.Get("regiao_imediata"),
+	}
+
+	if valor := query.Get("tem_codigo_tom"); valor != "" {
+		temCodigoTOM, err := strconv.ParseBool(valor)
+		if err != nil {
+			return consulta, domain.ArgumentoInvalido(domain.MsgValorBooleanoInvalido, "tem_codigo_tom", valor)
+		}
+		consulta.TemCodigoTOM = &temCodigoTOM
+	}
+	var err error
+	consulta.Ordenacao, err = domain.ParseOrdenacao(query.Get("ordenar"), domain.CamposOrdenacaoCidades)
+	return consulta, err
 }
 
 // GetCidadeByCodigo godoc
@@ -110,19 +263,34 @@ func (h *IBGEHandler) GetCidadesByEstadoUF(w http.ResponseWriter, r *http.Reques
 // @Accept json
 // @Produce json
 // @Param codigo_ibge path string true "Código IBGE da cidade" example(3550308)
+// @Param expand query string false "aliases, estado e/ou regiao, separados por vírgula" example(estado,regiao)
+// @Param fields query string false "Campos da cidade, separados por vírgula" example(codigo_ibge,nome)
+// @Param data query string false "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época" example(2010-08-01)
 // @Success 200 {object} domain.Cidade
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Router /cidades/{codigo_ibge} [get]
+// @Failure 400 {object} problema
+// @Failure 404 {object} problema
+// @Failure 410 {object} respostaCidadeExtinta "Município extinto; a resposta lista os sucessores"
+// @Router /v1/cidades/{codigo_ibge} [get]
 func (h *IBGEHandler) GetCidadeByCodigo(w http.ResponseWriter, r *http.Request) {
-	codigoIBGE := chi.URLParam(r, "codigo_ibge")
-	cidade, err := h.useCase.GetCidadeByCodigo(codigoIBGE)
+	cidade, err := h.cidadePorCodigo(r)
 	if err != nil {
-		log.Printf("Erro ao buscar cidade com código %s: %v", codigoIBGE, err)
-		respondWithError(w, http.StatusNotFound, err.Error())
+		responderErro(w, r, err)
 		return
 	}
-	respondWithJSON(w, http.StatusOK, cidade)
+	respond(w, r, http.StatusOK, cidadeParaResposta(r, cidade))
+}
+
+// cidadePorCodigo busca a cidade do parâmetro {codigo_ibge}, na data de referência se informada.
+func (h *IBGEHandler) cidadePorCodigo(r *http.Request) (*domain.Cidade, error) {
+	codigoIBGE := chi.URLParam(r, "codigo_ibge")
+	data, err := dataReferencia(r)
+	if err != nil {
+		return nil, err
+	}
+	if data != "" {
+		return h.casoDeUso(r).GetCidadeByCodigoNaData(codigoIBGE, data)
+	}
+	return h.casoDeUso(r).GetCidadeByCodigo(codigoIBGE)
 }
 
 // GetCidadeByCodigoTOM godoc
@@ -132,52 +300,471 @@ func (h *IBGEHandler) GetCidadeByCodigo(w http.ResponseWriter, r *http.Request)
 // @Accept json
 // @Produce json
 // @Param codigo_tom path string true "Código TOM da cidade" example(7107)
+// @Param expand query string false "aliases, estado e/ou regiao, separados por vírgula" example(estado,regiao)
+// @Param fields query string false "Campos da cidade, separados por vírgula" example(codigo_ibge,nome)
+// @Param data query string false "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época" example(2010-08-01)
 // @Success 200 {object} domain.Cidade
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Router /cidades/{codigo_tom}/tom [get]
+// @Failure 400 {object} problema
+// @Failure 404 {object} problema
+// @Router /v1/cidades/{codigo_tom}/tom [get]
 func (h *IBGEHandler) GetCidadeByCodigoTOM(w http.ResponseWriter, r *http.Request) {
 	codigoTOM := chi.URLParam(r, "codigo_tom")
-	cidade, err := h.useCase.GetCidadeByCodigoTOM(codigoTOM)
+	cidade, err := h.casoDeUso(r).GetCidadeByCodigoTOM(codigoTOM)
+	if err == nil {
+		cidade, err = h.situacaoNaData(r, cidade)
+	}
 	if err != nil {
-		log.Printf("Erro ao buscar cidade com código TOM %s: %v", codigoTOM, err)
-		respondWithError(w, http.StatusNotFound, err.Error())
+		responderErro(w, r, err)
 		return
 	}
-	respondWithJSON(w, http.StatusOK, cidade)
+	respond(w, r, http.StatusOK, cidadeParaResposta(r, cidade))
 }
 
-// respondWithJSON é uma função helper para padronizar as respostas JSON.
-func respondWithJSON(w http.ResponseWriter, code int, payload interface{}) {
-	response, err := json.Marshal(payload)
+// BuscarCidades godoc
+// @Summary Busca cidades pelo nome
+// @Description Retorna as cidades cujo nome oficial ou nome alternativo (grafias antigas, abreviações, nomes anteriores) contém o termo, sem diferenciar acentos e caixa. Correspondências exatas vêm primeiro.
+// @Tags Cidades
+// @Accept json
+// @Produce json
+// @Param nome query string true "Nome ou parte do nome da cidade" example(S. J. dos Campos)
+// @Param uf query string false "Sigla do estado" example(SP)
+// @Param expand query string false "aliases, estado e/ou regiao, separados por vírgula"
+// @Param fields query string false "Campos de cada cidade, separados por vírgula" example(codigo_ibge,nome)
+// @Param data query string false "Data de referência (AAAA-MM-DD); inclui municípios extintos depois dela" example(2010-08-01)
+// @Param pagina query int false "Página (a partir de 1)"
+// @Param por_pagina query int false "Itens por página (padrão 100, máximo 1000)"
+// @Param cursor query string false "Cursor do header Link (vazio para começar a navegação por cursor)"
+// @Success 200 {array} domain.Cidade
+// @Header 200 {integer} X-Total-Count "Total de itens da lista"
+// @Header 200 {string} Link "Links para as páginas first, prev, next e last (RFC 8288)"
+// @Failure 400 {object} problema
+// @Router /v1/cidades/busca [get]
+func (h *IBGEHandler) BuscarCidades(w http.ResponseWriter, r *http.Request) {
+	cidades, err := h.buscarCidades(r)
 	if err != nil {
-		log.Printf("Erro ao fazer marshal do JSON: %v", err)
-		respondWithError(w, http.StatusInternalServerError, "Erro interno do servidor")
+		responderErro(w, r, err)
 		return
 	}
+	respond(w, r, http.StatusOK, cidadesParaResposta(r, cidades))
+}
+
+// buscarCidades busca as cidades pelos parâmetros nome, uf e data da query string.
+func (h *IBGEHandler) buscarCidades(r *http.Request) ([]domain.Cidade, error) {
+	nome := r.URL.Query().Get("nome")
+	if len(domain.NormalizarNome(nome)) < 2 {
+		return nil, domain.ArgumentoInvalido(domain.MsgNomeBuscaCurto)
+	}
 
-	w.Header().Set("Content-Type", "application/json")
+	data, err := dataReferencia(r)
+	if err != nil {
+		return nil, err
+	}
+
+	uf := strings.ToUpper(r.URL.Query().Get("uf"))
+	cidades, err := h.casoDeUso(r).BuscarCidades(nome, uf)
+	if err != nil || data == "" {
+		return cidades, err
+	}
+
+	termo := domain.NormalizarNome(nome)
+	return h.casoDeUso(r).CidadesNaData(cidades, data, func(c domain.Cidade) bool {
+		if uf != "" && c.EstadoSigla != uf {
+			return false
+		}
+		for _, conhecido := range c.NomesConhecidos() {
+			if strings.Contains(domain.NormalizarNome(conhecido), termo) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// GetHoraLocal godoc
+// @Summary Hora local de uma cidade
+// @Description Retorna a hora corrente, o fuso horário IANA e o deslocamento UTC de uma cidade
+// @Tags Cidades
+// @Accept json
+// @Produce json
+// @Param codigo_ibge path string true "Código IBGE da cidade" example(1302603)
+// @Success 200 {object} domain.HoraLocal
+// @Failure 404 {object} problema
+// @Router /v1/cidades/{codigo_ibge}/hora-local [get]
+func (h *IBGEHandler) GetHoraLocal(w http.ResponseWriter, r *http.Request) {
+	codigoIBGE := chi.URLParam(r, "codigo_ibge")
+	horaLocal, err := h.casoDeUso(r).GetHoraLocal(codigoIBGE)
+	if err != nil {
+		responderErro(w, r, err)
+		return
+	}
+	respond(w, r, http.StatusOK, horaLocal)
+}
+
+// GetCidadeByCEP godoc
+// @Summary Busca cidade por CEP
+// @Description Retorna a cidade cuja faixa de CEP contém o CEP informado
+// @Tags CEP
+// @Accept json
+// @Produce json
+// @Param cep path string true "CEP com ou sem máscara" example(01310-100)
+// @Param data query string false "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época" example(2010-08-01)
+// @Success 200 {object} domain.Cidade
+// @Failure 400 {object} problema
+// @Failure 404 {object} problema
+// @Router /v1/cep/{cep} [get]
+func (h *IBGEHandler) GetCidadeByCEP(w http.ResponseWriter, r *http.Request) {
+	cidade, err := h.cidadePorCEP(r)
+	if err != nil {
+		responderErro(w, r, err)
+		return
+	}
+	respond(w, r, http.StatusOK, cidadeParaResposta(r, cidade))
+}
+
+// cidadePorCEP busca a cidade do parâmetro {cep}. Com a data de referência, retorna o município
+// ao qual o CEP pertencia na época, que pode ser o de origem de um município desmembrado depois.
+func (h *IBGEHandler) cidadePorCEP(r *http.Request) (*domain.Cidade, error) {
+	cep := chi.URLParam(r, "cep")
+	data, err := dataReferencia(r)
+	if err != nil {
+		return nil, err
+	}
+	if data != "" {
+		return h.casoDeUso(r).GetCidadeByCEPNaData(cep, data)
+	}
+	return h.casoDeUso(r).GetCidadeByCEP(cep)
+}
+
+// ValidarEndereco godoc
+// @Summary Valida a consistência entre CEP, cidade e UF
+// @Description Verifica se a cidade e a UF informadas em um endereço correspondem às faixas de CEP cadastradas
+// @Tags CEP
+// @Accept json
+// @Produce json
+// @Param cep path string true "CEP com ou sem máscara" example(01310-100)
+// @Param cidade query string true "Nome ou código IBGE da cidade" example(São Paulo)
+// @Param uf query string true "Sigla ou código IBGE do estado" example(SP)
+// @Success 200 {object} domain.ValidacaoEndereco
+// @Failure 400 {object} problema
+// @Failure 404 {object} problema
+// @Router /v1/cep/{cep}/validacao [get]
+func (h *IBGEHandler) ValidarEndereco(w http.ResponseWriter, r *http.Request) {
+	cep := chi.URLParam(r, "cep")
+	cidade := r.URL.Query().Get("cidade")
+	uf := r.URL.Query().Get("uf")
+
+	if cidade == "" || uf == "" {
+		respondWithError(w, r, http.StatusBadRequest, domain.MsgCidadeEUFObrigatorios)
+		return
+	}
+
+	validacao, err := h.casoDeUso(r).ValidarEndereco(cep, cidade, uf)
+	if err != nil {
+		responderErro(w, r, err)
+		return
+	}
+	validacao.CidadeEsperada = cidadeParaResposta(r, validacao.CidadeEsperada)
+	validacao.Traduzir(idiomaDaResposta(w, r))
+	respond(w, r, http.StatusOK, validacao)
+}
+
+// GetFeriados godoc
+// @Summary Lista os feriados de um ano
+// @Description Retorna os feriados nacionais e, conforme os filtros, os estaduais e municipais (fixos e móveis) de um ano
+// @Tags Feriados
+// @Accept json
+// @Produce json
+// @Param ano query int false "Ano (padrão: ano corrente)" example(2026)
+// @Param cidade query string false "Código IBGE da cidade" example(3550308)
+// @Param uf query string false "Sigla ou código IBGE do estado (ignorado se cidade for informada)" example(SP)
+// @Success 200 {array} domain.Feriado
+// @Failure 400 {object} problema
+// @Failure 404 {object} problema
+// @Router /v1/feriados [get]
+func (h *IBGEHandler) GetFeriados(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	ano := time.Now().Year()
+	if !query.Has("ano") {
+		// A resposta do ano corrente muda na virada do ano, mesmo sem o conjunto de dados mudar;
+		// ela é validada apenas pelo ETag
+		w.Header().Del("Last-Modified")
+	}
+	if anoStr := query.Get("ano"); anoStr != "" {
+		var err error
+		ano, err = strconv.Atoi(anoStr)
+		if err != nil || ano < 1900 || ano > 2199 {
+			respondWithError(w, r, http.StatusBadRequest, domain.MsgAnoInvalido)
+			return
+		}
+	}
+
+	feriados, err := h.casoDeUso(r).GetFeriados(ano, query.Get("cidade"), strings.ToUpper(query.Get("uf")))
+	if err != nil {
+		responderErro(w, r, err)
+		return
+	}
+	respond(w, r, http.StatusOK, feriados)
+}
+
+// CalcularDiasUteis godoc
+// @Summary Calcula um prazo em dias úteis
+// @Description Soma dias úteis a uma data, desconsiderando fins de semana e feriados nacionais, estaduais e municipais da cidade
+// @Tags Feriados
+// @Accept json
+// @Produce json
+// @Param cidade query string true "Código IBGE da cidade" example(3550308)
+// @Param inicio query string true "Data inicial no formato AAAA-MM-DD (não é contada)" example(2026-01-23)
+// @Param dias query int true "Quantidade de dias úteis (negativo para contar para trás)" example(5)
+// @Success 200 {object} domain.DiasUteis
+// @Failure 400 {object} problema
+// @Failure 404 {object} problema
+// @Router /v1/dias-uteis [get]
+func (h *IBGEHandler) CalcularDiasUteis(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	cidade := query.Get("cidade")
+	if cidade == "" {
+		respondWithError(w, r, http.StatusBadRequest, domain.MsgCidadeObrigatoria)
+		return
+	}
+
+	inicio, err := time.Parse("2006-01-02", query.Get("inicio"))
+	if err != nil {
+		respondWithError(w, r, http.StatusBadRequest, domain.MsgInicioInvalido)
+		return
+	}
+
+	dias, err := strconv.Atoi(query.Get("dias"))
+	if err != nil || dias > usecase.MaxDiasUteis || dias < -usecase.MaxDiasUteis {
+		respondWithError(w, r, http.StatusBadRequest, domain.MsgDiasInvalido, -usecase.MaxDiasUteis, usecase.MaxDiasUteis)
+		return
+	}
+
+	resultado, err := h.casoDeUso(r).CalcularDiasUteis(cidade, inicio, dias)
+	if err != nil {
+		responderErro(w, r, err)
+		return
+	}
+	respond(w, r, http.StatusOK, resultado)
+}
+
+// GetAlteracoes godoc
+// @Summary Feed de alterações para sincronização
+// @Description Retorna as criações, atualizações e remoções de estados e municípios registradas pelo seed depois da versão informada. Guarde versao_atual e use-a como desde na próxima sincronização.
+// @Tags Alterações
+// @Accept json
+// @Produce json
+// @Param desde query int false "Versão a partir da qual listar as alterações (padrão: 0, histórico completo)" example(1)
+// @Param pagina query int false "Página (a partir de 1)"
+// @Param por_pagina query int false "Itens por página (padrão 100, máximo 1000)"
+// @Param cursor query string false "Cursor do header Link (vazio para começar a navegação por cursor)"
+// @Success 200 {object} domain.FeedAlteracoes
+// @Header 200 {integer} X-Total-Count "Total de alterações do feed"
+// @Header 200 {string} Link "Links para as páginas first, prev, next e last (RFC 8288)"
+// @Failure 400 {object} problema
+// @Router /v1/alteracoes [get]
+func (h *IBGEHandler) GetAlteracoes(w http.ResponseWriter, r *http.Request) {
+	desde := 0
+	if desdeStr := r.URL.Query().Get("desde"); desdeStr != "" {
+		var err error
+		desde, err = strconv.Atoi(desdeStr)
+		if err != nil {
+			respondWithError(w, r, http.StatusBadRequest, domain.MsgDesdeInvalido)
+			return
+		}
+	}
+
+	feed, err := h.casoDeUso(r).GetAlteracoes(desde)
+	if err != nil {
+		responderErro(w, r, err)
+		return
+	}
+	respond(w, r, http.StatusOK, respostaAlteracoes(*feed))
+}
+
+// respostaAlteracoes é o feed de alterações com a lista paginável por respond.
+type respostaAlteracoes domain.FeedAlteracoes
+
+func (f respostaAlteracoes) itensLista() interface{} {
+	return f.Alteracoes
+}
+
+func (f respostaAlteracoes) comItens(itens interface{}) interface{} {
+	f.Alteracoes = itens.([]domain.Alteracao)
+	return f
+}
+
+// dataReferencia lê o parâmetro ?data=AAAA-MM-DD das consultas históricas.
+// Retorna string vazia quando o parâmetro não é informado (situação atual).
+func dataReferencia(r *http.Request) (string, error) {
+	data := r.URL.Query().Get("data")
+	if data == "" {
+		return "", nil
+	}
+	if err := usecase.ValidarData(data); err != nil {
+		return "", err
+	}
+	return data, nil
+}
+
+// semDataReferencia recusa ?data= nas consultas de estados: o histórico cobre apenas os
+// municípios, e ignorar o parâmetro faria a situação atual passar pela da data pedida.
+func semDataReferencia(r *http.Request) error {
+	if r.URL.Query().Has("data") {
+		return domain.ArgumentoInvalido(domain.MsgDataSemHistorico)
+	}
+	return nil
+}
+
+// situacaoNaData retorna a situação da cidade na data de referência, se informada.
+func (h *IBGEHandler) situacaoNaData(r *http.Request, cidade *domain.Cidade) (*domain.Cidade, error) {
+	data, err := dataReferencia(r)
+	if err != nil || data == "" {
+		return cidade, err
+	}
+	return h.casoDeUso(r).CidadeNaData(cidade, data)
+}
+
+// expandirAliases verifica se o cliente pediu os nomes alternativos com ?expand=aliases.
+func expandirAliases(r *http.Request) bool {
+	return expansoes(r)["aliases"]
+}
+
+// cidadeParaResposta omite os nomes alternativos da cidade, a menos que o cliente os tenha pedido.
+// Uma cópia é retornada para não alterar os dados compartilhados do repositório.
+func cidadeParaResposta(r *http.Request, cidade *domain.Cidade) *domain.Cidade {
+	if cidade == nil || expandirAliases(r) {
+		return cidade
+	}
+	copia := *cidade
+	copia.Aliases = nil
+	return &copia
+}
+
+// cidadesParaResposta aplica cidadeParaResposta a uma lista de cidades.
+func cidadesParaResposta(r *http.Request, cidades []domain.Cidade) []domain.Cidade {
+	if expandirAliases(r) {
+		return cidades
+	}
+	return semAliases(cidades)
+}
+
+// semAliases retorna cópias das cidades sem os nomes alternativos.
+func semAliases(cidades []domain.Cidade) []domain.Cidade {
+	copias := make([]domain.Cidade, len(cidades))
+	for i, cidade := range cidades {
+		cidade.Aliases = nil
+		copias[i] = cidade
+	}
+	return copias
+}
+
+// respond é uma função helper para padronizar as respostas, no formato negociado com o
+// cliente pelo header Accept ou pelo parâmetro ?formato= (JSON por padrão).
+func respond(w http.ResponseWriter, r *http.Request, code int, payload interface{}) {
+	formato, err := negociarFormato(r)
+	if err != nil {
+		status, detalhes := problemaDoErro(w, r, err)
+		respondWithJSON(w, status, detalhes)
+		return
+	}
+
+	// A paginação e os ajustes de ?fields= e ?expand= valem para os recursos, não para as mensagens de erro
+	if code < http.StatusBadRequest {
+		recursos := payload
+		if objeto, ok := payload.(objetoComLista); ok && formato.tabular {
+			recursos = objeto.itensLista()
+		}
+		if err := validarAjustesRecursos(r, recursos); err != nil {
+			responderErro(w, r, err)
+			return
+		}
+		if payload, err = paginar(w, r, payload); err != nil {
+			responderErro(w, r, err)
+			return
+		}
+		// O CSV é uma tabela de recursos: dos envelopes, vão só os itens (os metadados seguem nos headers)
+		if objeto, ok := payload.(objetoComLista); ok && formato.tabular {
+			payload = objeto.itensLista()
+		}
+		if tabela, vazia := tabelaDaListaVazia(r, payload); vazia && formato.tabular {
+			payload = tabela
+		} else if payload, err = ajustarRecursos(r, payload); err != nil {
+			log.Printf("Erro ao ajustar os campos da resposta: %v", err)
+			respondWithJSON(w, http.StatusInternalServerError, novoProblema(w, r, problemaInterno, erroInterno))
+			return
+		}
+	}
+
+	payload, tipoConteudo, err := formato.preparar(payload)
+	if errors.Is(err, errSemEsquemaProtobuf) {
+		// Os headers da paginação descrevem a lista que não será enviada
+		w.Header().Del("Link")
+		w.Header().Del(HeaderTotalRegistros)
+		respondWithError(w, r, http.StatusNotAcceptable, domain.MsgFormatoSemEsquema, formato.nome)
+		return
+	}
+	var corpo bytes.Buffer
+	if err == nil {
+		err = formato.codificar(&corpo, r, payload)
+	}
+	if err != nil {
+		log.Printf("Erro ao codificar a resposta em %s: %v", formato.nome, err)
+		respondWithJSON(w, http.StatusInternalServerError, novoProblema(w, r, problemaInterno, erroInterno))
+		return
+	}
+
+	w.Header().Add("Vary", "Accept")
+	if code >= http.StatusBadRequest {
+		naoArmazenar(w)
+	} else if code == http.StatusOK && validavel(r) {
+		w.Header().Set("ETag", etagRepresentacao(w.Header().Get(HeaderVersaoDataset), tipoConteudo, corpo.Bytes()))
+		if naoModificado(w, r) {
+			responderNaoModificado(w)
+			return
+		}
+	}
+	if _, erro := payload.(detalhesProblema); erro && formato == formatosResposta[0] {
+		tipoConteudo = TipoConteudoProblema
+	}
+	w.Header().Set("Content-Type", tipoConteudo)
 	w.WriteHeader(code)
 
 	// Ignoramos o erro de w.Write intencionalmente aqui
 	// pois não há muito que possamos fazer se falhar
-	_, _ = w.Write(response)
+	_, _ = w.Write(corpo.Bytes())
 }
 
-// respondWithError é uma função helper para padronizar as respostas de erro.
-func respondWithError(w http.ResponseWriter, code int, message string) {
-	errorResponse := map[string]string{"error": message}
-	response, err := json.Marshal(errorResponse)
+// respondWithJSON escreve a resposta sempre em JSON, para os endpoints cujo protocolo
+// exige esse formato (ex: GraphQL).
+func respondWithJSON(w http.ResponseWriter, code int, payload interface{}) {
+	response, err := json.Marshal(payload)
 	if err != nil {
-		log.Printf("Erro ao fazer marshal do erro: %v", err)
+		log.Printf("Erro ao fazer marshal do JSON: %v", err)
+		naoArmazenar(w)
 		http.Error(w, "Erro interno do servidor", http.StatusInternalServerError)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
+	if _, erro := payload.(detalhesProblema); erro {
+		w.Header().Set("Content-Type", TipoConteudoProblema)
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	if code >= http.StatusBadRequest {
+		naoArmazenar(w)
+	}
 	w.WriteHeader(code)
 
 	// Ignoramos o erro de w.Write intencionalmente aqui
 	// pois não há muito que possamos fazer se falhar
 	_, _ = w.Write(response)
 }
+
+// respondWithError é uma função helper para padronizar as respostas de erro, para as falhas
+// detectadas no próprio handler (ex: parâmetros de consulta), com a mensagem do catálogo. Erros dos
+// casos de uso passam por responderErro, que escolhe o status pelo tipo do erro.
+func respondWithError(w http.ResponseWriter, r *http.Request, code int, codigo domain.CodigoMensagem, args ...interface{}) {
+	respond(w, r, code, novoProblema(w, r, tipoDoStatus(code), &domain.Erro{Codigo: codigo, Args: args}))
+}
@@ -2,143 +2,17 @@ package http
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/brauliohms/ibge-service/internal/domain"
-	"github.com/brauliohms/ibge-service/internal/usecase"
 )
 
-// mockIBGERepository é um mock para o repositório usado pelo caso de uso.
-type mockIBGERepository struct{}
-
-func (m *mockIBGERepository) FindAllEstados() ([]domain.Estado, error) {
-	return []domain.Estado{
-		{CodigoIBGE: 35, Sigla: "SP", Nome: "São Paulo"},
-		{CodigoIBGE: 33, Sigla: "RJ", Nome: "Rio de Janeiro"},
-		{CodigoIBGE: 31, Sigla: "MG", Nome: "Minas Gerais"},
-	}, nil
-}
-
-func (m *mockIBGERepository) FindEstadoByUF(uf string) (*domain.Estado, error) {
-	uf = strings.ToUpper(uf)
-	switch uf {
-	case "SP":
-		return &domain.Estado{CodigoIBGE: 35, Sigla: "SP", Nome: "São Paulo"}, nil
-	case "RJ":
-		return &domain.Estado{CodigoIBGE: 33, Sigla: "RJ", Nome: "Rio de Janeiro"}, nil
-	case "MG":
-		return &domain.Estado{CodigoIBGE: 31, Sigla: "MG", Nome: "Minas Gerais"}, nil
-	default:
-		return nil, fmt.Errorf("estado com a sigla %s não encontrado", uf)
-	}
-}
-
-func (m *mockIBGERepository) FindEstadoByCodigoIbge(codigo_ibge string) (*domain.Estado, error) {
-	switch codigo_ibge {
-	case "35":
-		return &domain.Estado{CodigoIBGE: 35, Sigla: "SP", Nome: "São Paulo"}, nil
-	case "33":
-		return &domain.Estado{CodigoIBGE: 33, Sigla: "RJ", Nome: "Rio de Janeiro"}, nil
-	case "31":
-		return &domain.Estado{CodigoIBGE: 31, Sigla: "MG", Nome: "Minas Gerais"}, nil
-	default:
-		return nil, fmt.Errorf("estado com o código ibge %s não encontrado", codigo_ibge)
-	}
-}
-
-func (m *mockIBGERepository) FindCidadesByEstadoUF(uf string) ([]domain.Cidade, error) {
-	uf = strings.ToUpper(uf)
-	switch uf {
-	case "SP":
-		return []domain.Cidade{
-			{CodigoIBGE: 3550308, Nome: "São Paulo", EstadoCodigoIBGE: 35, CodigoTOM: "7107"},
-			{CodigoIBGE: 3509502, Nome: "Campinas", EstadoCodigoIBGE: 35, CodigoTOM: "7108"},
-			{CodigoIBGE: 3552205, Nome: "Santos", EstadoCodigoIBGE: 35, CodigoTOM: "7109"},
-		}, nil
-	case "RJ":
-		return []domain.Cidade{
-			{CodigoIBGE: 3304557, Nome: "Rio de Janeiro", EstadoCodigoIBGE: 33, CodigoTOM: "7201"},
-			{CodigoIBGE: 3301702, Nome: "Niterói", EstadoCodigoIBGE: 33, CodigoTOM: "7202"},
-		}, nil
-	case "MG":
-		return []domain.Cidade{
-			{CodigoIBGE: 3106200, Nome: "Belo Horizonte", EstadoCodigoIBGE: 31, CodigoTOM: "7301"},
-		}, nil
-	default:
-		return nil, fmt.Errorf("estado com a sigla %s não encontrado", uf)
-	}
-}
-
-func (m *mockIBGERepository) FindCidadesByEstadoCodigoIbge(codigo_ibge string) ([]domain.Cidade, error) {
-	switch codigo_ibge {
-	case "35":
-		return []domain.Cidade{
-			{CodigoIBGE: 3550308, Nome: "São Paulo", EstadoCodigoIBGE: 35, CodigoTOM: "7107"},
-			{CodigoIBGE: 3509502, Nome: "Campinas", EstadoCodigoIBGE: 35, CodigoTOM: "7108"},
-			{CodigoIBGE: 3552205, Nome: "Santos", EstadoCodigoIBGE: 35, CodigoTOM: "7109"},
-		}, nil
-	case "33":
-		return []domain.Cidade{
-			{CodigoIBGE: 3304557, Nome: "Rio de Janeiro", EstadoCodigoIBGE: 33, CodigoTOM: "7201"},
-			{CodigoIBGE: 3301702, Nome: "Niterói", EstadoCodigoIBGE: 33, CodigoTOM: "7202"},
-		}, nil
-	case "31":
-		return []domain.Cidade{
-			{CodigoIBGE: 3106200, Nome: "Belo Horizonte", EstadoCodigoIBGE: 31, CodigoTOM: "7301"},
-		}, nil
-	default:
-		return nil, fmt.Errorf("estado com o código ibge %s não encontrado", codigo_ibge)
-	}
-}
-
-func (m *mockIBGERepository) FindCidadeByCodigo(codigo string) (*domain.Cidade, error) {
-	switch codigo {
-	case "3550308":
-		return &domain.Cidade{CodigoIBGE: 3550308, Nome: "São Paulo", EstadoCodigoIBGE: 35, CodigoTOM: "7107"}, nil
-	case "3509502":
-		return &domain.Cidade{CodigoIBGE: 3509502, Nome: "Campinas", EstadoCodigoIBGE: 35, CodigoTOM: "7108"}, nil
-	case "3552205":
-		return &domain.Cidade{CodigoIBGE: 3552205, Nome: "Santos", EstadoCodigoIBGE: 35, CodigoTOM: "7109"}, nil
-	case "3304557":
-		return &domain.Cidade{CodigoIBGE: 3304557, Nome: "Rio de Janeiro", EstadoCodigoIBGE: 33, CodigoTOM: "7201"}, nil
-	case "3301702":
-		return &domain.Cidade{CodigoIBGE: 3301702, Nome: "Niterói", EstadoCodigoIBGE: 33, CodigoTOM: "7202"}, nil
-	case "3106200":
-		return &domain.Cidade{CodigoIBGE: 3106200, Nome: "Belo Horizonte", EstadoCodigoIBGE: 31, CodigoTOM: "7301"}, nil
-	default:
-		return nil, fmt.Errorf("cidade com código %s não encontrada", codigo)
-	}
-}
-
-func (m *mockIBGERepository) FindCidadeByCodigoTOM(codigo_tom string) (*domain.Cidade, error) {
-	switch codigo_tom {
-	case "7107":
-		return &domain.Cidade{CodigoIBGE: 3550308, Nome: "São Paulo", EstadoCodigoIBGE: 35, CodigoTOM: "7107"}, nil
-	case "7108":
-		return &domain.Cidade{CodigoIBGE: 3509502, Nome: "Campinas", EstadoCodigoIBGE: 35, CodigoTOM: "7108"}, nil
-	case "7109":
-		return &domain.Cidade{CodigoIBGE: 3552205, Nome: "Santos", EstadoCodigoIBGE: 35, CodigoTOM: "7109"}, nil
-	case "7201":
-		return &domain.Cidade{CodigoIBGE: 3304557, Nome: "Rio de Janeiro", EstadoCodigoIBGE: 33, CodigoTOM: "7201"}, nil
-	case "7202":
-		return &domain.Cidade{CodigoIBGE: 3301702, Nome: "Niterói", EstadoCodigoIBGE: 33, CodigoTOM: "7202"}, nil
-	case "7301":
-		return &domain.Cidade{CodigoIBGE: 3106200, Nome: "Belo Horizonte", EstadoCodigoIBGE: 31, CodigoTOM: "7301"}, nil
-	default:
-		return nil, fmt.Errorf("cidade com código TOM %s não encontrada", codigo_tom)
-	}
-}
-
 func TestIBGEHandler(t *testing.T) {
-	// Setup: criar as camadas com o mock
-	repo := &mockIBGERepository{}
-	uc := usecase.NewIBGEUseCase(repo)
-	handler := NewIBGEHandler(uc)
-	router := SetupRouter(handler)
+	router := novoRouterTeste(t)
 
 	t.Run("GET /api/v1/estados - deve retornar todos os estados", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "/api/v1/estados", nil)
@@ -392,13 +266,516 @@ func TestIBGEHandler(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("GET /api/v1/cep/{cep} - deve retornar a cidade da faixa de CEP", func(t *testing.T) {
+		testCases := []struct {
+			cep          string
+			expectedCode int
+			expectedName string
+		}{
+			{"01310-100", http.StatusOK, "São Paulo"},
+			{"20040020", http.StatusOK, "Rio de Janeiro"},
+			{"13010-000", http.StatusNotFound, ""},
+			{"1234", http.StatusBadRequest, ""},
+			{"abcde-fgh", http.StatusBadRequest, ""},
+		}
+
+		for _, tc := range testCases {
+			t.Run("CEP_"+tc.cep, func(t *testing.T) {
+				req := httptest.NewRequest("GET", "/api/v1/cep/"+tc.cep, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != tc.expectedCode {
+					t.Fatalf("Status code incorreto para CEP %s: got %v want %v", tc.cep, status, tc.expectedCode)
+				}
+
+				if tc.expectedCode == http.StatusOK {
+					var cidade domain.Cidade
+					if err := json.Unmarshal(rr.Body.Bytes(), &cidade); err != nil {
+						t.Fatalf("Erro ao decodificar JSON: %v", err)
+					}
+					if cidade.Nome != tc.expectedName {
+						t.Errorf("Nome da cidade incorreto: got %v want %v", cidade.Nome, tc.expectedName)
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/cep/{cep}/validacao - deve validar a tripla CEP, cidade e UF", func(t *testing.T) {
+		testCases := []struct {
+			query          string
+			expectedCode   int
+			expectedValido bool
+		}{
+			{"cidade=Sao+Paulo&uf=sp", http.StatusOK, true},
+			{"cidade=3550308&uf=35", http.StatusOK, true},
+			{"cidade=Campinas&uf=SP", http.StatusOK, false},
+			{"cidade=S%C3%A3o+Paulo&uf=RJ", http.StatusOK, false},
+			{"uf=SP", http.StatusBadRequest, false},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.query, func(t *testing.T) {
+				req := httptest.NewRequest("GET", "/api/v1/cep/01310-100/validacao?"+tc.query, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != tc.expectedCode {
+					t.Fatalf("Status code incorreto: got %v want %v", status, tc.expectedCode)
+				}
+
+				if tc.expectedCode == http.StatusOK {
+					var validacao domain.ValidacaoEndereco
+					if err := json.Unmarshal(rr.Body.Bytes(), &validacao); err != nil {
+						t.Fatalf("Erro ao decodificar JSON: %v", err)
+					}
+					if validacao.Valido != tc.expectedValido {
+						t.Errorf("Resultado da validação incorreto: got %v want %v (%v)", validacao.Valido, tc.expectedValido, validacao.Mensagens)
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/cep/{cep}/validacao - deve escrever as inconsistências no idioma pedido", func(t *testing.T) {
+		testCases := []struct {
+			idioma   string
+			expected []string
+		}{
+			{"", []string{"o CEP 01310100 pertence ao estado SP, não a RJ", "o CEP 01310100 pertence a São Paulo/SP, não a Niterói"}},
+			{"en", []string{"postal code (CEP) 01310100 belongs to the state SP, not RJ", "postal code (CEP) 01310100 belongs to São Paulo/SP, not Niterói"}},
+		}
+
+		for _, tc := range testCases {
+			req := httptest.NewRequest("GET", "/api/v1/cep/01310100/validacao?cidade=Niter%C3%B3i&uf=RJ", nil)
+			if tc.idioma != "" {
+				req.Header.Set("Accept-Language", tc.idioma)
+			}
+			rr := httptest.NewRecorder()
+
+			router.ServeHTTP(rr, req)
+
+			var validacao domain.ValidacaoEndereco
+			if err := json.Unmarshal(rr.Body.Bytes(), &validacao); err != nil {
+				t.Fatalf("Erro ao decodificar JSON: %v", err)
+			}
+			if !reflect.DeepEqual(validacao.Mensagens, tc.expected) {
+				t.Errorf("Inconsistências incorretas para %q.\ngot:  %q\nwant: %q", tc.idioma, validacao.Mensagens, tc.expected)
+			}
+			if idioma := rr.Header().Get("Content-Language"); tc.idioma != "" && idioma != tc.idioma {
+				t.Errorf("Content-Language incorreto: got %q want %q", idioma, tc.idioma)
+			}
+		}
+	})
+
+	t.Run("GET /api/v1/cidades/{codigo}/hora-local - deve retornar a hora local da cidade", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/cidades/3550308/hora-local", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusOK {
+			t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+		}
+
+		var horaLocal domain.HoraLocal
+		if err := json.Unmarshal(rr.Body.Bytes(), &horaLocal); err != nil {
+			t.Fatalf("Erro ao decodificar JSON: %v", err)
+		}
+
+		if horaLocal.FusoHorario != "America/Sao_Paulo" {
+			t.Errorf("Fuso horário incorreto: got %v want %v", horaLocal.FusoHorario, "America/Sao_Paulo")
+		}
+		if horaLocal.UTCOffset != "-03:00" {
+			t.Errorf("Deslocamento UTC incorreto: got %v want %v", horaLocal.UTCOffset, "-03:00")
+		}
+	})
+
+	t.Run("GET /api/v1/feriados - deve retornar feriados fixos e móveis da cidade", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/feriados?ano=2026&cidade=3550308", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusOK {
+			t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+		}
+
+		var feriados []domain.Feriado
+		if err := json.Unmarshal(rr.Body.Bytes(), &feriados); err != nil {
+			t.Fatalf("Erro ao decodificar JSON: %v", err)
+		}
+
+		datas := make(map[string]domain.TipoFeriado)
+		for _, f := range feriados {
+			datas[f.Data] = f.Tipo
+		}
+
+		expected := map[string]domain.TipoFeriado{
+			"2026-01-25": domain.FeriadoMunicipal, // Aniversário de São Paulo
+			"2026-02-17": domain.FeriadoNacional,  // Carnaval (Páscoa em 05/04/2026)
+			"2026-04-03": domain.FeriadoNacional,  // Sexta-feira Santa
+			"2026-06-04": domain.FeriadoNacional,  // Corpus Christi
+			"2026-07-09": domain.FeriadoEstadual,  // Revolução Constitucionalista
+			"2026-11-20": domain.FeriadoNacional,  // Consciência Negra
+		}
+		for data, tipo := range expected {
+			if got, ok := datas[data]; !ok || got != tipo {
+				t.Errorf("Feriado em %s incorreto: got %q want %q", data, got, tipo)
+			}
+		}
+	})
+
+	t.Run("GET /api/v1/dias-uteis - deve pular fins de semana e feriados", func(t *testing.T) {
+		testCases := []struct {
+			query        string
+			expectedCode int
+			expectedData string
+		}{
+			{"cidade=3550308&inicio=2026-02-13&dias=1", http.StatusOK, "2026-02-18"},  // Carnaval
+			{"cidade=3550308&inicio=2026-07-08&dias=1", http.StatusOK, "2026-07-10"},  // Feriado estadual
+			{"cidade=3550308&inicio=2026-02-18&dias=-1", http.StatusOK, "2026-02-13"}, // Contagem regressiva
+			{"cidade=3550308&inicio=18/02/2026&dias=1", http.StatusBadRequest, ""},
+			{"inicio=2026-02-13&dias=1", http.StatusBadRequest, ""},
+			{"cidade=999999&inicio=2026-02-13&dias=1", http.StatusNotFound, ""},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.query, func(t *testing.T) {
+				req := httptest.NewRequest("GET", "/api/v1/dias-uteis?"+tc.query, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != tc.expectedCode {
+					t.Fatalf("Status code incorreto: got %v want %v", status, tc.expectedCode)
+				}
+
+				if tc.expectedCode == http.StatusOK {
+					var resultado domain.DiasUteis
+					if err := json.Unmarshal(rr.Body.Bytes(), &resultado); err != nil {
+						t.Fatalf("Erro ao decodificar JSON: %v", err)
+					}
+					if resultado.DataFinal != tc.expectedData {
+						t.Errorf("Data final incorreta: got %v want %v", resultado.DataFinal, tc.expectedData)
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/estados/{uf}/cidades - classificação sem lista completa não deve ser exposta", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/estados/SP/cidades", nil)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		var cidades []map[string]interface{}
+		if err := json.Unmarshal(rr.Body.Bytes(), &cidades); err != nil {
+			t.Fatalf("Erro ao decodificar JSON: %v", err)
+		}
+		for _, cidade := range cidades {
+			if valor, found := cidade["zona_costeira"]; !found || valor != false {
+				t.Errorf("zona_costeira, disponível, deve ser false fora do recorte: %v", cidade)
+			}
+			if _, found := cidade["amazonia_legal"]; found {
+				t.Errorf("amazonia_legal, indisponível, não deve ser exposta: %v", cidade)
+			}
+		}
+	})
+
+	t.Run("GET /api/v1/cidades/{codigo} - deve expor aliases apenas com expand=aliases", func(t *testing.T) {
+		testCases := []struct {
+			query           string
+			expectedAliases int
+		}{
+			{"", 0},
+			{"?expand=aliases", 1},
+			{"?expand=estado,aliases", 1},
+		}
+
+		for _, tc := range testCases {
+			t.Run("Query_"+tc.query, func(t *testing.T) {
+				req := httptest.NewRequest("GET", "/api/v1/cidades/3550308"+tc.query, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				var cidade domain.Cidade
+				if err := json.Unmarshal(rr.Body.Bytes(), &cidade); err != nil {
+					t.Fatalf("Erro ao decodificar JSON: %v", err)
+				}
+				if len(cidade.Aliases) != tc.expectedAliases {
+					t.Errorf("Número de aliases incorreto: got %d want %d", len(cidade.Aliases), tc.expectedAliases)
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/cidades/busca - deve encontrar cidades por nomes alternativos", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/cidades/busca?nome=sampa", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusOK {
+			t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+		}
+
+		var cidades []domain.Cidade
+		if err := json.Unmarshal(rr.Body.Bytes(), &cidades); err != nil {
+			t.Fatalf("Erro ao decodificar JSON: %v", err)
+		}
+		if len(cidades) != 1 || cidades[0].CodigoIBGE != 3550308 {
+			t.Errorf("Resultado da busca incorreto: got %v", cidades)
+		}
+	})
+
+	t.Run("GET /api/v1/cidades/busca?data= - deve encontrar municípios extintos por nomes alternativos", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/cidades/busca?nome=antiga&data=2000-01-01", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		var cidades []domain.Cidade
+		if err := json.Unmarshal(rr.Body.Bytes(), &cidades); err != nil {
+			t.Fatalf("Erro ao decodificar JSON: %v", err)
+		}
+		if len(cidades) != 1 || cidades[0].CodigoIBGE != 3500000 {
+			t.Errorf("Resultado da busca incorreto: got %v", cidades)
+		}
+	})
+
+	t.Run("GET /api/v1/cidades/{codigo} - deve retornar os sucessores de um município extinto", func(t *testing.T) {
+		// Consultado em uma data em que já estava extinto, o município responde como hoje.
+		for _, path := range []string{"/api/v1/cidades/3500000", "/api/v1/cidades/3500000?data=2010-01-01"} {
+			req := httptest.NewRequest("GET", path, nil)
+			rr := httptest.NewRecorder()
+
+			router.ServeHTTP(rr, req)
+
+			if status := rr.Code; status != http.StatusGone {
+				t.Fatalf("%s: status code incorreto: got %v want %v", path, status, http.StatusGone)
+			}
+
+			var resposta respostaCidadeExtinta
+			if err := json.Unmarshal(rr.Body.Bytes(), &resposta); err != nil {
+				t.Fatalf("%s: erro ao decodificar JSON: %v", path, err)
+			}
+			if resposta.DataExtincao != "2005-01-01" || len(resposta.Sucessores) != 1 || resposta.Sucessores[0].CodigoIBGE != 3509502 {
+				t.Errorf("%s: resposta de município extinto incorreta: %+v", path, resposta)
+			}
+		}
+	})
+
+	t.Run("GET /api/v1/cidades/{codigo}?data= - deve retornar a situação na data", func(t *testing.T) {
+		testCases := []struct {
+			path           string
+			expectedStatus int
+			expectedNome   string
+		}{
+			{"/api/v1/cidades/3500000?data=2000-01-01", http.StatusOK, "Vila Extinta"},
+			{"/api/v1/cidades/3500000?data=2005-01-01", http.StatusGone, ""},
+			{"/api/v1/cidades/3550308?data=2010-08-01", http.StatusOK, "São Paulo"},
+			{"/api/v1/cidades/3550308?data=01/08/2010", http.StatusBadRequest, ""},
+			{"/api/v1/cidades/7108/tom?data=1800-01-01", http.StatusOK, "Campinas"},
+			{"/api/v1/cep/01310100?data=2010-08-01", http.StatusOK, "São Paulo"},
+			// O CEP de um município desmembrado depois da data pertencia ao município de origem
+			{"/api/v1/cep/11010000?data=2010-08-01", http.StatusOK, "São Paulo"},
+			{"/api/v1/cep/11010000?data=2013-01-01", http.StatusOK, "Santos"},
+			{"/api/v1/cep/11010000?data=2010", http.StatusBadRequest, ""},
+			// O histórico cobre apenas os municípios
+			{"/api/v1/estados/SP?data=2010-08-01", http.StatusBadRequest, ""},
+			{"/api/v1/estados?data=2010-08-01", http.StatusBadRequest, ""},
+			{"/api/v2/estados/SP?data=2010-08-01", http.StatusBadRequest, ""},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.path, func(t *testing.T) {
+				req := httptest.NewRequest("GET", tc.path, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != tc.expectedStatus {
+					t.Fatalf("Status code incorreto: got %v want %v", status, tc.expectedStatus)
+				}
+				if tc.expectedNome == "" {
+					return
+				}
+				var cidade domain.Cidade
+				if err := json.Unmarshal(rr.Body.Bytes(), &cidade); err != nil {
+					t.Fatalf("Erro ao decodificar JSON: %v", err)
+				}
+				if cidade.Nome != tc.expectedNome {
+					t.Errorf("Nome incorreto: got %s want %s", cidade.Nome, tc.expectedNome)
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/estados/{uf}/cidades?data= - deve listar as cidades existentes na data", func(t *testing.T) {
+		testCases := []struct {
+			data          string
+			expectedNomes []string
+		}{
+			{"2024-01-01", []string{"São Paulo", "Campinas", "Santos"}},
+			{"2010-08-01", []string{"São Paulo", "Campinas"}},
+			{"2000-01-01", []string{"São Paulo", "Campinas", "Vila Extinta"}},
+			{"1800-01-01", []string{"São Paulo", "São Carlos", "Vila Extinta"}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.data, func(t *testing.T) {
+				req := httptest.NewRequest("GET", "/api/v1/estados/SP/cidades?data="+tc.data, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != http.StatusOK {
+					t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+				}
+				var cidades []domain.Cidade
+				if err := json.Unmarshal(rr.Body.Bytes(), &cidades); err != nil {
+					t.Fatalf("Erro ao decodificar JSON: %v", err)
+				}
+				nomes := []string{}
+				for _, c := range cidades {
+					nomes = append(nomes, c.Nome)
+				}
+				if strings.Join(nomes, ",") != strings.Join(tc.expectedNomes, ",") {
+					t.Errorf("Cidades incorretas: got %v want %v", nomes, tc.expectedNomes)
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/estados - deve filtrar e ordenar os estados", func(t *testing.T) {
+		testCases := []struct {
+			name           string
+			query          string
+			expectedStatus int
+			expected       []string
+		}{
+			{"nome_contem", "?nome_contem=RIO", http.StatusOK, []string{"RJ"}},
+			{"ordem decrescente", "?ordenar=-codigo_ibge", http.StatusOK, []string{"SP", "RJ", "MG"}},
+			{"por nome", "?ordenar=nome", http.StatusOK, []string{"MG", "RJ", "SP"}},
+			{"campo inválido", "?ordenar=populacao", http.StatusBadRequest, nil},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := httptest.NewRequest("GET", "/api/v1/estados"+tc.query, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != tc.expectedStatus {
+					t.Fatalf("Status code incorreto: got %v want %v", status, tc.expectedStatus)
+				}
+				if tc.expected == nil {
+					return
+				}
+				var estados []domain.Estado
+				if err := json.Unmarshal(rr.Body.Bytes(), &estados); err != nil {
+					t.Fatalf("Erro ao decodificar JSON: %v", err)
+				}
+				siglas := []string{}
+				for _, e := range estados {
+					siglas = append(siglas, e.Sigla)
+				}
+				if !reflect.DeepEqual(siglas, tc.expected) {
+					t.Errorf("Estados incorretos: got %v want %v", siglas, tc.expected)
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/estados/{uf}/cidades - deve aplicar os filtros genéricos e a ordenação", func(t *testing.T) {
+		testCases := []struct {
+			name           string
+			url            string
+			expectedStatus int
+			expected       []int
+		}{
+			{"nome_contem sem acento", "/api/v1/estados/SP/cidades?nome_contem=sao", http.StatusOK, []int{3550308}},
+			{"ordem decrescente", "/api/v1/estados/35/cidades?ordenar=-codigo_ibge", http.StatusOK, []int{3552205, 3550308, 3509502}},
+			{"com código TOM", "/api/v1/estados/SP/cidades?tem_codigo_tom=true&ordenar=nome", http.StatusOK, []int{3509502, 3552205, 3550308}},
+			{"sem código TOM", "/api/v1/estados/SP/cidades?tem_codigo_tom=false", http.StatusOK, []int{}},
+			{"na data, ordenadas", "/api/v1/estados/SP/cidades?data=2000-01-01&ordenar=-nome", http.StatusOK, []int{3500000, 3550308, 3509502}},
+			{"tem_codigo_tom inválido", "/api/v1/estados/SP/cidades?tem_codigo_tom=talvez", http.StatusBadRequest, nil},
+			{"ordenação inválida", "/api/v1/estados/SP/cidades?ordenar=nome,populacao", http.StatusBadRequest, nil},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := httptest.NewRequest("GET", tc.url, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != tc.expectedStatus {
+					t.Fatalf("Status code incorreto: got %v want %v (%s)", status, tc.expectedStatus, rr.Body.String())
+				}
+				if tc.expected == nil {
+					return
+				}
+				var cidades []domain.Cidade
+				if err := json.Unmarshal(rr.Body.Bytes(), &cidades); err != nil {
+					t.Fatalf("Erro ao decodificar JSON: %v", err)
+				}
+				if codigos := codigosCidades(cidades); !reflect.DeepEqual(codigos, tc.expected) {
+					t.Errorf("Cidades incorretas: got %v want %v", codigos, tc.expected)
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/cidades - deve listar as cidades de todos os estados", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			query         string
+			expected      []int
+			expectedTotal string
+		}{
+			{"todas", "", []int{3550308, 3509502, 3552205, 3304557, 3301702, 3106200}, "6"},
+			{"por nome", "?ordenar=nome", []int{3106200, 3509502, 3301702, 3304557, 3552205, 3550308}, "6"},
+			{"filtradas", "?nome_contem=sao&ordenar=codigo_ibge", []int{3550308}, "1"},
+			{"paginadas", "?ordenar=nome&pagina=2&por_pagina=2", []int{3301702, 3304557}, "6"},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := httptest.NewRequest("GET", "/api/v1/cidades"+tc.query, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != http.StatusOK {
+					t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+				}
+				if total := rr.Header().Get("X-Total-Count"); total != tc.expectedTotal {
+					t.Errorf("X-Total-Count incorreto: got %q want %q", total, tc.expectedTotal)
+				}
+				var cidades []domain.Cidade
+				if err := json.Unmarshal(rr.Body.Bytes(), &cidades); err != nil {
+					t.Fatalf("Erro ao decodificar JSON: %v", err)
+				}
+				if codigos := codigosCidades(cidades); !reflect.DeepEqual(codigos, tc.expected) {
+					t.Errorf("Cidades incorretas: got %v want %v", codigos, tc.expected)
+				}
+			})
+		}
+	})
 }
 
 // Teste de benchmark para verificar performance
 func BenchmarkGetAllEstados(b *testing.B) {
 	repo := &mockIBGERepository{}
-	uc := usecase.NewIBGEUseCase(repo)
-	handler := NewIBGEHandler(uc)
+	handler := NewIBGEHandler(novoRegistroVersoes(repo))
 	router := SetupRouter(handler)
 
 	req := httptest.NewRequest("GET", "/api/v1/estados", nil)
@@ -413,8 +790,7 @@ func BenchmarkGetAllEstados(b *testing.B) {
 // Teste de benchmark para busca de cidades por estado
 func BenchmarkGetCidadesByEstado(b *testing.B) {
 	repo := &mockIBGERepository{}
-	uc := usecase.NewIBGEUseCase(repo)
-	handler := NewIBGEHandler(uc)
+	handler := NewIBGEHandler(novoRegistroVersoes(repo))
 	router := SetupRouter(handler)
 
 	req := httptest.NewRequest("GET", "/api/v1/estados/SP/cidades", nil)
@@ -425,50 +801,3 @@ func BenchmarkGetCidadesByEstado(b *testing.B) {
 		router.ServeHTTP(rr, req)
 	}
 }
-
-// Teste para verificar headers de resposta
-func TestResponseHeaders(t *testing.T) {
-	repo := &mockIBGERepository{}
-	uc := usecase.NewIBGEUseCase(repo)
-	handler := NewIBGEHandler(uc)
-	router := SetupRouter(handler)
-
-	req := httptest.NewRequest("GET", "/api/v1/estados", nil)
-	rr := httptest.NewRecorder()
-
-	router.ServeHTTP(rr, req)
-
-	// Verificar Content-Type
-	contentType := rr.Header().Get("Content-Type")
-	if contentType != "application/json" {
-		t.Errorf("Content-Type incorreto: got %v want %v", contentType, "application/json")
-	}
-
-	// Verificar headers de segurança (se implementados)
-	xContentTypeOptions := rr.Header().Get("X-Content-Type-Options")
-	if xContentTypeOptions != "nosniff" {
-		t.Errorf("X-Content-Type-Options incorreto: got %v want %v", xContentTypeOptions, "nosniff")
-	}
-}
-
-// Teste para verificar rate limiting (se implementado)
-func TestRateLimiting(t *testing.T) {
-	repo := &mockIBGERepository{}
-	uc := usecase.NewIBGEUseCase(repo)
-	handler := NewIBGEHandler(uc)
-	router := SetupRouter(handler)
-
-	// Fazer várias requisições rapidamente
-	for i := 0; i < 10; i++ {
-		req := httptest.NewRequest("GET", "/api/v1/estados", nil)
-		req.RemoteAddr = "192.168.1.1:12345" // Simular mesmo IP
-		rr := httptest.NewRecorder()
-
-		router.ServeHTTP(rr, req)
-
-		// As primeiras requisições devem passar
-		if i < 5 && rr.Code != http.StatusOK {
-			t.Errorf("Requisição %d deveria passar: got status %d", i, rr.Code)
-		}
-	}
-}
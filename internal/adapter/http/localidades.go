@@ -0,0 +1,307 @@
+package http
+
+import (
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+	"github.com/go-chi/chi/v5"
+)
+
+// Rotas compatíveis com a API de Localidades do IBGE (servicodados.ibge.gov.br/api/v1/localidades).
+// Clientes escritos para a API oficial passam a usar este serviço trocando apenas a URL base.
+//
+// Como na API oficial:
+//   - os identificadores aceitam vários valores separados por "|" (ex: /estados/33|35);
+//   - um único identificador retorna um objeto e vários retornam um array;
+//   - identificadores inexistentes resultam em um array vazio, com status 200;
+//   - as listagens aceitam ?orderBy=nome (por padrão, são ordenadas pelo código IBGE).
+//
+// O conjunto de dados guarda apenas os códigos das microrregiões e regiões imediatas. Os nomes
+// dessas divisões, e os códigos das mesorregiões e regiões intermediárias, são retornados como null.
+
+// localidadesRegiao é uma Grande Região no formato da API de Localidades.
+type localidadesRegiao struct {
+	ID    int    `json:"id"`
+	Sigla string `json:"sigla"`
+	Nome  string `json:"nome"`
+}
+
+// localidadesUF é um estado no formato da API de Localidades.
+type localidadesUF struct {
+	ID     int               `json:"id"`
+	Sigla  string            `json:"sigla"`
+	Nome   string            `json:"nome"`
+	Regiao localidadesRegiao `json:"regiao"`
+}
+
+type localidadesMesorregiao struct {
+	ID   *int          `json:"id"`
+	Nome *string       `json:"nome"`
+	UF   localidadesUF `json:"UF"`
+}
+
+type localidadesMicrorregiao struct {
+	ID          *int                   `json:"id"`
+	Nome        *string                `json:"nome"`
+	Mesorregiao localidadesMesorregiao `json:"mesorregiao"`
+}
+
+type localidadesRegiaoIntermediaria struct {
+	ID   *int          `json:"id"`
+	Nome *string       `json:"nome"`
+	UF   localidadesUF `json:"UF"`
+}
+
+type localidadesRegiaoImediata struct {
+	ID                  *int                           `json:"id"`
+	Nome                *string                        `json:"nome"`
+	RegiaoIntermediaria localidadesRegiaoIntermediaria `json:"regiao-intermediaria"`
+}
+
+// localidadesMunicipio é um município no formato da API de Localidades.
+type localidadesMunicipio struct {
+	ID             int                       `json:"id"`
+	Nome           string                    `json:"nome"`
+	Microrregiao   localidadesMicrorregiao   `json:"microrregiao"`
+	RegiaoImediata localidadesRegiaoImediata `json:"regiao-imediata"`
+}
+
+func novaRegiaoLocalidades(r domain.Regiao) localidadesRegiao {
+	return localidadesRegiao{ID: r.CodigoIBGE, Sigla: r.Sigla, Nome: r.Nome}
+}
+
+func novaUFLocalidades(e domain.Estado) localidadesUF {
+	return localidadesUF{ID: e.CodigoIBGE, Sigla: e.Sigla, Nome: e.Nome, Regiao: novaRegiaoLocalidades(e.Regiao())}
+}
+
+// novoMunicipioLocalidades monta o município com a hierarquia territorial da API de Localidades.
+func novoMunicipioLocalidades(c domain.Cidade, uf localidadesUF) localidadesMunicipio {
+	return localidadesMunicipio{
+		ID:   c.CodigoIBGE,
+		Nome: c.Nome,
+		Microrregiao: localidadesMicrorregiao{
+			ID:          codigoOpcional(c.MicroRegiao),
+			Mesorregiao: localidadesMesorregiao{UF: uf},
+		},
+		RegiaoImediata: localidadesRegiaoImediata{
+			ID:                  codigoOpcional(c.RegiaoImediata),
+			RegiaoIntermediaria: localidadesRegiaoIntermediaria{UF: uf},
+		},
+	}
+}
+
+// codigoOpcional converte um código territorial, retornando nil se ele não estiver cadastrado.
+func codigoOpcional(codigo string) *int {
+	n, err := strconv.Atoi(codigo)
+	if err != nil {
+		return nil
+	}
+	return &n
+}
+
+// identificadores separa os valores de um parâmetro de rota no formato "33|35".
+func identificadores(r *http.Request, parametro string) []string {
+	var ids []string
+	for _, id := range strings.Split(chi.URLParam(r, parametro), "|") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// respondLocalidades responde como a API de Localidades: um objeto quando um único
+// identificador foi pedido e encontrado, e um array nos demais casos.
+func respondLocalidades[T any](w http.ResponseWriter, r *http.Request, pedidos int, itens []T) {
+	if pedidos == 1 && len(itens) == 1 {
+		respond(w, r, http.StatusOK, itens[0])
+		return
+	}
+	if itens == nil {
+		itens = []T{}
+	}
+	respond(w, r, http.StatusOK, itens)
+}
+
+// ordenarPorNome aplica ?orderBy=nome, comparando os nomes sem acentos nem caixa.
+func ordenarPorNome[T any](r *http.Request, itens []T, nome func(T) string) {
+	if r.URL.Query().Get("orderBy") != "nome" {
+		return
+	}
+	sort.SliceStable(itens, func(i, j int) bool {
+		return domain.NormalizarNome(nome(itens[i])) < domain.NormalizarNome(nome(itens[j]))
+	})
+}
+
+// ufsPorCodigo indexa os estados da versão selecionada no formato da API de Localidades.
+func (h *IBGEHandler) ufsPorCodigo(r *http.Request) (map[int]localidadesUF, error) {
+	estados, err := h.casoDeUso(r).GetAllEstados()
+	if err != nil {
+		return nil, err
+	}
+	ufs := make(map[int]localidadesUF, len(estados))
+	for _, e := range estados {
+		ufs[e.CodigoIBGE] = novaUFLocalidades(e)
+	}
+	return ufs, nil
+}
+
+// estadosPorIdentificador busca os estados pelo código IBGE ou pela sigla, ignorando os inexistentes.
+func (h *IBGEHandler) estadosPorIdentificador(r *http.Request, ids []string) []domain.Estado {
+	uc := h.casoDeUso(r)
+	var estados []domain.Estado
+	for _, id := range ids {
+		var estado *domain.Estado
+		var err error
+		if _, parseErr := strconv.Atoi(id); parseErr == nil {
+			estado, err = uc.GetEstadoByCodigoIbge(id)
+		} else {
+			estado, err = uc.GetEstadoByUF(strings.ToUpper(id))
+		}
+		if err == nil {
+			estados = append(estados, *estado)
+		}
+	}
+	return estados
+}
+
+// respondMunicipios converte as cidades para o formato da API de Localidades e aplica a ordenação pedida.
+func (h *IBGEHandler) respondMunicipios(w http.ResponseWriter, r *http.Request, pedidos int, cidades []domain.Cidade) {
+	ufs, err := h.ufsPorCodigo(r)
+	if err != nil {
+		log.Printf("Erro ao buscar estados: %v", err)
+		respondWithError(w, r, http.StatusInternalServerError, domain.MsgErroInterno)
+		return
+	}
+
+	municipios := make([]localidadesMunicipio, 0, len(cidades))
+	for _, c := range cidades {
+		municipios = append(municipios, novoMunicipioLocalidades(c, ufs[c.EstadoCodigoIBGE]))
+	}
+	ordenarPorNome(r, municipios, func(m localidadesMunicipio) string { return m.Nome })
+	respondLocalidades(w, r, pedidos, municipios)
+}
+
+func respondEstados(w http.ResponseWriter, r *http.Request, pedidos int, estados []domain.Estado) {
+	ufs := make([]localidadesUF, 0, len(estados))
+	for _, e := range estados {
+		ufs = append(ufs, novaUFLocalidades(e))
+	}
+	sort.SliceStable(ufs, func(i, j int) bool { return ufs[i].ID < ufs[j].ID })
+	ordenarPorNome(r, ufs, func(uf localidadesUF) string { return uf.Nome })
+	respondLocalidades(w, r, pedidos, ufs)
+}
+
+// LocalidadesRegioes lista as Grandes Regiões (GET /localidades/regioes).
+func (h *IBGEHandler) LocalidadesRegioes(w http.ResponseWriter, r *http.Request) {
+	var regioes []localidadesRegiao
+	for _, regiao := range h.casoDeUso(r).GetRegioes() {
+		regioes = append(regioes, novaRegiaoLocalidades(regiao))
+	}
+	ordenarPorNome(r, regioes, func(regiao localidadesRegiao) string { return regiao.Nome })
+	respondLocalidades(w, r, 0, regioes)
+}
+
+// LocalidadesRegiao busca regiões pelo código ou sigla (GET /localidades/regioes/{macrorregiao}).
+func (h *IBGEHandler) LocalidadesRegiao(w http.ResponseWriter, r *http.Request) {
+	ids := identificadores(r, "macrorregiao")
+	var regioes []localidadesRegiao
+	for _, id := range ids {
+		if regiao, err := h.casoDeUso(r).GetRegiao(id); err == nil {
+			regioes = append(regioes, novaRegiaoLocalidades(*regiao))
+		}
+	}
+	respondLocalidades(w, r, len(ids), regioes)
+}
+
+// LocalidadesEstadosByRegiao lista os estados das regiões (GET /localidades/regioes/{macrorregiao}/estados).
+func (h *IBGEHandler) LocalidadesEstadosByRegiao(w http.ResponseWriter, r *http.Request) {
+	var estados []domain.Estado
+	for _, id := range identificadores(r, "macrorregiao") {
+		daRegiao, err := h.casoDeUso(r).GetEstadosByRegiao(id)
+		if err != nil {
+			continue
+		}
+		estados = append(estados, daRegiao...)
+	}
+	respondEstados(w, r, 0, estados)
+}
+
+// LocalidadesMunicipiosByRegiao lista os municípios das regiões (GET /localidades/regioes/{macrorregiao}/municipios).
+func (h *IBGEHandler) LocalidadesMunicipiosByRegiao(w http.ResponseWriter, r *http.Request) {
+	var cidades []domain.Cidade
+	for _, id := range identificadores(r, "macrorregiao") {
+		if _, err := h.casoDeUso(r).GetRegiao(id); err != nil {
+			continue
+		}
+		daRegiao, err := h.casoDeUso(r).GetCidadesByRegiao(id)
+		if err != nil {
+			log.Printf("Erro ao buscar municípios da região %s: %v", id, err)
+			respondWithError(w, r, http.StatusInternalServerError, domain.MsgErroInterno)
+			return
+		}
+		cidades = append(cidades, daRegiao...)
+	}
+	h.respondMunicipios(w, r, 0, cidades)
+}
+
+// LocalidadesEstados lista os estados (GET /localidades/estados).
+func (h *IBGEHandler) LocalidadesEstados(w http.ResponseWriter, r *http.Request) {
+	estados, err := h.casoDeUso(r).GetAllEstados()
+	if err != nil {
+		log.Printf("Erro ao buscar estados: %v", err)
+		respondWithError(w, r, http.StatusInternalServerError, domain.MsgErroInterno)
+		return
+	}
+	respondEstados(w, r, 0, estados)
+}
+
+// LocalidadesEstado busca estados pelo código IBGE ou pela sigla (GET /localidades/estados/{UF}).
+func (h *IBGEHandler) LocalidadesEstado(w http.ResponseWriter, r *http.Request) {
+	ids := identificadores(r, "UF")
+	respondEstados(w, r, len(ids), h.estadosPorIdentificador(r, ids))
+}
+
+// LocalidadesMunicipiosByEstado lista os municípios dos estados (GET /localidades/estados/{UF}/municipios).
+func (h *IBGEHandler) LocalidadesMunicipiosByEstado(w http.ResponseWriter, r *http.Request) {
+	var cidades []domain.Cidade
+	for _, estado := range h.estadosPorIdentificador(r, identificadores(r, "UF")) {
+		doEstado, err := h.casoDeUso(r).GetCidadesByEstadoCodigoIbge(strconv.Itoa(estado.CodigoIBGE))
+		if err != nil {
+			log.Printf("Erro ao buscar municípios do estado %s: %v", estado.Sigla, err)
+			respondWithError(w, r, http.StatusInternalServerError, domain.MsgErroInterno)
+			return
+		}
+		cidades = append(cidades, doEstado...)
+	}
+	sort.SliceStable(cidades, func(i, j int) bool { return cidades[i].CodigoIBGE < cidades[j].CodigoIBGE })
+	h.respondMunicipios(w, r, 0, cidades)
+}
+
+// LocalidadesMunicipios lista todos os municípios (GET /localidades/municipios).
+func (h *IBGEHandler) LocalidadesMunicipios(w http.ResponseWriter, r *http.Request) {
+	cidades, err := h.casoDeUso(r).GetAllCidades()
+	if err != nil {
+		log.Printf("Erro ao buscar municípios: %v", err)
+		respondWithError(w, r, http.StatusInternalServerError, domain.MsgErroInterno)
+		return
+	}
+	h.respondMunicipios(w, r, 0, cidades)
+}
+
+// LocalidadesMunicipio busca municípios pelo código IBGE (GET /localidades/municipios/{municipio}).
+// Municípios extintos não são retornados, como na API oficial.
+func (h *IBGEHandler) LocalidadesMunicipio(w http.ResponseWriter, r *http.Request) {
+	ids := identificadores(r, "municipio")
+	var cidades []domain.Cidade
+	for _, id := range ids {
+		if cidade, err := h.casoDeUso(r).GetCidadeByCodigo(id); err == nil {
+			cidades = append(cidades, *cidade)
+		}
+	}
+	h.respondMunicipios(w, r, len(ids), cidades)
+}
@@ -0,0 +1,110 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestLocalidades cobre as rotas compatíveis com a API de Localidades do IBGE.
+func TestLocalidades(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("GET /localidades/municipios/{id} - deve responder no formato da API de Localidades", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/localidades/municipios/3550308", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusOK {
+			t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+		}
+		var municipio struct {
+			ID           int    `json:"id"`
+			Nome         string `json:"nome"`
+			Microrregiao struct {
+				Mesorregiao struct {
+					UF struct {
+						Sigla  string `json:"sigla"`
+						Regiao struct {
+							Sigla string `json:"sigla"`
+						} `json:"regiao"`
+					} `json:"UF"`
+				} `json:"mesorregiao"`
+			} `json:"microrregiao"`
+		}
+		if err := json.Unmarshal(rr.Body.Bytes(), &municipio); err != nil {
+			t.Fatalf("Erro ao decodificar JSON: %v", err)
+		}
+		uf := municipio.Microrregiao.Mesorregiao.UF
+		if municipio.ID != 3550308 || uf.Sigla != "SP" || uf.Regiao.Sigla != "SE" {
+			t.Errorf("Município incorreto: %s", rr.Body.String())
+		}
+	})
+
+	t.Run("GET /localidades - deve reproduzir os caminhos da API de Localidades", func(t *testing.T) {
+		testCases := []struct {
+			path          string
+			expectedTotal int // -1 quando a resposta é um único objeto
+		}{
+			{"/localidades/regioes", 5},
+			{"/localidades/regioes/SE", -1},
+			{"/localidades/regioes/3/estados", 3},
+			{"/localidades/regioes/3/municipios", 6},
+			{"/localidades/estados", 3},
+			{"/localidades/estados/35", -1},
+			{"/localidades/estados/SP|RJ", 2},
+			{"/localidades/estados/SP/municipios", 3},
+			{"/localidades/municipios", 6},
+			{"/localidades/municipios/3550308|3304557", 2},
+			{"/localidades/municipios/9999999", 0},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.path, func(t *testing.T) {
+				req := httptest.NewRequest("GET", tc.path, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != http.StatusOK {
+					t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+				}
+				if tc.expectedTotal < 0 {
+					if !strings.HasPrefix(rr.Body.String(), "{") {
+						t.Errorf("Esperava um objeto, mas recebi: %s", rr.Body.String())
+					}
+					return
+				}
+				var itens []map[string]interface{}
+				if err := json.Unmarshal(rr.Body.Bytes(), &itens); err != nil {
+					t.Fatalf("Erro ao decodificar JSON: %v", err)
+				}
+				if len(itens) != tc.expectedTotal {
+					t.Errorf("Número de itens incorreto: got %d want %d", len(itens), tc.expectedTotal)
+				}
+			})
+		}
+	})
+
+	t.Run("GET /localidades/estados?orderBy=nome - deve ordenar pelo nome", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/localidades/estados?orderBy=nome", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		var estados []localidadesUF
+		if err := json.Unmarshal(rr.Body.Bytes(), &estados); err != nil {
+			t.Fatalf("Erro ao decodificar JSON: %v", err)
+		}
+		var siglas []string
+		for _, e := range estados {
+			siglas = append(siglas, e.Sigla)
+		}
+		if strings.Join(siglas, ",") != "MG,RJ,SP" {
+			t.Errorf("Ordem incorreta: %v", siglas)
+		}
+	})
+}
@@ -0,0 +1,309 @@
+package http
+
+import (
+	"flag"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+	"github.com/brauliohms/ibge-service/internal/usecase"
+)
+
+// mockIBGERepository é um mock para o repositório usado pelo caso de uso.
+type mockIBGERepository struct{}
+
+func (m *mockIBGERepository) FindAllEstados() ([]domain.Estado, error) {
+	return []domain.Estado{
+		{CodigoIBGE: 35, Sigla: "SP", Nome: "São Paulo"},
+		{CodigoIBGE: 33, Sigla: "RJ", Nome: "Rio de Janeiro"},
+		{CodigoIBGE: 31, Sigla: "MG", Nome: "Minas Gerais"},
+	}, nil
+}
+
+func (m *mockIBGERepository) FindEstadoByUF(uf string) (*domain.Estado, error) {
+	uf = strings.ToUpper(uf)
+	switch uf {
+	case "SP":
+		return &domain.Estado{CodigoIBGE: 35, Sigla: "SP", Nome: "São Paulo", Feriados: []domain.RegraFeriado{
+			{Nome: "Revolução Constitucionalista de 1932", Tipo: domain.FeriadoEstadual, Dia: 9, Mes: 7},
+		}}, nil
+	case "RJ":
+		return &domain.Estado{CodigoIBGE: 33, Sigla: "RJ", Nome: "Rio de Janeiro"}, nil
+	case "MG":
+		return &domain.Estado{CodigoIBGE: 31, Sigla: "MG", Nome: "Minas Gerais"}, nil
+	default:
+		return nil, domain.NaoEncontrado(domain.MsgEstadoSiglaNaoEncontrado, uf)
+	}
+}
+
+func (m *mockIBGERepository) FindEstadoByCodigoIbge(codigo_ibge string) (*domain.Estado, error) {
+	switch codigo_ibge {
+	case "35":
+		return &domain.Estado{CodigoIBGE: 35, Sigla: "SP", Nome: "São Paulo"}, nil
+	case "33":
+		return &domain.Estado{CodigoIBGE: 33, Sigla: "RJ", Nome: "Rio de Janeiro"}, nil
+	case "31":
+		return &domain.Estado{CodigoIBGE: 31, Sigla: "MG", Nome: "Minas Gerais"}, nil
+	default:
+		return nil, domain.NaoEncontrado(domain.MsgEstadoCodigoNaoEncontrado, codigo_ibge)
+	}
+}
+
+func (m *mockIBGERepository) FindCidadesByEstadoUF(uf string) ([]domain.Cidade, error) {
+	uf = strings.ToUpper(uf)
+	switch uf {
+	case "SP":
+		return []domain.Cidade{
+			{CodigoIBGE: 3550308, Nome: "São Paulo", EstadoCodigoIBGE: 35, ZonaCosteira: &nao, CodigoTOM: "7107"},
+			{CodigoIBGE: 3509502, Nome: "Campinas", EstadoCodigoIBGE: 35, ZonaCosteira: &nao, CodigoTOM: "7108", Historico: []domain.EventoMunicipio{
+				{CodigoIBGE: 3509502, Tipo: domain.EventoRenomeacao, Data: "1842-07-05", Nome: "Campinas", NomeAnterior: "São Carlos"},
+			}},
+			{CodigoIBGE: 3552205, Nome: "Santos", EstadoCodigoIBGE: 35, ZonaCosteira: &nao, CodigoTOM: "7109", Historico: []domain.EventoMunicipio{
+				{CodigoIBGE: 3552205, Tipo: domain.EventoDesmembramento, Data: "2013-01-01", Origem: []int{3550308}},
+			}},
+		}, nil
+	case "RJ":
+		return []domain.Cidade{
+			{CodigoIBGE: 3304557, Nome: "Rio de Janeiro", EstadoCodigoIBGE: 33, CodigoTOM: "7201", ZonaCosteira: &sim},
+			{CodigoIBGE: 3301702, Nome: "Niterói", EstadoCodigoIBGE: 33, CodigoTOM: "7202", ZonaCosteira: &sim},
+		}, nil
+	case "MG":
+		return []domain.Cidade{
+			{CodigoIBGE: 3106200, Nome: "Belo Horizonte", EstadoCodigoIBGE: 31, ZonaCosteira: &nao, CodigoTOM: "7301"},
+		}, nil
+	default:
+		return nil, domain.NaoEncontrado(domain.MsgEstadoSiglaNaoEncontrado, uf)
+	}
+}
+
+func (m *mockIBGERepository) FindCidadesByEstadoCodigoIbge(codigo_ibge string) ([]domain.Cidade, error) {
+	switch codigo_ibge {
+	case "35":
+		return []domain.Cidade{
+			{CodigoIBGE: 3550308, Nome: "São Paulo", EstadoCodigoIBGE: 35, ZonaCosteira: &nao, CodigoTOM: "7107"},
+			{CodigoIBGE: 3509502, Nome: "Campinas", EstadoCodigoIBGE: 35, ZonaCosteira: &nao, CodigoTOM: "7108"},
+			{CodigoIBGE: 3552205, Nome: "Santos", EstadoCodigoIBGE: 35, ZonaCosteira: &nao, CodigoTOM: "7109"},
+		}, nil
+	case "33":
+		return []domain.Cidade{
+			{CodigoIBGE: 3304557, Nome: "Rio de Janeiro", EstadoCodigoIBGE: 33, ZonaCosteira: &sim, CodigoTOM: "7201"},
+			{CodigoIBGE: 3301702, Nome: "Niterói", EstadoCodigoIBGE: 33, ZonaCosteira: &sim, CodigoTOM: "7202"},
+		}, nil
+	case "31":
+		return []domain.Cidade{
+			{CodigoIBGE: 3106200, Nome: "Belo Horizonte", EstadoCodigoIBGE: 31, ZonaCosteira: &nao, CodigoTOM: "7301"},
+		}, nil
+	default:
+		return nil, domain.NaoEncontrado(domain.MsgEstadoCodigoNaoEncontrado, codigo_ibge)
+	}
+}
+
+func (m *mockIBGERepository) FindCidadeByCodigo(codigo string) (*domain.Cidade, error) {
+	if _, err := strconv.Atoi(codigo); err != nil {
+		return nil, domain.CodigoInvalido(domain.MsgCodigoIBGEInvalido, codigo)
+	}
+	switch codigo {
+	case "3550308":
+		return &domain.Cidade{CodigoIBGE: 3550308, Nome: "São Paulo", Aliases: []string{"Sampa"}, ZonaCosteira: &nao, EstadoCodigoIBGE: 35, EstadoSigla: "SP", CodigoTOM: "7107", FusoHorario: "America/Sao_Paulo", Feriados: []domain.RegraFeriado{
+			{Nome: "Aniversário de São Paulo", Tipo: domain.FeriadoMunicipal, Dia: 25, Mes: 1},
+		}}, nil
+	case "3509502":
+		return &domain.Cidade{CodigoIBGE: 3509502, Nome: "Campinas", EstadoCodigoIBGE: 35, ZonaCosteira: &nao, CodigoTOM: "7108"}, nil
+	case "3552205":
+		return &domain.Cidade{CodigoIBGE: 3552205, Nome: "Santos", EstadoCodigoIBGE: 35, ZonaCosteira: &nao, CodigoTOM: "7109"}, nil
+	case "3304557":
+		return &domain.Cidade{CodigoIBGE: 3304557, Nome: "Rio de Janeiro", EstadoCodigoIBGE: 33, ZonaCosteira: &sim, CodigoTOM: "7201"}, nil
+	case "3301702":
+		return &domain.Cidade{CodigoIBGE: 3301702, Nome: "Niterói", EstadoCodigoIBGE: 33, ZonaCosteira: &sim, CodigoTOM: "7202"}, nil
+	case "3106200":
+		return &domain.Cidade{CodigoIBGE: 3106200, Nome: "Belo Horizonte", EstadoCodigoIBGE: 31, ZonaCosteira: &nao, CodigoTOM: "7301"}, nil
+	case "3500000":
+		extintas, _ := m.FindCidadesExtintas()
+		campinas, _ := m.FindCidadeByCodigo("3509502")
+		return nil, &domain.CidadeExtintaError{Cidade: extintas[0], Data: "2005-01-01", Sucessores: []domain.Cidade{*campinas}}
+	default:
+		return nil, domain.NaoEncontrado(domain.MsgCidadeNaoEncontrada, codigo)
+	}
+}
+
+func (m *mockIBGERepository) FindCidadeByCodigoTOM(codigo_tom string) (*domain.Cidade, error) {
+	switch codigo_tom {
+	case "7107":
+		return &domain.Cidade{CodigoIBGE: 3550308, Nome: "São Paulo", EstadoCodigoIBGE: 35, ZonaCosteira: &nao, CodigoTOM: "7107"}, nil
+	case "7108":
+		return &domain.Cidade{CodigoIBGE: 3509502, Nome: "Campinas", EstadoCodigoIBGE: 35, ZonaCosteira: &nao, CodigoTOM: "7108"}, nil
+	case "7109":
+		return &domain.Cidade{CodigoIBGE: 3552205, Nome: "Santos", EstadoCodigoIBGE: 35, ZonaCosteira: &nao, CodigoTOM: "7109"}, nil
+	case "7201":
+		return &domain.Cidade{CodigoIBGE: 3304557, Nome: "Rio de Janeiro", EstadoCodigoIBGE: 33, ZonaCosteira: &sim, CodigoTOM: "7201"}, nil
+	case "7202":
+		return &domain.Cidade{CodigoIBGE: 3301702, Nome: "Niterói", EstadoCodigoIBGE: 33, ZonaCosteira: &sim, CodigoTOM: "7202"}, nil
+	case "7301":
+		return &domain.Cidade{CodigoIBGE: 3106200, Nome: "Belo Horizonte", EstadoCodigoIBGE: 31, ZonaCosteira: &nao, CodigoTOM: "7301"}, nil
+	default:
+		return nil, domain.NaoEncontrado(domain.MsgCidadeTOMNaoEncontrada, codigo_tom)
+	}
+}
+
+func (m *mockIBGERepository) FindCidadeByCEP(cep string) (*domain.Cidade, error) {
+	switch {
+	case cep >= "01000000" && cep <= "05999999":
+		return &domain.Cidade{CodigoIBGE: 3550308, Nome: "São Paulo", EstadoCodigoIBGE: 35, EstadoSigla: "SP", CodigoTOM: "7107", ZonaCosteira: &nao}, nil
+	case cep >= "11000000" && cep <= "11299999":
+		return &domain.Cidade{CodigoIBGE: 3552205, Nome: "Santos", EstadoCodigoIBGE: 35, EstadoSigla: "SP", CodigoTOM: "7109", ZonaCosteira: &nao, Historico: []domain.EventoMunicipio{
+			{CodigoIBGE: 3552205, Tipo: domain.EventoDesmembramento, Data: "2013-01-01", Origem: []int{3550308}},
+		}}, nil
+	case cep >= "20000000" && cep <= "23799999":
+		return &domain.Cidade{CodigoIBGE: 3304557, Nome: "Rio de Janeiro", EstadoCodigoIBGE: 33, EstadoSigla: "RJ", CodigoTOM: "7201", ZonaCosteira: &sim}, nil
+	default:
+		return nil, domain.NaoEncontrado(domain.MsgCidadeCEPNaoEncontrada, cep)
+	}
+}
+
+func (m *mockIBGERepository) FindEstadoByCEP(cep string) (*domain.Estado, error) {
+	switch {
+	case cep >= "01000000" && cep <= "19999999":
+		return &domain.Estado{CodigoIBGE: 35, Sigla: "SP", Nome: "São Paulo"}, nil
+	case cep >= "20000000" && cep <= "28999999":
+		return &domain.Estado{CodigoIBGE: 33, Sigla: "RJ", Nome: "Rio de Janeiro"}, nil
+	default:
+		return nil, domain.NaoEncontrado(domain.MsgEstadoCEPNaoEncontrado, cep)
+	}
+}
+
+func (m *mockIBGERepository) FindEstados(consulta domain.ConsultaEstados) ([]domain.Estado, error) {
+	estados, _ := m.FindAllEstados()
+	return consulta.Filtrar(estados), nil
+}
+
+func (m *mockIBGERepository) FindCidades(consulta domain.ConsultaCidades) ([]domain.Cidade, error) {
+	var todas []domain.Cidade
+	for _, uf := range []string{"SP", "RJ", "MG"} {
+		cidades, _ := m.FindCidadesByEstadoUF(uf)
+		todas = append(todas, cidades...)
+	}
+	return consulta.Filtrar(todas), nil
+}
+
+func (m *mockIBGERepository) FindCidadesByNome(nome string, uf string) ([]domain.Cidade, error) {
+	todas, _ := m.FindCidadesByEstadoUF("SP")
+	sp, _ := m.FindCidadeByCodigo("3550308")
+	todas[0] = *sp
+
+	cidades := []domain.Cidade{}
+	for _, c := range todas {
+		if c.ConhecidaComo(nome) {
+			cidades = append(cidades, c)
+		}
+	}
+	return cidades, nil
+}
+
+func (m *mockIBGERepository) FindAllFaixasCEP() ([]domain.FaixaCEP, error) {
+	return []domain.FaixaCEP{
+		{UF: "SP", CEPInicial: "01000000", CEPFinal: "19999999"},
+		{UF: "RJ", CEPInicial: "20000000", CEPFinal: "28999999"},
+		{UF: "SP", CodigoIBGE: 3550308, CEPInicial: "01000000", CEPFinal: "05999999"},
+		{UF: "RJ", CodigoIBGE: 3304557, CEPInicial: "20000000", CEPFinal: "23799999"},
+	}, nil
+}
+
+func (m *mockIBGERepository) FindCidadesExtintas() ([]domain.Cidade, error) {
+	return []domain.Cidade{
+		{CodigoIBGE: 3500000, Nome: "Vila Extinta", Aliases: []string{"Vila Antiga"}, EstadoCodigoIBGE: 35, EstadoSigla: "SP", Historico: []domain.EventoMunicipio{
+			{CodigoIBGE: 3500000, Tipo: domain.EventoExtincao, Data: "2005-01-01", Sucessores: []int{3509502}},
+		}},
+	}, nil
+}
+
+func (m *mockIBGERepository) FindAlteracoesDesde(versao int) ([]domain.Alteracao, error) {
+	todas := []domain.Alteracao{
+		{Versao: 1, Entidade: domain.EntidadeCidade, Tipo: domain.Adicionado, CodigoIBGE: 3552205, Nome: "Santos", UF: "SP"},
+		{Versao: 2, Entidade: domain.EntidadeCidade, Tipo: domain.Alterado, CodigoIBGE: 3509502, Nome: "Campinas", UF: "SP", Campos: []domain.DiferencaCampo{
+			{Campo: "nome", Antes: "São Carlos", Depois: "Campinas"},
+		}},
+	}
+	var alteracoes []domain.Alteracao
+	for _, a := range todas {
+		if a.Versao > versao {
+			alteracoes = append(alteracoes, a)
+		}
+	}
+	return alteracoes, nil
+}
+
+func (m *mockIBGERepository) FindVersaoDataset() (int, error) {
+	return 2, nil
+}
+
+// atualizacaoMock é a data da última execução do seed informada pelo mock.
+var atualizacaoMock = time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+
+func (m *mockIBGERepository) FindAtualizadoEm() (time.Time, error) {
+	return atualizacaoMock, nil
+}
+
+// sim e nao marcam se as cidades do mock pertencem à zona costeira, a única classificação disponível.
+var sim, nao = true, false
+
+// mockIBGERepository2020 simula uma edição anterior do conjunto de dados, sem o estado de MG.
+type mockIBGERepository2020 struct {
+	mockIBGERepository
+}
+
+func (m *mockIBGERepository2020) FindAllEstados() ([]domain.Estado, error) {
+	return []domain.Estado{
+		{CodigoIBGE: 35, Sigla: "SP", Nome: "São Paulo"},
+		{CodigoIBGE: 33, Sigla: "RJ", Nome: "Rio de Janeiro"},
+	}, nil
+}
+
+func (m *mockIBGERepository2020) FindEstados(consulta domain.ConsultaEstados) ([]domain.Estado, error) {
+	estados, _ := m.FindAllEstados()
+	return consulta.Filtrar(estados), nil
+}
+
+// novoRegistroVersoes registra o repositório como a versão 2024 e o mock da edição 2020.
+func novoRegistroVersoes(repo usecase.IBGERepository) *usecase.RegistroVersoes {
+	versoes := usecase.NewRegistroVersoes()
+	versoes.Registrar("2024", usecase.NewIBGEUseCase(repo))
+	versoes.Registrar("2020", usecase.NewIBGEUseCase(&mockIBGERepository2020{}))
+	return versoes
+}
+
+// codigosCidades retorna os códigos IBGE das cidades, na ordem da lista.
+func codigosCidades(cidades []domain.Cidade) []int {
+	codigos := make([]int, len(cidades))
+	for i, c := range cidades {
+		codigos[i] = c.CodigoIBGE
+	}
+	return codigos
+}
+
+// linksPaginacao separa os links do header Link por relação (first, prev, next, last).
+func linksPaginacao(header string) map[string]string {
+	links := map[string]string{}
+	for _, link := range strings.Split(header, ",") {
+		partes := strings.Split(strings.TrimSpace(link), "; ")
+		if len(partes) == 2 {
+			rel := strings.Trim(strings.TrimPrefix(partes[1], "rel="), `"`)
+			links[rel] = strings.Trim(partes[0], "<>")
+		}
+	}
+	return links
+}
+
+// atualizarGolden regrava os arquivos de referência: go test ./internal/adapter/http -run BrasilAPI -update
+var atualizarGolden = flag.Bool("update", false, "regrava os arquivos golden em testdata")
+
+// novoRouterTeste monta o roteador completo sobre o repositório mock. Todas as requisições dos
+// testes vêm do mesmo IP; o limite padrão (100 por minuto) é coberto por TestRateLimiting e não
+// deve interromper os demais casos.
+func novoRouterTeste(t *testing.T) http.Handler {
+	t.Helper()
+	t.Setenv("RATE_LIMIT", "1000")
+	return SetupRouter(NewIBGEHandler(novoRegistroVersoes(&mockIBGERepository{})))
+}
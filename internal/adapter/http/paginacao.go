@@ -0,0 +1,268 @@
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// HeaderTotalRegistros informa a quantidade total de itens de uma lista, antes da paginação.
+const HeaderTotalRegistros = "X-Total-Count"
+
+const (
+	porPaginaPadrao = 100
+	porPaginaMaximo = 1000
+)
+
+// paginacao é a página pedida pelo cliente: por número (?pagina=&por_pagina=) ou por cursor
+// (?cursor=). Sem nenhum desses parâmetros a lista é retornada inteira.
+type paginacao struct {
+	inicio    int
+	porPagina int
+	cursor    bool
+}
+
+// cursorPaginacao é o conteúdo do cursor opaco enviado ao cliente. A versão do conjunto de dados
+// garante que as páginas seguintes sejam da mesma edição, cuja ordem das listas é fixa.
+type cursorPaginacao struct {
+	Versao    string `json:"v"`
+	Inicio    int    `json:"i"`
+	PorPagina int    `json:"n"`
+}
+
+func codificarCursor(c cursorPaginacao) string {
+	dados, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(dados)
+}
+
+func decodificarCursor(valor string) (cursorPaginacao, error) {
+	var c cursorPaginacao
+	dados, err := base64.RawURLEncoding.DecodeString(valor)
+	if err == nil {
+		err = json.Unmarshal(dados, &c)
+	}
+	if err != nil || c.Inicio < 0 || c.PorPagina < 1 || c.PorPagina > porPaginaMaximo {
+		return cursorPaginacao{}, domain.ArgumentoInvalido(domain.MsgCursorInvalido, valor)
+	}
+	return c, nil
+}
+
+// lerPaginacao lê os parâmetros de paginação. O segundo retorno indica se a paginação foi pedida;
+// ?cursor= vazio inicia a navegação por cursor na primeira página.
+func lerPaginacao(r *http.Request, versao string) (paginacao, bool, error) {
+	query := r.URL.Query()
+	if !query.Has("pagina") && !query.Has("por_pagina") && !query.Has("cursor") {
+		return paginacao{}, false, nil
+	}
+	if query.Has("pagina") && query.Has("cursor") {
+		return paginacao{}, false, domain.ArgumentoInvalido(domain.MsgPaginaECursor)
+	}
+
+	p := paginacao{porPagina: porPaginaPadrao, cursor: query.Has("cursor")}
+	if valor := query.Get("cursor"); valor != "" {
+		c, err := decodificarCursor(valor)
+		if err != nil {
+			return paginacao{}, false, err
+		}
+		if c.Versao != versao {
+			return paginacao{}, false, domain.ArgumentoInvalido(domain.MsgCursorOutraVersao, c.Versao, versao)
+		}
+		p.inicio, p.porPagina = c.Inicio, c.PorPagina
+	}
+
+	if valor := query.Get("por_pagina"); valor != "" {
+		n, err := strconv.Atoi(valor)
+		if err != nil || n < 1 || n > porPaginaMaximo {
+			return paginacao{}, false, domain.ArgumentoInvalido(domain.MsgPorPaginaInvalido, valor, porPaginaMaximo)
+		}
+		p.porPagina = n
+	}
+
+	if valor := query.Get("pagina"); valor != "" {
+		n, err := strconv.Atoi(valor)
+		// Páginas muito grandes estourariam o cálculo da posição do primeiro item
+		if err != nil || n < 1 || n-1 > math.MaxInt/p.porPagina {
+			return paginacao{}, false, domain.ArgumentoInvalido(domain.MsgPaginaInvalida, valor)
+		}
+		p.inicio = (n - 1) * p.porPagina
+	}
+	return p, true, nil
+}
+
+// relacoesPaginacao são as relações dos links de paginação, na ordem do header Link.
+var relacoesPaginacao = []string{"first", "prev", "next", "last"}
+
+// paginaLista é o resultado da paginação de uma lista: os itens retornados e os dados que vão nos
+// headers e no envelope das listas (ver envelopeLista).
+type paginaLista struct {
+	itens     interface{}
+	total     int
+	pedida    bool
+	cursor    bool
+	inicio    int
+	porPagina int
+	links     map[string]string // Relação (first, prev, next, last) -> URL
+}
+
+// paginar aplica a paginação às respostas que são listas ou envelopes de listas. O total de itens
+// vai sempre no header X-Total-Count; com a paginação pedida, apenas a página é retornada e os links
+// para a primeira, anterior, próxima e última páginas vão no header Link (RFC 8288). Os links fixam
+// a versão do conjunto de dados, para que a navegação não mude de edição no meio do caminho.
+func paginar(w http.ResponseWriter, r *http.Request, payload interface{}) (interface{}, error) {
+	if envelope, ok := payload.(envelopeLista); ok {
+		pagina, err := paginarLista(w, r, reflect.ValueOf(envelope.Dados))
+		if err != nil {
+			return nil, err
+		}
+		return novoEnvelopeLista(w, pagina), nil
+	}
+	if objeto, ok := payload.(objetoComLista); ok {
+		pagina, err := paginarLista(w, r, reflect.ValueOf(objeto.itensLista()))
+		if err != nil {
+			return nil, err
+		}
+		return objeto.comItens(pagina.itens), nil
+	}
+
+	lista := reflect.ValueOf(payload)
+	if lista.Kind() != reflect.Slice {
+		return payload, nil
+	}
+	pagina, err := paginarLista(w, r, lista)
+	if err != nil {
+		return nil, err
+	}
+	return pagina.itens, nil
+}
+
+func paginarLista(w http.ResponseWriter, r *http.Request, lista reflect.Value) (paginaLista, error) {
+	versao := w.Header().Get(HeaderVersaoDataset)
+	p, pedida, err := lerPaginacao(r, versao)
+	if err != nil {
+		return paginaLista{}, err
+	}
+	total := lista.Len()
+	w.Header().Set(HeaderTotalRegistros, strconv.Itoa(total))
+	if !pedida {
+		return paginaLista{itens: lista.Interface(), total: total}, nil
+	}
+
+	inicio := min(max(p.inicio, 0), total)
+	fim := min(inicio+p.porPagina, total)
+	ultima := 0
+	if total > 0 {
+		ultima = (total - 1) / p.porPagina * p.porPagina
+	}
+
+	link := func(inicioPagina int) string {
+		query := r.URL.Query()
+		query.Del("pagina")
+		query.Del("por_pagina")
+		query.Del("cursor")
+		if versao != "" {
+			query.Set("versao", versao)
+		}
+		// O cursor já leva o tamanho da página
+		if p.cursor {
+			query.Set("cursor", codificarCursor(cursorPaginacao{Versao: versao, Inicio: inicioPagina, PorPagina: p.porPagina}))
+		} else {
+			query.Set("pagina", strconv.Itoa(inicioPagina/p.porPagina+1))
+			query.Set("por_pagina", strconv.Itoa(p.porPagina))
+		}
+		destino := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+		return destino.String()
+	}
+
+	pagina := paginaLista{
+		itens:     lista.Slice(inicio, fim).Interface(),
+		total:     total,
+		pedida:    true,
+		cursor:    p.cursor,
+		inicio:    inicio,
+		porPagina: p.porPagina,
+		links:     map[string]string{"first": link(0), "last": link(ultima)},
+	}
+	if inicio > 0 {
+		pagina.links["prev"] = link(max(inicio-p.porPagina, 0))
+	}
+	if fim < total {
+		pagina.links["next"] = link(fim)
+	}
+
+	links := make([]string, 0, len(pagina.links))
+	for _, rel := range relacoesPaginacao {
+		if destino, found := pagina.links[rel]; found {
+			links = append(links, fmt.Sprintf(`<%s>; rel="%s"`, destino, rel))
+		}
+	}
+	w.Header().Set("Link", strings.Join(links, ", "))
+	return pagina, nil
+}
+
+// objetoComLista é implementada pelas respostas que são objetos com uma lista de itens e alguns
+// campos sobre ela (ex: o feed de alterações). respond pagina a lista mantendo os demais campos, e o
+// CSV, que é uma tabela de recursos, recebe só os itens.
+type objetoComLista interface {
+	itensLista() interface{}
+	comItens(itens interface{}) interface{}
+}
+
+// envelopeLista é o corpo das listas que levam os metadados junto com os itens, e não apenas nos
+// headers (ex: na API v2). Os handlers informam só os itens, em Dados; respond pagina a lista e
+// preenche Meta.
+type envelopeLista struct {
+	Dados interface{}    `json:"dados"`
+	Meta  metadadosLista `json:"meta"`
+}
+
+// metadadosLista descreve a lista do envelope. Todos os campos estão sempre presentes: o que não se
+// aplica à requisição (ex: a página, sem paginação) vem como null.
+type metadadosLista struct {
+	Total     int        `json:"total" example:"645"`
+	Pagina    *int       `json:"pagina" example:"1"` // null sem paginação ou na navegação por cursor
+	PorPagina *int       `json:"por_pagina" example:"100"`
+	Versao    string     `json:"versao" example:"2022"`
+	Links     linksLista `json:"links"`
+}
+
+// linksLista são os mesmos links do header Link; os que não se aplicam vêm como null.
+type linksLista struct {
+	First *string `json:"first" example:"/api/v2/estados/SP/cidades?pagina=1&por_pagina=100&versao=2022"`
+	Prev  *string `json:"prev"`
+	Next  *string `json:"next" example:"/api/v2/estados/SP/cidades?pagina=2&por_pagina=100&versao=2022"`
+	Last  *string `json:"last" example:"/api/v2/estados/SP/cidades?pagina=7&por_pagina=100&versao=2022"`
+}
+
+func (e envelopeLista) itensLista() interface{} {
+	return e.Dados
+}
+
+func (e envelopeLista) comItens(itens interface{}) interface{} {
+	e.Dados = itens
+	return e
+}
+
+func novoEnvelopeLista(w http.ResponseWriter, pagina paginaLista) envelopeLista {
+	meta := metadadosLista{Total: pagina.total, Versao: w.Header().Get(HeaderVersaoDataset)}
+	if pagina.pedida {
+		meta.PorPagina = &pagina.porPagina
+		if !pagina.cursor {
+			numero := pagina.inicio/pagina.porPagina + 1
+			meta.Pagina = &numero
+		}
+		for rel, destino := range map[string]**string{"first": &meta.Links.First, "prev": &meta.Links.Prev, "next": &meta.Links.Next, "last": &meta.Links.Last} {
+			if link, found := pagina.links[rel]; found {
+				*destino = &link
+			}
+		}
+	}
+	return envelopeLista{Dados: pagina.itens, Meta: meta}
+}
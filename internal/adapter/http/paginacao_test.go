@@ -0,0 +1,182 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// TestPaginacao cobre a paginação por número de página e por cursor.
+func TestPaginacao(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("GET /api/v1/estados/{uf}/cidades - deve informar o total e paginar por número", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			url           string
+			expected      []int
+			expectedLinks map[string]string
+		}{
+			{"sem paginação", "/api/v1/estados/SP/cidades", []int{3550308, 3509502, 3552205}, map[string]string{}},
+			{
+				"primeira página", "/api/v1/estados/SP/cidades?por_pagina=2&fields=codigo_ibge",
+				[]int{3550308, 3509502},
+				map[string]string{
+					"first": "/api/v1/estados/SP/cidades?fields=codigo_ibge&pagina=1&por_pagina=2&versao=2024",
+					"next":  "/api/v1/estados/SP/cidades?fields=codigo_ibge&pagina=2&por_pagina=2&versao=2024",
+					"last":  "/api/v1/estados/SP/cidades?fields=codigo_ibge&pagina=2&por_pagina=2&versao=2024",
+				},
+			},
+			{
+				"última página", "/api/v1/estados/SP/cidades?pagina=2&por_pagina=2",
+				[]int{3552205},
+				map[string]string{
+					"first": "/api/v1/estados/SP/cidades?pagina=1&por_pagina=2&versao=2024",
+					"prev":  "/api/v1/estados/SP/cidades?pagina=1&por_pagina=2&versao=2024",
+					"last":  "/api/v1/estados/SP/cidades?pagina=2&por_pagina=2&versao=2024",
+				},
+			},
+			{"além da última página", "/api/v1/estados/SP/cidades?pagina=5&por_pagina=2", []int{}, nil},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := httptest.NewRequest("GET", tc.url, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != http.StatusOK {
+					t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+				}
+				if total := rr.Header().Get("X-Total-Count"); total != "3" {
+					t.Errorf("X-Total-Count incorreto: got %q want 3", total)
+				}
+				var cidades []domain.Cidade
+				if err := json.Unmarshal(rr.Body.Bytes(), &cidades); err != nil {
+					t.Fatalf("Erro ao decodificar JSON: %v", err)
+				}
+				if codigos := codigosCidades(cidades); !reflect.DeepEqual(codigos, tc.expected) {
+					t.Errorf("Cidades incorretas: got %v want %v", codigos, tc.expected)
+				}
+				if tc.expectedLinks == nil {
+					return
+				}
+				if links := linksPaginacao(rr.Header().Get("Link")); !reflect.DeepEqual(links, tc.expectedLinks) {
+					t.Errorf("Links incorretos: got %v want %v", links, tc.expectedLinks)
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/estados/{uf}/cidades - deve navegar por cursor", func(t *testing.T) {
+		url := "/api/v1/estados/SP/cidades?cursor=&por_pagina=2"
+		var codigos []int
+		for paginas := 0; url != ""; paginas++ {
+			if paginas > 3 {
+				t.Fatal("A navegação por cursor não terminou")
+			}
+			req := httptest.NewRequest("GET", url, nil)
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			if status := rr.Code; status != http.StatusOK {
+				t.Fatalf("Status code incorreto em %s: got %v want %v", url, status, http.StatusOK)
+			}
+			var cidades []domain.Cidade
+			if err := json.Unmarshal(rr.Body.Bytes(), &cidades); err != nil {
+				t.Fatalf("Erro ao decodificar JSON: %v", err)
+			}
+			codigos = append(codigos, codigosCidades(cidades)...)
+			links := linksPaginacao(rr.Header().Get("Link"))
+			if !strings.Contains(links["first"], "cursor=") || strings.Contains(links["first"], "pagina=") {
+				t.Errorf("Os links deveriam usar cursor: got %v", links)
+			}
+			url = links["next"]
+		}
+		if esperados := []int{3550308, 3509502, 3552205}; !reflect.DeepEqual(codigos, esperados) {
+			t.Errorf("Cidades incorretas: got %v want %v", codigos, esperados)
+		}
+	})
+
+	t.Run("deve paginar as listas dentro dos objetos, mantendo os demais campos", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/alteracoes?desde=0&por_pagina=1&pagina=2", nil)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if total := rr.Header().Get("X-Total-Count"); total != "2" {
+			t.Errorf("X-Total-Count incorreto: got %q want 2", total)
+		}
+		var feed domain.FeedAlteracoes
+		if err := json.Unmarshal(rr.Body.Bytes(), &feed); err != nil {
+			t.Fatalf("Erro ao decodificar JSON: %v", err)
+		}
+		if feed.VersaoAtual != 2 || len(feed.Alteracoes) != 1 || feed.Alteracoes[0].CodigoIBGE != 3509502 {
+			t.Errorf("Feed incorreto: %+v", feed)
+		}
+		if links := linksPaginacao(rr.Header().Get("Link")); links["prev"] != "/api/v1/alteracoes?desde=0&pagina=1&por_pagina=1&versao=2024" {
+			t.Errorf("Links incorretos: got %v", links)
+		}
+
+		req = httptest.NewRequest("GET", "/api/v1/downloads?por_pagina=1", nil)
+		rr = httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		var downloads respostaDownloads
+		if err := json.Unmarshal(rr.Body.Bytes(), &downloads); err != nil {
+			t.Fatalf("Erro ao decodificar JSON: %v", err)
+		}
+		if len(downloads.Downloads) != 1 || downloads.VersaoDataset != "2024" || rr.Header().Get("X-Total-Count") != "3" {
+			t.Errorf("Downloads incorretos: %+v (X-Total-Count %s)", downloads, rr.Header().Get("X-Total-Count"))
+		}
+	})
+
+	t.Run("GET /api/v1/estados - deve rejeitar paginação inválida", func(t *testing.T) {
+		// Cursor da edição 2020 (com apenas SP e RJ), usado numa requisição da edição 2024
+		req := httptest.NewRequest("GET", "/api/v1/estados?versao=2020&cursor=&por_pagina=1", nil)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		if total := rr.Header().Get("X-Total-Count"); total != "2" {
+			t.Fatalf("X-Total-Count incorreto: got %q want 2", total)
+		}
+		proxima := linksPaginacao(rr.Header().Get("Link"))["next"]
+		cursor := proxima[strings.Index(proxima, "cursor=")+len("cursor="):]
+		if i := strings.Index(cursor, "&"); i >= 0 {
+			cursor = cursor[:i]
+		}
+
+		testCases := []struct {
+			name string
+			url  string
+		}{
+			{"página zero", "/api/v1/estados?pagina=0"},
+			{"página que estoura a posição do primeiro item", "/api/v1/estados?pagina=9223372036854775807&por_pagina=2"},
+			{"por_pagina acima do máximo", "/api/v1/estados?por_pagina=5000"},
+			{"por_pagina inválido", "/api/v1/estados?por_pagina=abc"},
+			{"cursor inválido", "/api/v1/estados?cursor=lixo"},
+			{"pagina e cursor", "/api/v1/estados?pagina=1&cursor="},
+			{"cursor de outra versão", "/api/v1/estados?versao=2024&cursor=" + cursor},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := httptest.NewRequest("GET", tc.url, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != http.StatusBadRequest {
+					t.Errorf("Status code incorreto: got %v want %v (%s)", status, http.StatusBadRequest, rr.Body.String())
+				}
+				if link, total := rr.Header().Get("Link"), rr.Header().Get("X-Total-Count"); link != "" || total != "" {
+					t.Errorf("Erros não deveriam ter os headers de paginação: got Link %q e X-Total-Count %q", link, total)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,191 @@
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/brauliohms/ibge-service/internal/domain"
+	"github.com/brauliohms/ibge-service/internal/usecase"
+)
+
+const codificacaoIdentidade = "identity"
+
+// nivelBrotli equilibra a taxa de compressão e o tempo de carga: o nível 11 reduz o corpo em
+// cerca de 17%, mas leva segundos por edição do conjunto de dados, contra décimos de segundo do 9.
+const nivelBrotli = 9
+
+// codificacoesPreComprimidas são as codificações guardadas além do corpo sem compressão, em
+// ordem de preferência quando o cliente aceita mais de uma com o mesmo fator q.
+var codificacoesPreComprimidas = []string{"br", "gzip"}
+
+// respostaPreSerializada é uma resposta JSON serializada e comprimida uma única vez, na carga.
+type respostaPreSerializada struct {
+	etag   string            // O mesmo que respond calcularia para o corpo sem compressão
+	total  int               // Itens da lista, para o header X-Total-Count
+	corpos map[string][]byte // Codificação (identity, br, gzip) -> corpo
+}
+
+// respostasPreSerializadas guarda as listas mais pedidas (/estados e /estados/{uf}/cidades) na
+// representação padrão, indexadas por "versão/chave". Como os repositórios em memória não mudam
+// depois da carga, elas são montadas em NewIBGEHandler e servidas sem json.Marshal nem compressão
+// a cada requisição.
+type respostasPreSerializadas map[string]*respostaPreSerializada
+
+// preSerializarRespostas monta as respostas de todas as edições do conjunto de dados. Uma edição
+// que falhe continua sendo servida normalmente, apenas sem o atalho.
+func preSerializarRespostas(versoes *usecase.RegistroVersoes) respostasPreSerializadas {
+	respostas := respostasPreSerializadas{}
+	for _, versao := range versoes.Versoes() {
+		uc, _, err := versoes.Versao(versao)
+		if err == nil {
+			err = respostas.adicionarVersao(versao, uc)
+		}
+		if err != nil {
+			log.Printf("Respostas da versão %s não pré-serializadas: %v", versao, err)
+		}
+	}
+	return respostas
+}
+
+func (p respostasPreSerializadas) adicionarVersao(versao string, uc *usecase.IBGEUseCase) error {
+	estados, err := uc.ConsultarEstados(domain.ConsultaEstados{})
+	if err != nil {
+		return err
+	}
+	if err := p.adicionar(versao, estados, len(estados), "estados"); err != nil {
+		return err
+	}
+
+	for _, estado := range estados {
+		cidades, err := uc.ConsultarCidades(domain.ConsultaCidades{EstadoCodigoIBGE: estado.CodigoIBGE})
+		if err != nil {
+			return err
+		}
+		// /estados/{uf}/cidades aceita a sigla ou o código IBGE do estado
+		err = p.adicionar(versao, semAliases(cidades), len(cidades), "estados/"+estado.Sigla+"/cidades", "estados/"+strconv.Itoa(estado.CodigoIBGE)+"/cidades")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p respostasPreSerializadas) adicionar(versao string, lista interface{}, total int, chaves ...string) error {
+	var corpo bytes.Buffer
+	if err := codificarJSON(&corpo, nil, lista); err != nil {
+		return err
+	}
+	resposta := &respostaPreSerializada{
+		etag:   etagRepresentacao(versao, formatosResposta[0].conteudo, corpo.Bytes()),
+		total:  total,
+		corpos: map[string][]byte{codificacaoIdentidade: corpo.Bytes()},
+	}
+
+	var err error
+	if resposta.corpos["gzip"], err = comprimir(corpo.Bytes(), func(w io.Writer) (io.WriteCloser, error) {
+		return gzip.NewWriterLevel(w, gzip.BestCompression)
+	}); err != nil {
+		return err
+	}
+	if resposta.corpos["br"], err = comprimir(corpo.Bytes(), func(w io.Writer) (io.WriteCloser, error) {
+		return brotli.NewWriterLevel(w, nivelBrotli), nil
+	}); err != nil {
+		return err
+	}
+
+	for _, chave := range chaves {
+		p[versao+"/"+chave] = resposta
+	}
+	return nil
+}
+
+func comprimir(dados []byte, novoCompressor func(io.Writer) (io.WriteCloser, error)) ([]byte, error) {
+	var comprimido bytes.Buffer
+	compressor, err := novoCompressor(&comprimido)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := compressor.Write(dados); err != nil {
+		return nil, err
+	}
+	if err := compressor.Close(); err != nil {
+		return nil, err
+	}
+	return comprimido.Bytes(), nil
+}
+
+// responderPreSerializada serve a resposta pré-serializada da chave quando a requisição pede a
+// representação padrão: JSON, sem parâmetros além de ?versao=. Retorna false quando a requisição
+// precisa passar pelo caminho normal (ex: filtros, paginação, outro formato).
+func (h *IBGEHandler) responderPreSerializada(w http.ResponseWriter, r *http.Request, chave string) bool {
+	for parametro := range r.URL.Query() {
+		if parametro != "versao" {
+			return false
+		}
+	}
+	if formato, err := negociarFormato(r); err != nil || formato != formatosResposta[0] {
+		return false
+	}
+	resposta, found := h.respostas[h.versaoSelecionada(w)+"/"+chave]
+	if !found {
+		return false
+	}
+
+	w.Header().Set(HeaderTotalRegistros, strconv.Itoa(resposta.total))
+	w.Header().Add("Vary", "Accept")
+	w.Header().Add("Vary", "Accept-Encoding")
+	if validavel(r) {
+		w.Header().Set("ETag", resposta.etag)
+		if naoModificado(w, r) {
+			responderNaoModificado(w)
+			return true
+		}
+	}
+
+	// Com Content-Encoding definido, o middleware de compressão repassa o corpo como está
+	codificacao := negociarCodificacao(r.Header.Get("Accept-Encoding"))
+	if codificacao != codificacaoIdentidade {
+		w.Header().Set("Content-Encoding", codificacao)
+	}
+	corpo := resposta.corpos[codificacao]
+	w.Header().Set("Content-Type", formatosResposta[0].conteudo)
+	w.Header().Set("Content-Length", strconv.Itoa(len(corpo)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(corpo)
+	return true
+}
+
+// negociarCodificacao escolhe, pelo header Accept-Encoding, a codificação pré-comprimida de maior
+// fator q aceita pelo cliente, ou identity se ele não aceitar nenhuma.
+func negociarCodificacao(acceptEncoding string) string {
+	qualidades := map[string]float64{}
+	for _, parte := range strings.Split(acceptEncoding, ",") {
+		nome, params, _ := strings.Cut(parte, ";")
+		q := 1.0
+		if valor, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if q, err = strconv.ParseFloat(valor, 64); err != nil {
+				continue
+			}
+		}
+		qualidades[strings.ToLower(strings.TrimSpace(nome))] = q
+	}
+
+	escolhida, melhorQ := codificacaoIdentidade, 0.0
+	for _, codificacao := range codificacoesPreComprimidas {
+		q, found := qualidades[codificacao]
+		if !found {
+			q, found = qualidades["*"]
+		}
+		if found && q > melhorQ {
+			escolhida, melhorQ = codificacao, q
+		}
+	}
+	return escolhida
+}
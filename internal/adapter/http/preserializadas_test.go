@@ -0,0 +1,150 @@
+package http
+
+import (
+	"compress/gzip"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+)
+
+// TestRespostasPreSerializadas compara as respostas pré-serializadas com as montadas a cada requisição.
+func TestRespostasPreSerializadas(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("Respostas pré-serializadas - devem ser idênticas às montadas a cada requisição", func(t *testing.T) {
+		get := func(url, acceptEncoding string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest("GET", url, nil)
+			if acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", acceptEncoding)
+			}
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+			return rr
+		}
+
+		testCases := []struct {
+			url            string
+			referencia     string // ?formato=json desvia do atalho e passa por respond
+			acceptEncoding string
+			codificacao    string
+		}{
+			{"/api/v1/estados", "/api/v1/estados?formato=json", "", ""},
+			{"/api/v1/estados", "/api/v1/estados?formato=json", "gzip, deflate, br", "br"},
+			{"/api/v1/estados/SP/cidades", "/api/v1/estados/SP/cidades?formato=json", "gzip", "gzip"},
+			{"/api/v1/estados/sp/cidades", "/api/v1/estados/SP/cidades?formato=json", "br;q=0.5, gzip", "gzip"},
+			{"/api/v1/estados/35/cidades", "/api/v1/estados/SP/cidades?formato=json", "*", "br"},
+			{"/api/v1/estados/RJ/cidades?versao=2024", "/api/v1/estados/RJ/cidades?formato=json", "br, gzip;q=0", "br"},
+			{"/api/v1/estados?versao=2020", "/api/v1/estados?versao=2020&formato=json", "gzip", "gzip"},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.url+" "+tc.acceptEncoding, func(t *testing.T) {
+				referencia := get(tc.referencia, "")
+				rr := get(tc.url, tc.acceptEncoding)
+				if rr.Code != http.StatusOK {
+					t.Fatalf("Status code incorreto: got %v want %v", rr.Code, http.StatusOK)
+				}
+				if got := rr.Header().Get("Content-Encoding"); got != tc.codificacao {
+					t.Errorf("Content-Encoding incorreto: got %q want %q", got, tc.codificacao)
+				}
+				if got := rr.Header().Get("Content-Length"); got != strconv.Itoa(rr.Body.Len()) {
+					t.Errorf("Content-Length incorreto: got %q want %d", got, rr.Body.Len())
+				}
+				if vary := strings.Join(rr.Header().Values("Vary"), ", "); !strings.Contains(vary, "Accept-Encoding") {
+					t.Errorf("Vary deveria incluir Accept-Encoding, got %q", vary)
+				}
+
+				var leitor io.Reader = rr.Body
+				switch tc.codificacao {
+				case "gzip":
+					gz, err := gzip.NewReader(rr.Body)
+					if err != nil {
+						t.Fatalf("Corpo gzip inválido: %v", err)
+					}
+					leitor = gz
+				case "br":
+					leitor = brotli.NewReader(rr.Body)
+				}
+				corpo, err := io.ReadAll(leitor)
+				if err != nil {
+					t.Fatalf("Erro ao descomprimir o corpo: %v", err)
+				}
+
+				if string(corpo) != referencia.Body.String() {
+					t.Errorf("Corpo diferente do montado por respond.\ngot:  %s\nwant: %s", corpo, referencia.Body.String())
+				}
+				for _, header := range []string{"Content-Type", HeaderTotalRegistros, "Last-Modified", "Cache-Control"} {
+					if got, want := rr.Header().Get(header), referencia.Header().Get(header); got != want {
+						t.Errorf("Header %s incorreto: got %q want %q", header, got, want)
+					}
+				}
+				// Cada codificação do corpo tem o seu ETag
+				if got, want := rr.Header().Get("ETag"), etagCodificada(referencia.Header().Get("ETag"), tc.codificacao); got != want {
+					t.Errorf("Header ETag incorreto: got %q want %q", got, want)
+				}
+			})
+		}
+
+		t.Run("atende If-None-Match", func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/api/v1/estados/SP/cidades", nil)
+			req.Header.Set("If-None-Match", get("/api/v1/estados/SP/cidades", "").Header().Get("ETag"))
+			req.Header.Set("Accept-Encoding", "br")
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+			if rr.Code != http.StatusNotModified || rr.Body.Len() != 0 {
+				t.Errorf("Resposta incorreta: got %d com %d bytes", rr.Code, rr.Body.Len())
+			}
+
+			// O ETag da cópia comprimida também é aceito, e o 304 o repete
+			etag := get("/api/v1/estados/SP/cidades", "br").Header().Get("ETag")
+			if !strings.HasSuffix(etag, `-br"`) {
+				t.Fatalf("ETag da resposta br sem o sufixo da codificação: %s", etag)
+			}
+			req = httptest.NewRequest("GET", "/api/v1/estados/SP/cidades", nil)
+			req.Header.Set("If-None-Match", etag)
+			req.Header.Set("Accept-Encoding", "br")
+			rr = httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+			if rr.Code != http.StatusNotModified || rr.Header().Get("ETag") != etag {
+				t.Errorf("Resposta incorreta: got %d com ETag %s, want 304 com %s", rr.Code, rr.Header().Get("ETag"), etag)
+			}
+		})
+	})
+}
+
+// BenchmarkRespostasPreSerializadas compara a lista de cidades servida já serializada e comprimida
+// com a mesma representação montada a cada requisição (?formato=json desvia do atalho), pelo
+// roteador completo: go test ./internal/adapter/http -run ^$ -bench PreSerializadas -benchmem
+func BenchmarkRespostasPreSerializadas(b *testing.B) {
+	b.Setenv("RATE_LIMIT", strconv.Itoa(math.MaxInt32))
+	repo := &mockIBGERepository{}
+	handler := NewIBGEHandler(novoRegistroVersoes(repo))
+	router := SetupRouter(handler)
+
+	for _, codificacao := range []string{"identity", "gzip", "br"} {
+		for _, caso := range []struct{ nome, url string }{
+			{"pre-serializada", "/api/v1/estados/SP/cidades"},
+			{"montada", "/api/v1/estados/SP/cidades?formato=json"},
+		} {
+			b.Run(codificacao+"/"+caso.nome, func(b *testing.B) {
+				req := httptest.NewRequest("GET", caso.url, nil)
+				req.Header.Set("Accept-Encoding", codificacao)
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					rr := httptest.NewRecorder()
+					router.ServeHTTP(rr, req)
+					if rr.Code != http.StatusOK {
+						b.Fatalf("Status code incorreto: %d", rr.Code)
+					}
+				}
+			})
+		}
+	}
+}
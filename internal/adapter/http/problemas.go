@@ -0,0 +1,157 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// TipoConteudoProblema é o Content-Type das respostas de erro em JSON (RFC 7807).
+const TipoConteudoProblema = "application/problem+json"
+
+// problema é o corpo das respostas de erro, no formato "problem details" da RFC 7807, com os membros
+// code (o código da mensagem no catálogo, o mesmo em todos os idiomas) e request_id. Nos demais
+// formatos negociados (XML, CSV etc.), os mesmos campos são codificados como qualquer resposta.
+type problema struct {
+	Type      string `json:"type" example:"urn:ibge-service:problema:nao-encontrado"`
+	Title     string `json:"title" example:"Recurso não encontrado"`
+	Status    int    `json:"status" example:"404"`
+	Detail    string `json:"detail" example:"estado com a sigla XX não encontrado"`
+	Code      string `json:"code,omitempty" example:"estado_sigla_nao_encontrado"`
+	Instance  string `json:"instance" example:"/api/v1/estados/XX"`
+	RequestID string `json:"request_id,omitempty" example:"servidor/abc123-000001"`
+}
+
+// detalhesProblema identifica os payloads de erro, inclusive os que estendem problema com membros
+// próprios (ex: respostaCidadeExtinta), para que respond use o Content-Type da RFC 7807.
+type detalhesProblema interface {
+	detalhes() problema
+}
+
+func (p problema) detalhes() problema {
+	return p
+}
+
+// tipoProblema descreve uma classe de erro do domínio nas respostas HTTP.
+type tipoProblema struct {
+	erro   error
+	status int
+	uri    string
+	titulo domain.CodigoMensagem
+}
+
+// tiposProblema traduz os erros do domínio em status e tipos de problema. É o único lugar em que
+// essa correspondência é feita; erros fora da tabela são tratados como falhas internas.
+var tiposProblema = []tipoProblema{
+	{domain.ErrInvalidArgument, http.StatusBadRequest, "urn:ibge-service:problema:parametro-invalido", domain.MsgTituloParametroInvalido},
+	{domain.ErrInvalidCode, http.StatusBadRequest, "urn:ibge-service:problema:codigo-invalido", domain.MsgTituloCodigoInvalido},
+	{domain.ErrNotFound, http.StatusNotFound, "urn:ibge-service:problema:nao-encontrado", domain.MsgTituloNaoEncontrado},
+	{domain.ErrGone, http.StatusGone, "urn:ibge-service:problema:municipio-extinto", domain.MsgTituloMunicipioExtinto},
+}
+
+// problemaInterno é o tipo das falhas internas: about:blank, como recomenda a RFC 7807 para os
+// problemas sem semântica além do status.
+var problemaInterno = tipoProblema{status: http.StatusInternalServerError, uri: "about:blank", titulo: domain.MsgTituloErroInterno}
+
+// erroInterno é a mensagem das falhas internas, que não expõe a causa ao cliente.
+var erroInterno = &domain.Erro{Codigo: domain.MsgErroInterno}
+
+// tipoDoErro retorna o tipo de problema do erro, ou problemaInterno se ele não for classificado.
+func tipoDoErro(err error) tipoProblema {
+	for _, tipo := range tiposProblema {
+		if errors.Is(err, tipo.erro) {
+			return tipo
+		}
+	}
+	return problemaInterno
+}
+
+// tipoDoStatus retorna o primeiro tipo de problema da tabela com o status, para os erros
+// detectados no próprio handler. Sem correspondência, o tipo é about:blank.
+func tipoDoStatus(code int) tipoProblema {
+	for _, tipo := range tiposProblema {
+		if tipo.status == code {
+			return tipo
+		}
+	}
+	if code == http.StatusInternalServerError {
+		return problemaInterno
+	}
+	return tipoProblema{status: code, uri: "about:blank"}
+}
+
+// idiomaDaResposta escolhe o idioma das mensagens de erro pelo header Accept-Language e o informa
+// em Content-Language.
+func idiomaDaResposta(w http.ResponseWriter, r *http.Request) domain.Idioma {
+	idioma := domain.IdiomaPreferido(r.Header.Get("Accept-Language"))
+	w.Header().Set("Content-Language", string(idioma))
+	w.Header().Add("Vary", "Accept-Language")
+	return idioma
+}
+
+// novoProblema monta o problema de uma requisição, com o título e a mensagem no idioma pedido.
+func novoProblema(w http.ResponseWriter, r *http.Request, tipo tipoProblema, erro domain.ErroTraduzivel) problema {
+	idioma := idiomaDaResposta(w, r)
+	p := problema{
+		Type:      tipo.uri,
+		Title:     http.StatusText(tipo.status),
+		Status:    tipo.status,
+		Instance:  r.URL.RequestURI(),
+		RequestID: middleware.GetReqID(r.Context()),
+	}
+	if tipo.titulo != "" {
+		p.Title = domain.Mensagem(idioma, tipo.titulo)
+	}
+	if erro != nil {
+		p.Detail, p.Code = erro.Mensagem(idioma), string(erro.CodigoMensagem())
+	}
+	return p
+}
+
+// problemaDoErro monta o status e o corpo da resposta para um erro dos casos de uso. Erros sem
+// classificação no domínio são registrados no log e respondidos como 500, sem expor a mensagem
+// original ao cliente.
+func problemaDoErro(w http.ResponseWriter, r *http.Request, err error) (int, interface{}) {
+	tipo := tipoDoErro(err)
+	if tipo.status == http.StatusInternalServerError {
+		log.Printf("Erro interno em %s: %v", r.URL.Path, err)
+		return tipo.status, novoProblema(w, r, tipo, erroInterno)
+	}
+
+	var traduzivel domain.ErroTraduzivel
+	if !errors.As(err, &traduzivel) {
+		p := novoProblema(w, r, tipo, nil)
+		p.Detail = err.Error()
+		return tipo.status, p
+	}
+	p := novoProblema(w, r, tipo, traduzivel)
+
+	var extinta *domain.CidadeExtintaError
+	if errors.As(err, &extinta) {
+		return tipo.status, respostaCidadeExtinta{
+			problema:     p,
+			DataExtincao: extinta.Data,
+			Cidade:       cidadeParaResposta(r, &extinta.Cidade),
+			Sucessores:   cidadesParaResposta(r, extinta.Sucessores),
+		}
+	}
+	return tipo.status, p
+}
+
+// responderErro responde com o problema correspondente ao erro retornado pelos casos de uso.
+func responderErro(w http.ResponseWriter, r *http.Request, err error) {
+	code, payload := problemaDoErro(w, r, err)
+	respond(w, r, code, payload)
+}
+
+// respostaCidadeExtinta é o corpo da resposta 410 para códigos de municípios extintos: o problema
+// mais a data de extinção, o município e os seus sucessores.
+type respostaCidadeExtinta struct {
+	problema
+	DataExtincao string          `json:"data_extincao"`
+	Cidade       *domain.Cidade  `json:"cidade"`
+	Sucessores   []domain.Cidade `json:"sucessores"`
+}
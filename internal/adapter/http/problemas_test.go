@@ -0,0 +1,144 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brauliohms/ibge-service/internal/usecase"
+)
+
+// TestProblemas cobre as respostas de erro no formato da RFC 7807.
+func TestProblemas(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("Erros - devem seguir a RFC 7807 com o status do tipo de erro do domínio", func(t *testing.T) {
+		testCases := []struct {
+			path           string
+			expectedStatus int
+			expectedType   string
+			expectedTitle  string
+			expectedDetail string
+		}{
+			{"/api/v1/cidades/abc", http.StatusBadRequest, "urn:ibge-service:problema:codigo-invalido", "Código inválido", "código IBGE inválido: abc deve ser um número"},
+			{"/api/v1/estados/XX", http.StatusNotFound, "urn:ibge-service:problema:nao-encontrado", "Recurso não encontrado", "estado com a sigla XX não encontrado"},
+			{"/api/v1/cidades/9999999", http.StatusNotFound, "urn:ibge-service:problema:nao-encontrado", "Recurso não encontrado", "cidade com código IBGE 9999999 não encontrada"},
+			{"/api/v1/cep/123", http.StatusBadRequest, "urn:ibge-service:problema:codigo-invalido", "Código inválido", usecase.ErrCEPInvalido.Error()},
+			{"/api/v1/cidades/3550308?data=ontem", http.StatusBadRequest, "urn:ibge-service:problema:parametro-invalido", "Parâmetro inválido", usecase.ErrDataInvalida.Error()},
+			{"/api/v1/estados?ordenar=populacao", http.StatusBadRequest, "urn:ibge-service:problema:parametro-invalido", "Parâmetro inválido", ""},
+			{"/api/v1/cidades/3500000", http.StatusGone, "urn:ibge-service:problema:municipio-extinto", "Município extinto", ""},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.path, func(t *testing.T) {
+				req := httptest.NewRequest("GET", tc.path, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != tc.expectedStatus {
+					t.Fatalf("Status code incorreto: got %v want %v", status, tc.expectedStatus)
+				}
+				if contentType := rr.Header().Get("Content-Type"); contentType != TipoConteudoProblema {
+					t.Errorf("Content-Type incorreto: got %v want %v", contentType, TipoConteudoProblema)
+				}
+
+				var p problema
+				if err := json.Unmarshal(rr.Body.Bytes(), &p); err != nil {
+					t.Fatalf("Erro ao decodificar JSON: %v", err)
+				}
+				if p.Type != tc.expectedType || p.Title != tc.expectedTitle || p.Status != tc.expectedStatus {
+					t.Errorf("Problema incorreto: %+v", p)
+				}
+				if tc.expectedDetail != "" && p.Detail != tc.expectedDetail {
+					t.Errorf("Detalhe incorreto: got %q want %q", p.Detail, tc.expectedDetail)
+				}
+				if p.Instance != tc.path {
+					t.Errorf("Instance incorreto: got %q want %q", p.Instance, tc.path)
+				}
+				if p.RequestID == "" {
+					t.Error("O problema deveria informar o ID da requisição")
+				}
+			})
+		}
+	})
+
+	t.Run("Erros - formatos que não são JSON mantêm o próprio Content-Type", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/estados/XX", nil)
+		req.Header.Set("Accept", "application/xml")
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusNotFound {
+			t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusNotFound)
+		}
+		if contentType := rr.Header().Get("Content-Type"); contentType != "application/xml; charset=utf-8" {
+			t.Errorf("Content-Type incorreto: got %v", contentType)
+		}
+		if !strings.Contains(rr.Body.String(), "<type>urn:ibge-service:problema:nao-encontrado</type>") {
+			t.Errorf("Corpo incorreto: got %s", rr.Body.String())
+		}
+	})
+
+	t.Run("Erros - devem ser escritos no idioma do Accept-Language", func(t *testing.T) {
+		testCases := []struct {
+			name             string
+			path             string
+			acceptLanguage   string
+			expectedIdioma   string
+			expectedTitle    string
+			expectedDetail   string
+			expectedCodeErro string
+		}{
+			{"padrão", "/api/v1/estados/XX", "", "pt-BR", "Recurso não encontrado", "estado com a sigla XX não encontrado", "estado_sigla_nao_encontrado"},
+			{"inglês", "/api/v1/estados/XX", "en-US,en;q=0.9,pt;q=0.5", "en", "Resource not found", "state with abbreviation XX not found", "estado_sigla_nao_encontrado"},
+			{"português preferido", "/api/v1/estados/XX", "en;q=0.5,pt-PT", "pt-BR", "Recurso não encontrado", "estado com a sigla XX não encontrado", "estado_sigla_nao_encontrado"},
+			{"idioma sem tradução", "/api/v1/estados/XX", "fr-FR", "pt-BR", "Recurso não encontrado", "estado com a sigla XX não encontrado", "estado_sigla_nao_encontrado"},
+			{"erro do handler", "/api/v1/cidades/busca?nome=a", "en", "en", "Invalid parameter", "the nome parameter must have at least 2 characters", "nome_busca_curto"},
+			{"erro de paginação", "/api/v1/estados?pagina=0", "en", "en", "Invalid parameter", `invalid pagina parameter: "0" (the first page is 1)`, "pagina_invalida"},
+			{"município extinto", "/api/v1/cidades/3500000", "en", "en", "Abolished municipality", "the municipality Vila Extinta (IBGE code 3500000) was abolished on 2005-01-01", "cidade_extinta"},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := httptest.NewRequest("GET", tc.path, nil)
+				if tc.acceptLanguage != "" {
+					req.Header.Set("Accept-Language", tc.acceptLanguage)
+				}
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if idioma := rr.Header().Get("Content-Language"); idioma != tc.expectedIdioma {
+					t.Errorf("Content-Language incorreto: got %v want %v", idioma, tc.expectedIdioma)
+				}
+				if vary := rr.Header().Values("Vary"); !strings.Contains(strings.Join(vary, ","), "Accept-Language") {
+					t.Errorf("Vary deveria incluir Accept-Language: %v", vary)
+				}
+
+				var p problema
+				if err := json.Unmarshal(rr.Body.Bytes(), &p); err != nil {
+					t.Fatalf("Erro ao decodificar JSON: %v", err)
+				}
+				if p.Title != tc.expectedTitle || p.Detail != tc.expectedDetail || p.Code != tc.expectedCodeErro {
+					t.Errorf("Problema incorreto: %+v", p)
+				}
+			})
+		}
+	})
+
+	t.Run("Erros - respostas de sucesso não dependem do idioma", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/estados/SP", nil)
+		req.Header.Set("Accept-Language", "en")
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK || rr.Header().Get("Content-Language") != "" {
+			t.Errorf("Resposta incorreta: %d %v", rr.Code, rr.Header())
+		}
+	})
+}
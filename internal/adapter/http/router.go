@@ -12,6 +12,27 @@ import (
 	httpSwagger "github.com/swaggo/http-swagger"
 )
 
+// tiposComprimiveis são os tipos de conteúdo comprimidos pelo middleware de compressão: os
+// padrões do chi e os demais formatos textuais da API, que a lista do chi não inclui.
+var tiposComprimiveis = []string{
+	"text/html",
+	"text/css",
+	"text/plain",
+	"text/javascript",
+	"application/javascript",
+	"application/x-javascript",
+	"application/json",
+	"application/atom+xml",
+	"application/rss+xml",
+	"image/svg+xml",
+	"text/csv",
+	"text/xml",
+	"application/xml",
+	"application/yaml",
+	"application/x-ndjson",
+	TipoConteudoProblema,
+}
+
 func SetupRouter(handler *IBGEHandler) http.Handler {
 	r := chi.NewRouter()
 
@@ -36,10 +57,17 @@ func SetupRouter(handler *IBGEHandler) http.Handler {
 			"Content-Type",
 			"X-CSRF-Token",
 			"X-Requested-With",
+			HeaderVersaoDataset,
 		},
 		ExposedHeaders: []string{
+			"Content-Digest",
+			"Content-Disposition",
+			"Deprecation",
+			"ETag",
 			"Link",
-			"X-Total-Count",
+			HeaderTotalRegistros,
+			HeaderVersaoDataset,
+			"Sunset",
 		},
 		AllowCredentials: true,
 		MaxAge:           300, // 5 minutos
@@ -51,11 +79,13 @@ func SetupRouter(handler *IBGEHandler) http.Handler {
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
 
-	// Timeout para requisições
-	r.Use(middleware.Timeout(30 * time.Second))
+	// Timeout para requisições, aplicado por grupo de rotas: o export de cidades tem um prazo
+	// próprio, mais longo, para não ser truncado em clientes lentos
+	timeout := middleware.Timeout(cfg.RequestTimeout)
 
-	// Compressão para reduzir bandwidth
-	r.Use(middleware.Compress(5))
+	// Compressão para reduzir bandwidth, com um ETag para cada codificação do corpo
+	r.Use(ETagPorCodificacao)
+	r.Use(middleware.Compress(5, tiposComprimiveis...))
 
 	// Headers de segurança
 	r.Use(func(next http.Handler) http.Handler {
@@ -71,18 +101,92 @@ func SetupRouter(handler *IBGEHandler) http.Handler {
 	r.Use(httprate.LimitByIP(cfg.RateLimit, 1*time.Minute)) // 100 requests por minuto por IP
 
 	// Health check
-	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
+	r.With(timeout).Get("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("OK"))
 	})
 
 	r.Route("/api/v1", func(r chi.Router) {
-		r.Get("/estados", handler.GetAllEstados)
-		r.Get("/estados/{uf}", handler.GetEstadoByUF)
-		r.Get("/estados/{uf}/cidades", handler.GetCidadesByEstadoUF)
-		r.Get("/cidades/{codigo_ibge}", handler.GetCidadeByCodigo)
-		r.Get("/cidades/{codigo_tom}/tom", handler.GetCidadeByCodigoTOM)
-		r.Get("/docs/*", httpSwagger.WrapHandler)
+		r.With(timeout).Get("/versoes", handler.GetVersoes)
+		r.With(timeout).Get("/docs/*", httpSwagger.WrapHandler)
+
+		// Rotas de consulta, servidas pela edição do conjunto de dados escolhida pelo cliente
+		r.Group(func(r chi.Router) {
+			r.Use(handler.SelecionarVersao)
+			r.Use(handler.CacheHTTP(cfg.PoliticaCache))
+
+			r.With(middleware.Timeout(cfg.ExportTimeout)).Get("/cidades/export", handler.ExportCidades)
+
+			r.Group(func(r chi.Router) {
+				r.Use(timeout)
+
+				// Rotas com equivalente na v2, descontinuadas conforme API_V1_DEPRECATION e API_V1_SUNSET
+				r.Group(func(r chi.Router) {
+					r.Use(Descontinuacao(cfg.V1Deprecacao, cfg.V1Remocao))
+					r.Get("/estados", handler.GetAllEstados)
+					r.Get("/estados/{uf}", handler.GetEstadoByUF)
+					r.Get("/estados/{uf}/cidades", handler.GetCidadesByEstadoUF)
+					r.Get("/cidades", handler.GetCidades)
+					r.Get("/cidades/busca", handler.BuscarCidades)
+					r.Get("/cidades/{codigo_ibge}", handler.GetCidadeByCodigo)
+					r.Get("/cidades/{codigo_tom}/tom", handler.GetCidadeByCodigoTOM)
+					r.Get("/cep/{cep}", handler.GetCidadeByCEP)
+				})
+
+				r.Get("/cidades/{codigo_ibge}/hora-local", handler.GetHoraLocal)
+				r.Get("/cep/{cep}/validacao", handler.ValidarEndereco)
+				r.Get("/feriados", handler.GetFeriados)
+				r.Get("/dias-uteis", handler.CalcularDiasUteis)
+				r.Get("/alteracoes", handler.GetAlteracoes)
+				r.Get("/downloads", handler.GetDownloads)
+				r.Get("/downloads/{formato}", handler.GetDownload)
+				r.Post("/graphql", handler.GraphQL)
+			})
+		})
+	})
+
+	// API v2: os mesmos dados com o estado e a região aninhados e as listas em envelopes
+	r.Route("/api/v2", func(r chi.Router) {
+		r.Use(timeout)
+		r.Use(handler.SelecionarVersao)
+		r.Use(handler.CacheHTTP(cfg.PoliticaCache))
+		r.Get("/regioes", handler.V2Regioes)
+		r.Get("/regioes/{regiao}", handler.V2Regiao)
+		r.Get("/regioes/{regiao}/estados", handler.V2EstadosByRegiao)
+		r.Get("/estados", handler.V2Estados)
+		r.Get("/estados/{uf}", handler.V2Estado)
+		r.Get("/estados/{uf}/cidades", handler.V2CidadesByEstado)
+		r.Get("/cidades", handler.V2Cidades)
+		r.Get("/cidades/busca", handler.V2BuscarCidades)
+		r.Get("/cidades/{codigo_ibge}", handler.V2Cidade)
+		r.Get("/cidades/{codigo_tom}/tom", handler.V2CidadeByCodigoTOM)
+		r.Get("/cep/{cep}", handler.V2CidadeByCEP)
+	})
+
+	// Rotas compatíveis com a API de Localidades do IBGE, para clientes da API oficial
+	r.Route("/localidades", func(r chi.Router) {
+		r.Use(timeout)
+		r.Use(handler.SelecionarVersao)
+		r.Use(handler.CacheHTTP(cfg.PoliticaCache))
+		r.Get("/regioes", handler.LocalidadesRegioes)
+		r.Get("/regioes/{macrorregiao}", handler.LocalidadesRegiao)
+		r.Get("/regioes/{macrorregiao}/estados", handler.LocalidadesEstadosByRegiao)
+		r.Get("/regioes/{macrorregiao}/municipios", handler.LocalidadesMunicipiosByRegiao)
+		r.Get("/estados", handler.LocalidadesEstados)
+		r.Get("/estados/{UF}", handler.LocalidadesEstado)
+		r.Get("/estados/{UF}/municipios", handler.LocalidadesMunicipiosByEstado)
+		r.Get("/municipios", handler.LocalidadesMunicipios)
+		r.Get("/municipios/{municipio}", handler.LocalidadesMunicipio)
+	})
+
+	// Rotas compatíveis com os endpoints de IBGE da BrasilAPI
+	r.Route("/brasilapi/ibge", func(r chi.Router) {
+		r.Use(timeout)
+		r.Use(handler.SelecionarVersao)
+		r.Use(handler.CacheHTTP(cfg.PoliticaCache))
+		r.Get("/uf/v1", handler.BrasilAPIEstados)
+		r.Get("/uf/v1/{code}", handler.BrasilAPIEstado)
+		r.Get("/municipios/v1/{siglaUF}", handler.BrasilAPIMunicipios)
 	})
 
 	return r
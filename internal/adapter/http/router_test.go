@@ -0,0 +1,52 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Teste para verificar headers de resposta
+func TestResponseHeaders(t *testing.T) {
+	repo := &mockIBGERepository{}
+	handler := NewIBGEHandler(novoRegistroVersoes(repo))
+	router := SetupRouter(handler)
+
+	req := httptest.NewRequest("GET", "/api/v1/estados", nil)
+	rr := httptest.NewRecorder()
+
+	router.ServeHTTP(rr, req)
+
+	// Verificar Content-Type
+	contentType := rr.Header().Get("Content-Type")
+	if contentType != "application/json" {
+		t.Errorf("Content-Type incorreto: got %v want %v", contentType, "application/json")
+	}
+
+	// Verificar headers de segurança (se implementados)
+	xContentTypeOptions := rr.Header().Get("X-Content-Type-Options")
+	if xContentTypeOptions != "nosniff" {
+		t.Errorf("X-Content-Type-Options incorreto: got %v want %v", xContentTypeOptions, "nosniff")
+	}
+}
+
+// Teste para verificar rate limiting (se implementado)
+func TestRateLimiting(t *testing.T) {
+	repo := &mockIBGERepository{}
+	handler := NewIBGEHandler(novoRegistroVersoes(repo))
+	router := SetupRouter(handler)
+
+	// Fazer várias requisições rapidamente
+	for i := 0; i < 10; i++ {
+		req := httptest.NewRequest("GET", "/api/v1/estados", nil)
+		req.RemoteAddr = "192.168.1.1:12345" // Simular mesmo IP
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		// As primeiras requisições devem passar
+		if i < 5 && rr.Code != http.StatusOK {
+			t.Errorf("Requisição %d deveria passar: got status %d", i, rr.Code)
+		}
+	}
+}
@@ -0,0 +1,425 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+	"github.com/go-chi/chi/v5"
+)
+
+// Rotas da API v2 (/api/v2), com um contrato estável para os mesmos dados da v1:
+//   - as cidades trazem o estado e a Grande Região como objetos aninhados;
+//   - os códigos são números (na v1, codigo_tom, micro_regiao e regiao_imediata são texto);
+//   - todos os campos estão sempre presentes: valores ausentes vêm como null e listas vazias como [];
+//   - as listas vêm em um envelope {"dados": [...], "meta": {...}}, com o total, a página, a versão
+//     do conjunto de dados e os links de paginação.
+//
+// Os filtros, a ordenação, a paginação, ?fields=, ?data= e a negociação de formato funcionam como
+// na v1. ?expand= não tem efeito, pois o estado e a região já vêm aninhados. A v1 continua servindo
+// o formato original, com os headers Deprecation e Sunset quando configurados.
+
+// v2EstadoResumo é o estado aninhado nas cidades.
+type v2EstadoResumo struct {
+	CodigoIBGE int    `json:"codigo_ibge" example:"35"`
+	Sigla      string `json:"sigla" example:"SP"`
+	Nome       string `json:"nome" example:"São Paulo"`
+}
+
+// v2Estado é um estado na API v2.
+type v2Estado struct {
+	CodigoIBGE  int           `json:"codigo_ibge" example:"35"`
+	Sigla       string        `json:"sigla" example:"SP"`
+	Nome        string        `json:"nome" example:"São Paulo"`
+	FusoHorario *string       `json:"fuso_horario" example:"America/Sao_Paulo"`
+	Regiao      domain.Regiao `json:"regiao"`
+}
+
+// v2Cidade é uma cidade na API v2.
+type v2Cidade struct {
+	CodigoIBGE      int            `json:"codigo_ibge" example:"3550308"`
+	Nome            string         `json:"nome" example:"São Paulo"`
+	Gentilico       *string        `json:"gentilico" example:"paulistano"`
+	Aliases         []string       `json:"aliases"`
+	CodigoTOM       *int           `json:"codigo_tom" example:"7107"`
+	MicroRegiao     *int           `json:"micro_regiao" example:"35061"`
+	RegiaoImediata  *int           `json:"regiao_imediata" example:"350001"`
+	FusoHorario     *string        `json:"fuso_horario" example:"America/Sao_Paulo"`
+	Estado          v2EstadoResumo `json:"estado"`
+	Regiao          domain.Regiao  `json:"regiao"`
+	AmazoniaLegal   *bool          `json:"amazonia_legal"`
+	Semiarido       *bool          `json:"semiarido"`
+	FaixaFronteira  *bool          `json:"faixa_fronteira"`
+	PaisesFronteira []string       `json:"paises_fronteira"`
+	ZonaCosteira    *bool          `json:"zona_costeira"`
+	Matopiba        *bool          `json:"matopiba"`
+}
+
+// v2RespostaCidadeExtinta é o corpo da resposta 410 da v2: o mesmo da v1, com as cidades no
+// formato da v2.
+type v2RespostaCidadeExtinta struct {
+	problema
+	DataExtincao string     `json:"data_extincao"`
+	Cidade       v2Cidade   `json:"cidade"`
+	Sucessores   []v2Cidade `json:"sucessores"`
+}
+
+func novoEstadoV2(e domain.Estado) v2Estado {
+	return v2Estado{
+		CodigoIBGE:  e.CodigoIBGE,
+		Sigla:       e.Sigla,
+		Nome:        e.Nome,
+		FusoHorario: textoOpcional(e.FusoHorario),
+		Regiao:      e.Regiao(),
+	}
+}
+
+func novosEstadosV2(estados []domain.Estado) []v2Estado {
+	convertidos := make([]v2Estado, len(estados))
+	for i, e := range estados {
+		convertidos[i] = novoEstadoV2(e)
+	}
+	return convertidos
+}
+
+func novaCidadeV2(c domain.Cidade, estado v2EstadoResumo) v2Cidade {
+	return v2Cidade{
+		CodigoIBGE:      c.CodigoIBGE,
+		Nome:            c.Nome,
+		Gentilico:       textoOpcional(c.Gentilico),
+		Aliases:         append([]string{}, c.Aliases...),
+		CodigoTOM:       codigoOpcional(c.CodigoTOM),
+		MicroRegiao:     codigoOpcional(c.MicroRegiao),
+		RegiaoImediata:  codigoOpcional(c.RegiaoImediata),
+		FusoHorario:     textoOpcional(c.FusoHorario),
+		Estado:          estado,
+		Regiao:          domain.Estado{CodigoIBGE: c.EstadoCodigoIBGE}.Regiao(),
+		AmazoniaLegal:   c.AmazoniaLegal,
+		Semiarido:       c.Semiarido,
+		FaixaFronteira:  c.FaixaFronteira,
+		PaisesFronteira: append([]string{}, c.PaisesFronteira...),
+		ZonaCosteira:    c.ZonaCosteira,
+		Matopiba:        c.Matopiba,
+	}
+}
+
+// cidadesV2 converte as cidades para a v2, com os dados do estado de cada uma na versão selecionada.
+func (h *IBGEHandler) cidadesV2(r *http.Request, cidades []domain.Cidade) ([]v2Cidade, error) {
+	estados, err := h.casoDeUso(r).GetAllEstados()
+	if err != nil {
+		return nil, err
+	}
+	resumos := make(map[int]v2EstadoResumo, len(estados))
+	for _, e := range estados {
+		resumos[e.CodigoIBGE] = v2EstadoResumo{CodigoIBGE: e.CodigoIBGE, Sigla: e.Sigla, Nome: e.Nome}
+	}
+
+	convertidas := make([]v2Cidade, len(cidades))
+	for i, c := range cidades {
+		estado, found := resumos[c.EstadoCodigoIBGE]
+		if !found {
+			estado = v2EstadoResumo{CodigoIBGE: c.EstadoCodigoIBGE, Sigla: c.EstadoSigla, Nome: c.EstadoNome}
+		}
+		convertidas[i] = novaCidadeV2(c, estado)
+	}
+	return convertidas, nil
+}
+
+// respondCidadesV2 responde com o envelope das cidades no formato da v2.
+func (h *IBGEHandler) respondCidadesV2(w http.ResponseWriter, r *http.Request, cidades []domain.Cidade) {
+	convertidas, err := h.cidadesV2(r, cidades)
+	if err != nil {
+		h.responderErroV2(w, r, err)
+		return
+	}
+	respond(w, r, http.StatusOK, envelopeLista{Dados: convertidas})
+}
+
+// respondCidadeV2 responde com a cidade no formato da v2.
+func (h *IBGEHandler) respondCidadeV2(w http.ResponseWriter, r *http.Request, cidade *domain.Cidade) {
+	convertidas, err := h.cidadesV2(r, []domain.Cidade{*cidade})
+	if err != nil {
+		h.responderErroV2(w, r, err)
+		return
+	}
+	respond(w, r, http.StatusOK, convertidas[0])
+}
+
+// textoOpcional retorna nil para textos vazios, que a v2 serializa como null.
+func textoOpcional(texto string) *string {
+	if texto == "" {
+		return nil
+	}
+	return &texto
+}
+
+// responderErroV2 responde como responderErro, com o município extinto e os sucessores da
+// resposta 410 no formato da v2.
+func (h *IBGEHandler) responderErroV2(w http.ResponseWriter, r *http.Request, err error) {
+	status, payload := problemaDoErro(w, r, err)
+	var extinta *domain.CidadeExtintaError
+	if resposta, ok := payload.(respostaCidadeExtinta); ok && errors.As(err, &extinta) {
+		cidades, erroEstados := h.cidadesV2(r, append([]domain.Cidade{extinta.Cidade}, extinta.Sucessores...))
+		if erroEstados != nil {
+			status, payload = problemaDoErro(w, r, erroEstados)
+		} else {
+			payload = v2RespostaCidadeExtinta{
+				problema:     resposta.problema,
+				DataExtincao: extinta.Data,
+				Cidade:       cidades[0],
+				Sucessores:   cidades[1:],
+			}
+		}
+	}
+	respond(w, r, status, payload)
+}
+
+// V2Regioes godoc
+// @Summary Lista as Grandes Regiões
+// @Description Retorna as cinco Grandes Regiões do Brasil, ordenadas pelo código IBGE
+// @Tags Regiões (v2)
+// @Produce json
+// @Success 200 {object} envelopeLista{dados=[]domain.Regiao}
+// @Router /v2/regioes [get]
+func (h *IBGEHandler) V2Regioes(w http.ResponseWriter, r *http.Request) {
+	respond(w, r, http.StatusOK, envelopeLista{Dados: h.casoDeUso(r).GetRegioes()})
+}
+
+// V2Regiao godoc
+// @Summary Busca uma Grande Região
+// @Description Retorna uma Grande Região pelo código IBGE ou pela sigla
+// @Tags Regiões (v2)
+// @Produce json
+// @Param regiao path string true "Código IBGE (ex: 3) ou sigla (ex: SE) da região"
+// @Success 200 {object} domain.Regiao
+// @Failure 404 {object} problema
+// @Router /v2/regioes/{regiao} [get]
+func (h *IBGEHandler) V2Regiao(w http.ResponseWriter, r *http.Request) {
+	regiao, err := h.casoDeUso(r).GetRegiao(chi.URLParam(r, "regiao"))
+	if err != nil {
+		h.responderErroV2(w, r, err)
+		return
+	}
+	respond(w, r, http.StatusOK, regiao)
+}
+
+// V2EstadosByRegiao godoc
+// @Summary Lista os estados de uma Grande Região
+// @Description Retorna os estados da região, ordenados pelo código IBGE
+// @Tags Regiões (v2)
+// @Produce json
+// @Param regiao path string true "Código IBGE (ex: 3) ou sigla (ex: SE) da região"
+// @Param pagina query int false "Página (a partir de 1)"
+// @Param por_pagina query int false "Itens por página (padrão 100, máximo 1000)"
+// @Param cursor query string false "Cursor de meta.links (vazio para começar a navegação por cursor)"
+// @Success 200 {object} envelopeLista{dados=[]v2Estado}
+// @Failure 400 {object} problema
+// @Failure 404 {object} problema
+// @Router /v2/regioes/{regiao}/estados [get]
+func (h *IBGEHandler) V2EstadosByRegiao(w http.ResponseWriter, r *http.Request) {
+	err := semDataReferencia(r)
+	var estados []domain.Estado
+	if err == nil {
+		estados, err = h.casoDeUso(r).GetEstadosByRegiao(chi.URLParam(r, "regiao"))
+	}
+	if err != nil {
+		h.responderErroV2(w, r, err)
+		return
+	}
+	respond(w, r, http.StatusOK, envelopeLista{Dados: novosEstadosV2(estados)})
+}
+
+// V2Estados godoc
+// @Summary Lista os estados
+// @Description Retorna os 27 estados, opcionalmente filtrados pelo nome e ordenados, com a Grande Região de cada um
+// @Tags Estados (v2)
+// @Produce json
+// @Param nome_contem query string false "Parte do nome, sem diferenciar acentos e caixa" example(para)
+// @Param ordenar query string false "Campos de ordenação separados por vírgula, com - para ordem decrescente (codigo_ibge, nome, sigla)" example(-codigo_ibge)
+// @Param fields query string false "Campos de cada estado, separados por vírgula" example(sigla,regiao.sigla)
+// @Param pagina query int false "Página (a partir de 1)"
+// @Param por_pagina query int false "Itens por página (padrão 100, máximo 1000)"
+// @Param cursor query string false "Cursor de meta.links (vazio para começar a navegação por cursor)"
+// @Success 200 {object} envelopeLista{dados=[]v2Estado}
+// @Failure 400 {object} problema
+// @Failure 500 {object} problema
+// @Router /v2/estados [get]
+func (h *IBGEHandler) V2Estados(w http.ResponseWriter, r *http.Request) {
+	estados, err := h.listarEstados(r)
+	if err != nil {
+		h.responderErroV2(w, r, err)
+		return
+	}
+	respond(w, r, http.StatusOK, envelopeLista{Dados: novosEstadosV2(estados)})
+}
+
+// V2Estado godoc
+// @Summary Busca um estado
+// @Description Retorna um estado pela sigla ou pelo código IBGE, com a sua Grande Região
+// @Tags Estados (v2)
+// @Produce json
+// @Param uf path string true "Sigla (ex: SP) ou código IBGE (ex: 35) do estado"
+// @Success 200 {object} v2Estado
+// @Failure 400 {object} problema "Parâmetro data informado: o histórico cobre apenas os municípios"
+// @Failure 404 {object} problema
+// @Router /v2/estados/{uf} [get]
+func (h *IBGEHandler) V2Estado(w http.ResponseWriter, r *http.Request) {
+	err := semDataReferencia(r)
+	var estado *domain.Estado
+	if err == nil {
+		estado, err = h.buscarEstado(r, chi.URLParam(r, "uf"))
+	}
+	if err != nil {
+		h.responderErroV2(w, r, err)
+		return
+	}
+	respond(w, r, http.StatusOK, novoEstadoV2(*estado))
+}
+
+// V2CidadesByEstado godoc
+// @Summary Lista as cidades de um estado
+// @Description Retorna as cidades do estado, com os mesmos filtros e ordenação de /v1/estados/{uf}/cidades
+// @Tags Cidades (v2)
+// @Produce json
+// @Param uf path string true "Sigla (ex: SP) ou código IBGE (ex: 35) do estado"
+// @Param nome_contem query string false "Parte do nome, sem diferenciar acentos e caixa" example(sao jose)
+// @Param micro_regiao query string false "Código da microrregião" example(35061)
+// @Param regiao_imediata query string false "Código da região geográfica imediata" example(350001)
+// @Param tem_codigo_tom query bool false "Filtra cidades com (ou sem) código TOM"
+// @Param ordenar query string false "Campos de ordenação separados por vírgula, com - para ordem decrescente" example(nome,-codigo_ibge)
+// @Param fields query string false "Campos de cada cidade, separados por vírgula" example(codigo_ibge,nome,estado.sigla)
+// @Param data query string false "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época"
+// @Param pagina query int false "Página (a partir de 1)"
+// @Param por_pagina query int false "Itens por página (padrão 100, máximo 1000)"
+// @Param cursor query string false "Cursor de meta.links (vazio para começar a navegação por cursor)"
+// @Success 200 {object} envelopeLista{dados=[]v2Cidade}
+// @Failure 400 {object} problema
+// @Failure 404 {object} problema
+// @Router /v2/estados/{uf}/cidades [get]
+func (h *IBGEHandler) V2CidadesByEstado(w http.ResponseWriter, r *http.Request) {
+	cidades, err := h.listarCidades(r, chi.URLParam(r, "uf"))
+	if err != nil {
+		h.responderErroV2(w, r, err)
+		return
+	}
+	h.respondCidadesV2(w, r, cidades)
+}
+
+// V2Cidades godoc
+// @Summary Lista as cidades de todos os estados
+// @Description Retorna as cidades do país, com os mesmos filtros e ordenação de /v2/estados/{uf}/cidades
+// @Tags Cidades (v2)
+// @Produce json
+// @Param nome_contem query string false "Parte do nome, sem diferenciar acentos e caixa" example(sao jose)
+// @Param micro_regiao query string false "Código da microrregião" example(35061)
+// @Param regiao_imediata query string false "Código da região geográfica imediata" example(350001)
+// @Param tem_codigo_tom query bool false "Filtra cidades com (ou sem) código TOM"
+// @Param ordenar query string false "Campos de ordenação separados por vírgula, com - para ordem decrescente" example(nome,-codigo_ibge)
+// @Param fields query string false "Campos de cada cidade, separados por vírgula" example(codigo_ibge,nome,estado.sigla)
+// @Param data query string false "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época"
+// @Param pagina query int false "Página (a partir de 1)"
+// @Param por_pagina query int false "Itens por página (padrão 100, máximo 1000)"
+// @Param cursor query string false "Cursor de meta.links (vazio para começar a navegação por cursor)"
+// @Success 200 {object} envelopeLista{dados=[]v2Cidade}
+// @Failure 400 {object} problema
+// @Failure 500 {object} problema
+// @Router /v2/cidades [get]
+func (h *IBGEHandler) V2Cidades(w http.ResponseWriter, r *http.Request) {
+	cidades, err := h.listarCidades(r, "")
+	if err != nil {
+		h.responderErroV2(w, r, err)
+		return
+	}
+	h.respondCidadesV2(w, r, cidades)
+}
+
+// V2BuscarCidades godoc
+// @Summary Busca cidades pelo nome
+// @Description Retorna as cidades cujo nome oficial ou nome alternativo contém o termo, sem diferenciar acentos e caixa. Correspondências exatas vêm primeiro.
+// @Tags Cidades (v2)
+// @Produce json
+// @Param nome query string true "Nome ou parte do nome da cidade" example(S. J. dos Campos)
+// @Param uf query string false "Sigla do estado" example(SP)
+// @Param fields query string false "Campos de cada cidade, separados por vírgula" example(codigo_ibge,nome)
+// @Param data query string false "Data de referência (AAAA-MM-DD); inclui municípios extintos depois dela" example(2010-08-01)
+// @Param pagina query int false "Página (a partir de 1)"
+// @Param por_pagina query int false "Itens por página (padrão 100, máximo 1000)"
+// @Param cursor query string false "Cursor de meta.links (vazio para começar a navegação por cursor)"
+// @Success 200 {object} envelopeLista{dados=[]v2Cidade}
+// @Failure 400 {object} problema
+// @Router /v2/cidades/busca [get]
+func (h *IBGEHandler) V2BuscarCidades(w http.ResponseWriter, r *http.Request) {
+	cidades, err := h.buscarCidades(r)
+	if err != nil {
+		h.responderErroV2(w, r, err)
+		return
+	}
+	h.respondCidadesV2(w, r, cidades)
+}
+
+// V2Cidade godoc
+// @Summary Busca cidade por código IBGE
+// @Description Retorna uma cidade pelo código IBGE, com o estado e a Grande Região aninhados
+// @Tags Cidades (v2)
+// @Produce json
+// @Param codigo_ibge path string true "Código IBGE da cidade" example(3550308)
+// @Param fields query string false "Campos da cidade, separados por vírgula" example(codigo_ibge,nome)
+// @Param data query string false "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época" example(2010-08-01)
+// @Success 200 {object} v2Cidade
+// @Failure 400 {object} problema
+// @Failure 404 {object} problema
+// @Failure 410 {object} v2RespostaCidadeExtinta "Município extinto; a resposta lista os sucessores"
+// @Router /v2/cidades/{codigo_ibge} [get]
+func (h *IBGEHandler) V2Cidade(w http.ResponseWriter, r *http.Request) {
+	cidade, err := h.cidadePorCodigo(r)
+	if err != nil {
+		h.responderErroV2(w, r, err)
+		return
+	}
+	h.respondCidadeV2(w, r, cidade)
+}
+
+// V2CidadeByCodigoTOM godoc
+// @Summary Busca cidade por código TOM
+// @Description Retorna uma cidade pelo código TOM
+// @Tags Cidades (v2)
+// @Produce json
+// @Param codigo_tom path string true "Código TOM da cidade" example(7107)
+// @Param fields query string false "Campos da cidade, separados por vírgula" example(codigo_ibge,nome)
+// @Param data query string false "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época" example(2010-08-01)
+// @Success 200 {object} v2Cidade
+// @Failure 400 {object} problema
+// @Failure 404 {object} problema
+// @Router /v2/cidades/{codigo_tom}/tom [get]
+func (h *IBGEHandler) V2CidadeByCodigoTOM(w http.ResponseWriter, r *http.Request) {
+	cidade, err := h.casoDeUso(r).GetCidadeByCodigoTOM(chi.URLParam(r, "codigo_tom"))
+	if err == nil {
+		cidade, err = h.situacaoNaData(r, cidade)
+	}
+	if err != nil {
+		h.responderErroV2(w, r, err)
+		return
+	}
+	h.respondCidadeV2(w, r, cidade)
+}
+
+// V2CidadeByCEP godoc
+// @Summary Busca cidade por CEP
+// @Description Retorna a cidade cuja faixa de CEP contém o CEP informado
+// @Tags Cidades (v2)
+// @Produce json
+// @Param cep path string true "CEP com ou sem máscara" example(01310-100)
+// @Param fields query string false "Campos da cidade, separados por vírgula" example(codigo_ibge,nome)
+// @Param data query string false "Data de referência (AAAA-MM-DD) para consultar a situação territorial da época" example(2010-08-01)
+// @Success 200 {object} v2Cidade
+// @Failure 400 {object} problema
+// @Failure 404 {object} problema
+// @Router /v2/cep/{cep} [get]
+func (h *IBGEHandler) V2CidadeByCEP(w http.ResponseWriter, r *http.Request) {
+	cidade, err := h.cidadePorCEP(r)
+	if err != nil {
+		h.responderErroV2(w, r, err)
+		return
+	}
+	h.respondCidadeV2(w, r, cidade)
+}
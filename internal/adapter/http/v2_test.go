@@ -0,0 +1,188 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestAPIV2 cobre as rotas da API v2.
+func TestAPIV2(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("GET /api/v2/cidades/{codigo_ibge} - município extinto deve responder 410 no formato da v2", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v2/cidades/3500000", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusGone {
+			t.Fatalf("Status code incorreto: got %v want %v", rr.Code, http.StatusGone)
+		}
+		var resposta struct {
+			Type         string                   `json:"type"`
+			DataExtincao string                   `json:"data_extincao"`
+			Cidade       map[string]interface{}   `json:"cidade"`
+			Sucessores   []map[string]interface{} `json:"sucessores"`
+		}
+		if err := json.Unmarshal(rr.Body.Bytes(), &resposta); err != nil {
+			t.Fatalf("Erro ao decodificar a resposta: %v", err)
+		}
+		if resposta.Type != "urn:ibge-service:problema:municipio-extinto" || resposta.DataExtincao != "2005-01-01" {
+			t.Errorf("Problema incorreto: %+v", resposta)
+		}
+		// Sem código TOM, o campo vem como null em vez de ser omitido
+		if valor, found := resposta.Cidade["codigo_tom"]; !found || valor != nil {
+			t.Errorf("codigo_tom deveria ser null: %v", resposta.Cidade)
+		}
+		if len(resposta.Sucessores) != 1 || resposta.Sucessores[0]["codigo_tom"] != float64(7108) {
+			t.Fatalf("Sucessores incorretos: %v", resposta.Sucessores)
+		}
+		if estado, _ := resposta.Sucessores[0]["estado"].(map[string]interface{}); estado["sigla"] != "SP" {
+			t.Errorf("Estado do sucessor incorreto: %v", resposta.Sucessores[0])
+		}
+	})
+
+	t.Run("GET /api/v2/cidades - ?fields= deve selecionar os campos dos itens e manter os metadados", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v2/cidades?fields=nome,estado.sigla&por_pagina=2", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Status code incorreto: got %v want %v", rr.Code, http.StatusOK)
+		}
+		var resposta struct {
+			Dados json.RawMessage `json:"dados"`
+			Meta  metadadosLista  `json:"meta"`
+		}
+		if err := json.Unmarshal(rr.Body.Bytes(), &resposta); err != nil {
+			t.Fatalf("Erro ao decodificar a resposta: %v", err)
+		}
+		if got, want := string(resposta.Dados), `[{"nome":"São Paulo","estado":{"sigla":"SP"}},{"nome":"Campinas","estado":{"sigla":"SP"}}]`; got != want {
+			t.Errorf("Itens incorretos.\ngot:  %s\nwant: %s", got, want)
+		}
+		meta := resposta.Meta
+		if meta.Total != 6 || meta.Pagina == nil || *meta.Pagina != 1 || meta.PorPagina == nil || *meta.PorPagina != 2 || meta.Versao != "2024" {
+			t.Errorf("Metadados incorretos: %+v", meta)
+		}
+		if meta.Links.Prev != nil || meta.Links.Next == nil || *meta.Links.Next != "/api/v2/cidades?fields=nome%2Cestado.sigla&pagina=2&por_pagina=2&versao=2024" {
+			t.Errorf("Links incorretos: %+v", meta.Links)
+		}
+		if rr.Header().Get(HeaderTotalRegistros) != "6" || linksPaginacao(rr.Header().Get("Link"))["next"] == "" {
+			t.Errorf("Headers de paginação ausentes: %v", rr.Header())
+		}
+	})
+
+	t.Run("GET /api/v2/estados - navegação por cursor não informa o número da página", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v2/estados?cursor=&por_pagina=2", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		var resposta struct {
+			Dados []v2Estado     `json:"dados"`
+			Meta  metadadosLista `json:"meta"`
+		}
+		if err := json.Unmarshal(rr.Body.Bytes(), &resposta); err != nil {
+			t.Fatalf("Erro ao decodificar a resposta: %v", err)
+		}
+		if len(resposta.Dados) != 2 || resposta.Meta.Pagina != nil || resposta.Meta.PorPagina == nil || *resposta.Meta.PorPagina != 2 {
+			t.Fatalf("Envelope incorreto: %+v", resposta)
+		}
+		if resposta.Meta.Links.Next == nil || *resposta.Meta.Links.Next != linksPaginacao(rr.Header().Get("Link"))["next"] {
+			t.Errorf("Link next do envelope diferente do header: %v", resposta.Meta.Links.Next)
+		}
+	})
+
+	t.Run("GET /api/v2/estados/SP/cidades - CSV deve trazer apenas os itens do envelope", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v2/estados/SP/cidades?formato=csv&fields=codigo_ibge,estado.sigla,codigo_tom", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		esperado := "codigo_ibge,codigo_tom,estado.sigla\n3550308,7107,SP\n3509502,7108,SP\n3552205,7109,SP\n"
+		if rr.Code != http.StatusOK || rr.Body.String() != esperado {
+			t.Errorf("CSV incorreto: %d\n%s", rr.Code, rr.Body.String())
+		}
+	})
+
+	t.Run("GET /api/v2 - erros seguem a RFC 7807", func(t *testing.T) {
+		testCases := []struct {
+			path           string
+			expectedStatus int
+			expectedCode   string
+		}{
+			{"/api/v2/estados/XX", http.StatusNotFound, "estado_sigla_nao_encontrado"},
+			{"/api/v2/regioes/XX/estados", http.StatusNotFound, "regiao_nao_encontrada"},
+			{"/api/v2/cidades/busca?nome=a", http.StatusBadRequest, "nome_busca_curto"},
+			{"/api/v2/cidades?pagina=0", http.StatusBadRequest, "pagina_invalida"},
+		}
+		for _, tc := range testCases {
+			req := httptest.NewRequest("GET", tc.path, nil)
+			rr := httptest.NewRecorder()
+
+			router.ServeHTTP(rr, req)
+
+			var p problema
+			if err := json.Unmarshal(rr.Body.Bytes(), &p); err != nil {
+				t.Fatalf("%s: erro ao decodificar a resposta: %v", tc.path, err)
+			}
+			if rr.Code != tc.expectedStatus || p.Code != tc.expectedCode || rr.Header().Get("Content-Type") != TipoConteudoProblema {
+				t.Errorf("%s: resposta incorreta: %d %+v", tc.path, rr.Code, p)
+			}
+		}
+	})
+}
+
+// TestAPIV2Contrato compara o JSON exato das rotas /api/v2 com os arquivos golden em testdata/v2,
+// que fixam o contrato da v2: uma mudança no schema precisa regravá-los com -update.
+func TestAPIV2Contrato(t *testing.T) {
+	repo := &mockIBGERepository{}
+	handler := NewIBGEHandler(novoRegistroVersoes(repo))
+	router := SetupRouter(handler)
+
+	testCases := []struct {
+		path           string
+		golden         string
+		expectedStatus int
+	}{
+		{"/api/v2/regioes", "regioes.json", http.StatusOK},
+		{"/api/v2/regioes/SE/estados", "regiao-se-estados.json", http.StatusOK},
+		{"/api/v2/estados", "estados.json", http.StatusOK},
+		{"/api/v2/estados/SP", "estado-sp.json", http.StatusOK},
+		{"/api/v2/estados/RJ/cidades?pagina=2&por_pagina=1", "cidades-rj-pagina-2.json", http.StatusOK},
+		{"/api/v2/cidades/3550308", "cidade-3550308.json", http.StatusOK},
+		{"/api/v2/cidades/7201/tom", "cidade-tom-7201.json", http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tc.path, nil)
+			rr := httptest.NewRecorder()
+
+			router.ServeHTTP(rr, req)
+
+			if status := rr.Code; status != tc.expectedStatus {
+				t.Fatalf("Status code incorreto: got %v want %v", status, tc.expectedStatus)
+			}
+
+			arquivo := filepath.Join("testdata", "v2", tc.golden)
+			if *atualizarGolden {
+				if err := os.WriteFile(arquivo, append(rr.Body.Bytes(), '\n'), 0o644); err != nil {
+					t.Fatalf("Erro ao gravar %s: %v", arquivo, err)
+				}
+			}
+			esperado, err := os.ReadFile(arquivo)
+			if err != nil {
+				t.Fatalf("Erro ao ler %s: %v", arquivo, err)
+			}
+			if got := rr.Body.String() + "\n"; got != string(esperado) {
+				t.Errorf("JSON diferente de %s.\ngot:  %s\nwant: %s", arquivo, got, esperado)
+			}
+		})
+	}
+}
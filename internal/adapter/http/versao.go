@@ -0,0 +1,90 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/brauliohms/ibge-service/internal/usecase"
+)
+
+// HeaderVersaoDataset é o header usado para escolher e informar a edição do conjunto de dados.
+const HeaderVersaoDataset = "X-Dataset-Version"
+
+type chaveContexto string
+
+const chaveCasoDeUso chaveContexto = "casoDeUso"
+
+// SelecionarVersao resolve a edição do conjunto de dados pedida pelo cliente, pelo header
+// X-Dataset-Version ou pelo parâmetro ?versao= (que tem precedência), e guarda o caso de uso
+// correspondente no contexto da requisição. Sem nenhum dos dois, a versão padrão é usada.
+func (h *IBGEHandler) SelecionarVersao(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		versao := r.Header.Get(HeaderVersaoDataset)
+		if v := r.URL.Query().Get("versao"); v != "" {
+			versao = v
+		}
+
+		uc, versao, err := h.versoes.Versao(versao)
+		if err != nil {
+			responderErro(w, r, err)
+			return
+		}
+
+		w.Header().Set(HeaderVersaoDataset, versao)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), chaveCasoDeUso, uc)))
+	})
+}
+
+// casoDeUso retorna o caso de uso da versão selecionada para a requisição.
+// Fora do middleware SelecionarVersao, a versão padrão é usada.
+func (h *IBGEHandler) casoDeUso(r *http.Request) *usecase.IBGEUseCase {
+	return h.casoDeUsoDoContexto(r.Context())
+}
+
+// casoDeUsoDoContexto é como casoDeUso, para quem só tem o contexto da requisição (ex: resolvers GraphQL).
+func (h *IBGEHandler) casoDeUsoDoContexto(ctx context.Context) *usecase.IBGEUseCase {
+	if uc, ok := ctx.Value(chaveCasoDeUso).(*usecase.IBGEUseCase); ok {
+		return uc
+	}
+	uc, _, _ := h.versoes.Versao("")
+	return uc
+}
+
+// versaoSelecionada retorna o nome da edição escolhida pelo middleware SelecionarVersao para a
+// resposta, ou o da versão padrão fora dele.
+func (h *IBGEHandler) versaoSelecionada(w http.ResponseWriter) string {
+	if versao := w.Header().Get(HeaderVersaoDataset); versao != "" {
+		return versao
+	}
+	return h.versoes.Padrao()
+}
+
+// respostaVersoes é o corpo da resposta de /versoes.
+type respostaVersoes struct {
+	Padrao  string   `json:"padrao"`
+	Versoes []string `json:"versoes"`
+}
+
+func (v respostaVersoes) itensLista() interface{} {
+	return v.Versoes
+}
+
+func (v respostaVersoes) comItens(itens interface{}) interface{} {
+	v.Versoes = itens.([]string)
+	return v
+}
+
+// GetVersoes godoc
+// @Summary Lista as versões do conjunto de dados
+// @Description Retorna as edições do conjunto de dados disponíveis (ex: DTB 2020, 2022, 2024) e a versão padrão. Escolha uma versão com o header X-Dataset-Version ou o parâmetro versao.
+// @Tags Versões
+// @Accept json
+// @Produce json
+// @Success 200 {object} respostaVersoes
+// @Router /v1/versoes [get]
+func (h *IBGEHandler) GetVersoes(w http.ResponseWriter, r *http.Request) {
+	respond(w, r, http.StatusOK, respostaVersoes{
+		Padrao:  h.versoes.Padrao(),
+		Versoes: h.versoes.Versoes(),
+	})
+}
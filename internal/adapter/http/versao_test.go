@@ -0,0 +1,121 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// TestVersoesDataset cobre a escolha da versão do conjunto de dados e o feed de alterações.
+func TestVersoesDataset(t *testing.T) {
+	router := novoRouterTeste(t)
+
+	t.Run("GET /api/v1/estados - deve servir a versão do conjunto de dados escolhida", func(t *testing.T) {
+		testCases := []struct {
+			name            string
+			query           string
+			header          string
+			expectedStatus  int
+			expectedVersao  string
+			expectedEstados int
+		}{
+			{"Padrão é a mais recente", "", "", http.StatusOK, "2024", 3},
+			{"Header", "", "2020", http.StatusOK, "2020", 2},
+			{"Parâmetro", "?versao=2020", "", http.StatusOK, "2020", 2},
+			{"Parâmetro tem precedência", "?versao=2024", "2020", http.StatusOK, "2024", 3},
+			{"Versão inexistente", "?versao=1999", "", http.StatusBadRequest, "", 0},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := httptest.NewRequest("GET", "/api/v1/estados"+tc.query, nil)
+				if tc.header != "" {
+					req.Header.Set(HeaderVersaoDataset, tc.header)
+				}
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != tc.expectedStatus {
+					t.Fatalf("Status code incorreto: got %v want %v", status, tc.expectedStatus)
+				}
+				if tc.expectedStatus != http.StatusOK {
+					return
+				}
+				if versao := rr.Header().Get(HeaderVersaoDataset); versao != tc.expectedVersao {
+					t.Errorf("Header %s incorreto: got %s want %s", HeaderVersaoDataset, versao, tc.expectedVersao)
+				}
+				var estados []domain.Estado
+				if err := json.Unmarshal(rr.Body.Bytes(), &estados); err != nil {
+					t.Fatalf("Erro ao decodificar JSON: %v", err)
+				}
+				if len(estados) != tc.expectedEstados {
+					t.Errorf("Número de estados incorreto: got %d want %d", len(estados), tc.expectedEstados)
+				}
+			})
+		}
+	})
+
+	t.Run("GET /api/v1/versoes - deve listar as versões disponíveis", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/versoes", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusOK {
+			t.Fatalf("Status code incorreto: got %v want %v", status, http.StatusOK)
+		}
+		var resposta respostaVersoes
+		if err := json.Unmarshal(rr.Body.Bytes(), &resposta); err != nil {
+			t.Fatalf("Erro ao decodificar JSON: %v", err)
+		}
+		if resposta.Padrao != "2024" || strings.Join(resposta.Versoes, ",") != "2020,2024" {
+			t.Errorf("Versões incorretas: %+v", resposta)
+		}
+	})
+
+	t.Run("GET /api/v1/alteracoes - deve retornar o feed de alterações", func(t *testing.T) {
+		testCases := []struct {
+			query          string
+			expectedStatus int
+			expectedTotal  int
+		}{
+			{"", http.StatusOK, 2},
+			{"?desde=1", http.StatusOK, 1},
+			{"?desde=2", http.StatusOK, 0},
+			{"?desde=3", http.StatusBadRequest, 0},
+			{"?desde=-1", http.StatusBadRequest, 0},
+			{"?desde=abc", http.StatusBadRequest, 0},
+		}
+
+		for _, tc := range testCases {
+			t.Run("Query_"+tc.query, func(t *testing.T) {
+				req := httptest.NewRequest("GET", "/api/v1/alteracoes"+tc.query, nil)
+				rr := httptest.NewRecorder()
+
+				router.ServeHTTP(rr, req)
+
+				if status := rr.Code; status != tc.expectedStatus {
+					t.Fatalf("Status code incorreto: got %v want %v", status, tc.expectedStatus)
+				}
+				if tc.expectedStatus != http.StatusOK {
+					return
+				}
+				var feed domain.FeedAlteracoes
+				if err := json.Unmarshal(rr.Body.Bytes(), &feed); err != nil {
+					t.Fatalf("Erro ao decodificar JSON: %v", err)
+				}
+				if feed.VersaoAtual != 2 {
+					t.Errorf("Versão atual incorreta: got %d want 2", feed.VersaoAtual)
+				}
+				if feed.Alteracoes == nil || len(feed.Alteracoes) != tc.expectedTotal {
+					t.Errorf("Número de alterações incorreto: got %d want %d", len(feed.Alteracoes), tc.expectedTotal)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,196 @@
+// Package protobuf converte as entidades do domínio nas mensagens de ibge.v1, compartilhadas
+// pelo serviço gRPC e pelas respostas application/x-protobuf da API HTTP.
+package protobuf
+
+import (
+	"time"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+	ibgev1 "github.com/brauliohms/ibge-service/pkg/pb/ibge/v1"
+)
+
+// ParaEstado converte um estado, com a sua Grande Região.
+func ParaEstado(e domain.Estado) *ibgev1.Estado {
+	regiao := e.Regiao()
+	return &ibgev1.Estado{
+		CodigoIbge:  int32(e.CodigoIBGE),
+		Nome:        e.Nome,
+		Sigla:       e.Sigla,
+		FusoHorario: e.FusoHorario,
+		Regiao: &ibgev1.Regiao{
+			CodigoIbge: int32(regiao.CodigoIBGE),
+			Sigla:      regiao.Sigla,
+			Nome:       regiao.Nome,
+		},
+		Feriados: paraRegrasFeriado(e.Feriados),
+	}
+}
+
+// ParaCidade converte uma cidade, com os feriados municipais e o histórico.
+func ParaCidade(c domain.Cidade) *ibgev1.Cidade {
+	return &ibgev1.Cidade{
+		CodigoIbge:       int32(c.CodigoIBGE),
+		Nome:             c.Nome,
+		Gentilico:        c.Gentilico,
+		Aliases:          c.Aliases,
+		CodigoTom:        c.CodigoTOM,
+		MicroRegiao:      c.MicroRegiao,
+		RegiaoImediata:   c.RegiaoImediata,
+		FusoHorario:      c.FusoHorario,
+		EstadoCodigoIbge: int32(c.EstadoCodigoIBGE),
+		EstadoSigla:      c.EstadoSigla,
+		EstadoNome:       c.EstadoNome,
+		AmazoniaLegal:    c.AmazoniaLegal,
+		Semiarido:        c.Semiarido,
+		FaixaFronteira:   c.FaixaFronteira,
+		PaisesFronteira:  c.PaisesFronteira,
+		ZonaCosteira:     c.ZonaCosteira,
+		Matopiba:         c.Matopiba,
+		Feriados:         paraRegrasFeriado(c.Feriados),
+		Historico:        paraEventos(c.Historico),
+	}
+}
+
+func paraRegrasFeriado(regras []domain.RegraFeriado) []*ibgev1.RegraFeriado {
+	if len(regras) == 0 {
+		return nil
+	}
+	mensagens := make([]*ibgev1.RegraFeriado, 0, len(regras))
+	for _, r := range regras {
+		mensagem := &ibgev1.RegraFeriado{
+			Nome:     r.Nome,
+			Tipo:     string(r.Tipo),
+			Dia:      int32(r.Dia),
+			Mes:      int32(r.Mes),
+			DesdeAno: int32(r.DesdeAno),
+		}
+		if r.DiasAposPascoa != nil {
+			dias := int32(*r.DiasAposPascoa)
+			mensagem.DiasAposPascoa = &dias
+		}
+		mensagens = append(mensagens, mensagem)
+	}
+	return mensagens
+}
+
+func paraEventos(eventos []domain.EventoMunicipio) []*ibgev1.EventoMunicipio {
+	if len(eventos) == 0 {
+		return nil
+	}
+	mensagens := make([]*ibgev1.EventoMunicipio, 0, len(eventos))
+	for _, e := range eventos {
+		mensagens = append(mensagens, &ibgev1.EventoMunicipio{
+			CodigoIbge:   int32(e.CodigoIBGE),
+			Tipo:         string(e.Tipo),
+			Data:         e.Data,
+			Nome:         e.Nome,
+			NomeAnterior: e.NomeAnterior,
+			Uf:           e.UF,
+			Origem:       paraCodigos(e.Origem),
+			Sucessores:   paraCodigos(e.Sucessores),
+		})
+	}
+	return mensagens
+}
+
+func paraCodigos(codigos []int) []int32 {
+	if len(codigos) == 0 {
+		return nil
+	}
+	convertidos := make([]int32, len(codigos))
+	for i, codigo := range codigos {
+		convertidos[i] = int32(codigo)
+	}
+	return convertidos
+}
+
+// ParaEstados converte uma lista de estados.
+func ParaEstados(estados []domain.Estado) []*ibgev1.Estado {
+	mensagens := make([]*ibgev1.Estado, 0, len(estados))
+	for _, e := range estados {
+		mensagens = append(mensagens, ParaEstado(e))
+	}
+	return mensagens
+}
+
+// ParaCidades converte uma lista de cidades.
+func ParaCidades(cidades []domain.Cidade) []*ibgev1.Cidade {
+	mensagens := make([]*ibgev1.Cidade, 0, len(cidades))
+	for _, c := range cidades {
+		mensagens = append(mensagens, ParaCidade(c))
+	}
+	return mensagens
+}
+
+// ParaFeriados converte os feriados de um ano.
+func ParaFeriados(feriados []domain.Feriado) []*ibgev1.Feriado {
+	mensagens := make([]*ibgev1.Feriado, 0, len(feriados))
+	for _, f := range feriados {
+		mensagens = append(mensagens, &ibgev1.Feriado{Data: f.Data, Nome: f.Nome, Tipo: string(f.Tipo)})
+	}
+	return mensagens
+}
+
+// ParaDiasUteis converte um prazo em dias úteis, com os feriados considerados no cálculo.
+func ParaDiasUteis(d domain.DiasUteis) *ibgev1.DiasUteis {
+	return &ibgev1.DiasUteis{
+		CodigoIbge:           int32(d.CodigoIBGE),
+		Inicio:               d.Inicio,
+		Dias:                 int32(d.Dias),
+		DataFinal:            d.DataFinal,
+		FeriadosConsiderados: ParaFeriados(d.FeriadosConsiderados),
+	}
+}
+
+// ParaHoraLocal converte a hora local de uma cidade. A hora vai em RFC 3339, como no JSON, para
+// manter o deslocamento do fuso, que um google.protobuf.Timestamp descartaria.
+func ParaHoraLocal(h domain.HoraLocal) *ibgev1.HoraLocal {
+	return &ibgev1.HoraLocal{
+		CodigoIbge:   int32(h.CodigoIBGE),
+		Nome:         h.Nome,
+		EstadoSigla:  h.EstadoSigla,
+		FusoHorario:  h.FusoHorario,
+		HoraLocal:    h.HoraLocal.Format(time.RFC3339Nano),
+		UtcOffset:    h.UTCOffset,
+		Abreviacao:   h.Abreviacao,
+		HorarioVerao: h.HorarioVerao,
+	}
+}
+
+// ParaValidacaoEndereco converte a validação de um endereço, com o estado e a cidade esperados.
+func ParaValidacaoEndereco(v domain.ValidacaoEndereco) *ibgev1.ValidacaoEndereco {
+	mensagem := &ibgev1.ValidacaoEndereco{
+		Cep:             v.CEP,
+		Valido:          v.Valido,
+		UfConfere:       v.UFConfere,
+		CidadeConfere:   v.CidadeConfere,
+		Inconsistencias: v.Mensagens,
+	}
+	if v.EstadoEsperado != nil {
+		mensagem.EstadoEsperado = ParaEstado(*v.EstadoEsperado)
+	}
+	if v.CidadeEsperada != nil {
+		mensagem.CidadeEsperada = ParaCidade(*v.CidadeEsperada)
+	}
+	return mensagem
+}
+
+// ParaFeedAlteracoes converte o feed de sincronização, com os campos alterados de cada registro.
+func ParaFeedAlteracoes(f domain.FeedAlteracoes) *ibgev1.FeedAlteracoes {
+	alteracoes := make([]*ibgev1.Alteracao, 0, len(f.Alteracoes))
+	for _, a := range f.Alteracoes {
+		alteracao := &ibgev1.Alteracao{
+			Versao:     int32(a.Versao),
+			Entidade:   string(a.Entidade),
+			Tipo:       string(a.Tipo),
+			CodigoIbge: int32(a.CodigoIBGE),
+			Nome:       a.Nome,
+			Uf:         a.UF,
+		}
+		for _, c := range a.Campos {
+			alteracao.Campos = append(alteracao.Campos, &ibgev1.DiferencaCampo{Campo: c.Campo, Antes: c.Antes, Depois: c.Depois})
+		}
+		alteracoes = append(alteracoes, alteracao)
+	}
+	return &ibgev1.FeedAlteracoes{Desde: int32(f.Desde), VersaoAtual: int32(f.VersaoAtual), Alteracoes: alteracoes}
+}
@@ -2,8 +2,10 @@ package memory
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/brauliohms/ibge-service/internal/domain"
 )
@@ -13,17 +15,33 @@ type SourceRepository interface {
 	FindAllEstados() ([]domain.Estado, error)
 	// Adicionamos um método auxiliar para carregar todas as cidades de forma eficiente.
 	FindAllCidades() ([]domain.Cidade, map[string][]domain.Cidade, error)
+	FindAllFaixasCEP() ([]domain.FaixaCEP, error)
+	FindAllFeriados() ([]domain.RegraFeriado, error)
+	FindAllClassificacoes() ([]domain.ClassificacaoMunicipio, error)
+	FindAllAliases() (map[int][]string, error)
+	FindAllEventosMunicipios() ([]domain.EventoMunicipio, error)
+	FindAllAlteracoes() ([]domain.Alteracao, error)
+	FindVersaoDataset() (int, error)
+	FindAtualizadoEm() (time.Time, error)
 }
 
 // MemoryRepository implementa a interface IBGERepository e armazena os dados em memória.
 type MemoryRepository struct {
 	estados                   []domain.Estado
+	cidades                   []domain.Cidade // Todas as cidades, na ordem da fonte (UF, nome)
 	estadosByUF               map[string]domain.Estado
 	estadosByCodigoIbge       map[string]domain.Estado
 	cidadesByEstadoUF         map[string][]domain.Cidade
 	cidadesByEstadoCodigoIbge map[string][]domain.Cidade // Agora será indexado por código IBGE
 	cidadesByCodigo           map[string]domain.Cidade
 	cidadesByCodigoTOM        map[string]domain.Cidade
+	faixasCEPCidades          []domain.FaixaCEP // Ordenadas por CEP inicial para busca binária
+	faixasCEPEstados          []domain.FaixaCEP // Ordenadas por CEP inicial para busca binária
+	cidadesExtintas           []domain.Cidade   // Municípios extintos, que não constam das cidades atuais
+	cidadesExtintasByCodigo   map[string]domain.Cidade
+	alteracoes                []domain.Alteracao // Log de alterações do seed, em ordem de versão
+	versaoDataset             int
+	atualizadoEm              time.Time // Data da última execução do seed
 }
 
 // NewMemoryRepository cria e inicializa o repositório em memória, carregando dados da fonte.
@@ -38,6 +56,87 @@ func NewMemoryRepository(source SourceRepository) (*MemoryRepository, error) {
 		return nil, fmt.Errorf("falha ao carregar cidades: %w", err)
 	}
 
+	faixasCEP, err := source.FindAllFaixasCEP()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar faixas de CEP: %w", err)
+	}
+
+	feriados, err := source.FindAllFeriados()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar feriados: %w", err)
+	}
+
+	classificacoes, err := source.FindAllClassificacoes()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar classificações territoriais: %w", err)
+	}
+
+	aliases, err := source.FindAllAliases()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar aliases: %w", err)
+	}
+
+	eventos, err := source.FindAllEventosMunicipios()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar histórico de municípios: %w", err)
+	}
+
+	alteracoes, err := source.FindAllAlteracoes()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar alterações: %w", err)
+	}
+
+	versaoDataset, err := source.FindVersaoDataset()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar a versão do conjunto de dados: %w", err)
+	}
+
+	atualizadoEm, err := source.FindAtualizadoEm()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar a data de atualização do conjunto de dados: %w", err)
+	}
+
+	// Anexar feriados, classificações e aliases antes de montar os índices,
+	// pois os índices guardam cópias dos estados e cidades.
+	feriadosByUF := make(map[string][]domain.RegraFeriado)
+	feriadosByCidade := make(map[int][]domain.RegraFeriado)
+	for _, f := range feriados {
+		if f.CodigoIBGE == 0 {
+			feriadosByUF[strings.ToUpper(f.UF)] = append(feriadosByUF[strings.ToUpper(f.UF)], f)
+		} else {
+			feriadosByCidade[f.CodigoIBGE] = append(feriadosByCidade[f.CodigoIBGE], f)
+		}
+	}
+	for i := range estados {
+		estados[i].Feriados = feriadosByUF[strings.ToUpper(estados[i].Sigla)]
+	}
+	classificacoesByCidade := make(map[int][]domain.ClassificacaoMunicipio)
+	for _, c := range classificacoes {
+		classificacoesByCidade[c.CodigoIBGE] = append(classificacoesByCidade[c.CodigoIBGE], c)
+	}
+	classificacoesDisponiveis := domain.ClassificacoesCarregadas(classificacoes)
+	historicoByCidade := make(map[int][]domain.EventoMunicipio)
+	for _, e := range eventos {
+		historicoByCidade[e.CodigoIBGE] = append(historicoByCidade[e.CodigoIBGE], e)
+	}
+	for _, historico := range historicoByCidade {
+		domain.OrdenarEventos(historico)
+	}
+	enriquecer := func(cidade *domain.Cidade) {
+		cidade.Feriados = feriadosByCidade[cidade.CodigoIBGE]
+		cidade.Historico = historicoByCidade[cidade.CodigoIBGE]
+		cidade.Aliases = aliases[cidade.CodigoIBGE]
+		cidade.Classificar(classificacoesDisponiveis, classificacoesByCidade[cidade.CodigoIBGE])
+	}
+	for i := range todasCidades {
+		enriquecer(&todasCidades[i])
+	}
+	for _, cidades := range cidadesMapByUF {
+		for i := range cidades {
+			enriquecer(&cidades[i])
+		}
+	}
+
 	estadosByUF := make(map[string]domain.Estado)
 	for _, e := range estados {
 		estadosByUF[strings.ToUpper(e.Sigla)] = e
@@ -70,17 +169,87 @@ func NewMemoryRepository(source SourceRepository) (*MemoryRepository, error) {
 		cidadesByCodigoTOM[cidade.CodigoTOM] = cidade
 	}
 
+	// Municípios com histórico que não constam das cidades atuais e cujo último evento é a extinção
+	// são reconstruídos a partir dos próprios eventos.
+	var cidadesExtintas []domain.Cidade
+	cidadesExtintasByCodigo := make(map[string]domain.Cidade)
+	for codigo, historico := range historicoByCidade {
+		if _, ativa := cidadesByCodigo[strconv.Itoa(codigo)]; ativa {
+			continue
+		}
+		cidade := domain.Cidade{CodigoIBGE: codigo, EstadoCodigoIBGE: codigo / 100000, Historico: historico, Aliases: aliases[codigo]}
+		if _, extinta := cidade.Extincao(); !extinta {
+			continue
+		}
+		for _, e := range historico {
+			if e.Nome != "" {
+				cidade.Nome = e.Nome
+			}
+			if e.UF != "" {
+				cidade.EstadoSigla = strings.ToUpper(e.UF)
+			}
+		}
+		if estado, found := estadosByCodigoIbge[strconv.Itoa(cidade.EstadoCodigoIBGE)]; found {
+			cidade.EstadoSigla = estado.Sigla
+			cidade.EstadoNome = estado.Nome
+		}
+		cidadesExtintas = append(cidadesExtintas, cidade)
+		cidadesExtintasByCodigo[strconv.Itoa(codigo)] = cidade
+	}
+	sort.Slice(cidadesExtintas, func(i, j int) bool {
+		return cidadesExtintas[i].CodigoIBGE < cidadesExtintas[j].CodigoIBGE
+	})
+
+	// Separar faixas estaduais e municipais, mantendo cada lista ordenada
+	var faixasCEPCidades, faixasCEPEstados []domain.FaixaCEP
+	for _, faixa := range faixasCEP {
+		if faixa.CodigoIBGE == 0 {
+			faixasCEPEstados = append(faixasCEPEstados, faixa)
+		} else {
+			faixasCEPCidades = append(faixasCEPCidades, faixa)
+		}
+	}
+	sortFaixasCEP(faixasCEPCidades)
+	sortFaixasCEP(faixasCEPEstados)
+
 	return &MemoryRepository{
 		estados:                   estados,
+		cidades:                   todasCidades,
 		estadosByUF:               estadosByUF,
 		estadosByCodigoIbge:       estadosByCodigoIbge,
 		cidadesByEstadoUF:         cidadesMapByUF,
 		cidadesByEstadoCodigoIbge: cidadesByEstadoCodigoIbge,
 		cidadesByCodigo:           cidadesByCodigo,
 		cidadesByCodigoTOM:        cidadesByCodigoTOM,
+		faixasCEPCidades:          faixasCEPCidades,
+		faixasCEPEstados:          faixasCEPEstados,
+		cidadesExtintas:           cidadesExtintas,
+		cidadesExtintasByCodigo:   cidadesExtintasByCodigo,
+		alteracoes:                alteracoes,
+		versaoDataset:             versaoDataset,
+		atualizadoEm:              atualizadoEm,
 	}, nil
 }
 
+// sortFaixasCEP ordena as faixas pelo CEP inicial.
+func sortFaixasCEP(faixas []domain.FaixaCEP) {
+	sort.Slice(faixas, func(i, j int) bool {
+		return faixas[i].CEPInicial < faixas[j].CEPInicial
+	})
+}
+
+// findFaixaCEP faz uma busca binária pela faixa que contém o CEP.
+// As faixas não se sobrepõem, então basta encontrar a primeira cujo CEP final é >= cep.
+func findFaixaCEP(faixas []domain.FaixaCEP, cep string) (domain.FaixaCEP, bool) {
+	i := sort.Search(len(faixas), func(i int) bool {
+		return faixas[i].CEPFinal >= cep
+	})
+	if i < len(faixas) && faixas[i].Contem(cep) {
+		return faixas[i], true
+	}
+	return domain.FaixaCEP{}, false
+}
+
 func (r *MemoryRepository) FindAllEstados() ([]domain.Estado, error) {
 	return r.estados, nil
 }
@@ -88,7 +257,7 @@ func (r *MemoryRepository) FindAllEstados() ([]domain.Estado, error) {
 func (r *MemoryRepository) FindEstadoByUF(uf string) (*domain.Estado, error) {
 	estado, found := r.estadosByUF[strings.ToUpper(uf)]
 	if !found {
-		return nil, fmt.Errorf("estado com a sigla %s não encontrado", uf)
+		return nil, domain.NaoEncontrado(domain.MsgEstadoSiglaNaoEncontrado, uf)
 	}
 	return &estado, nil
 }
@@ -96,7 +265,7 @@ func (r *MemoryRepository) FindEstadoByUF(uf string) (*domain.Estado, error) {
 func (r *MemoryRepository) FindEstadoByCodigoIbge(codigo_ibge string) (*domain.Estado, error) {
 	estado, found := r.estadosByCodigoIbge[codigo_ibge]
 	if !found {
-		return nil, fmt.Errorf("estado com o código ibge %s não encontrado", codigo_ibge)
+		return nil, domain.NaoEncontrado(domain.MsgEstadoCodigoNaoEncontrado, codigo_ibge)
 	}
 	return &estado, nil
 }
@@ -106,7 +275,7 @@ func (r *MemoryRepository) FindCidadesByEstadoUF(uf string) ([]domain.Cidade, er
 	if !found {
 		// Verificamos primeiro se o estado existe para dar uma mensagem de erro melhor.
 		if _, stateExists := r.estadosByUF[strings.ToUpper(uf)]; !stateExists {
-			return nil, fmt.Errorf("estado com a sigla %s não encontrado", uf)
+			return nil, domain.NaoEncontrado(domain.MsgEstadoSiglaNaoEncontrado, uf)
 		}
 		// O estado existe, mas não tem cidades (cenário improvável, mas possível).
 		return []domain.Cidade{}, nil
@@ -117,14 +286,14 @@ func (r *MemoryRepository) FindCidadesByEstadoUF(uf string) ([]domain.Cidade, er
 func (r *MemoryRepository) FindCidadesByEstadoCodigoIbge(codigo_ibge string) ([]domain.Cidade, error) {
 	// Validar se o código é um número válido
 	if _, err := strconv.Atoi(codigo_ibge); err != nil {
-		return nil, fmt.Errorf("código IBGE inválido: %s deve ser um número", codigo_ibge)
+		return nil, domain.CodigoInvalido(domain.MsgCodigoIBGEInvalido, codigo_ibge)
 	}
 
 	cidades, found := r.cidadesByEstadoCodigoIbge[codigo_ibge]
 	if !found {
 		// Verificamos primeiro se o estado existe para dar uma mensagem de erro melhor.
 		if _, stateExists := r.estadosByCodigoIbge[codigo_ibge]; !stateExists {
-			return nil, fmt.Errorf("estado com o código ibge %s não encontrado", codigo_ibge)
+			return nil, domain.NaoEncontrado(domain.MsgEstadoCodigoNaoEncontrado, codigo_ibge)
 		}
 		// O estado existe, mas não tem cidades (cenário improvável, mas possível).
 		return []domain.Cidade{}, nil
@@ -136,28 +305,165 @@ func (r *MemoryRepository) FindCidadesByEstadoCodigoIbge(codigo_ibge string) ([]
 func (r *MemoryRepository) FindCidadeByCodigo(codigo_ibge string) (*domain.Cidade, error) {
 	// Validar se o código é um número válido
 	if _, err := strconv.Atoi(codigo_ibge); err != nil {
-		return nil, fmt.Errorf("código IBGE inválido: %s deve ser um número", codigo_ibge)
+		return nil, domain.CodigoInvalido(domain.MsgCodigoIBGEInvalido, codigo_ibge)
 	}
 
 	cidade, found := r.cidadesByCodigo[codigo_ibge]
 	if !found {
-		return nil, fmt.Errorf("cidade com código IBGE %s não encontrada", codigo_ibge)
+		if extinta, found := r.cidadesExtintasByCodigo[codigo_ibge]; found {
+			return nil, r.erroCidadeExtinta(extinta)
+		}
+		return nil, domain.NaoEncontrado(domain.MsgCidadeNaoEncontrada, codigo_ibge)
 	}
 
 	return &cidade, nil
 }
 
+// erroCidadeExtinta monta o erro de município extinto, resolvendo os seus sucessores atuais.
+func (r *MemoryRepository) erroCidadeExtinta(extinta domain.Cidade) error {
+	extincao, _ := extinta.Extincao()
+	erro := &domain.CidadeExtintaError{Cidade: extinta, Data: extincao.Data}
+	for _, codigo := range extincao.Sucessores {
+		if sucessora, found := r.cidadesByCodigo[strconv.Itoa(codigo)]; found {
+			erro.Sucessores = append(erro.Sucessores, sucessora)
+		}
+	}
+	return erro
+}
+
+// FindCidadesExtintas retorna os municípios extintos, ordenados por código IBGE.
+// Eles não constam das demais buscas e servem às consultas da situação territorial em datas passadas.
+func (r *MemoryRepository) FindCidadesExtintas() ([]domain.Cidade, error) {
+	return r.cidadesExtintas, nil
+}
+
 // FindCidadeByCodigoTOM busca uma cidade pelo seu código TOM
 func (r *MemoryRepository) FindCidadeByCodigoTOM(codigo_tom string) (*domain.Cidade, error) {
 	// Validar se o código é um número válido
 	if _, err := strconv.Atoi(codigo_tom); err != nil {
-		return nil, fmt.Errorf("código TOM inválido: %s deve ser um número", codigo_tom)
+		return nil, domain.CodigoInvalido(domain.MsgCodigoTOMInvalido, codigo_tom)
 	}
 
 	cidade, found := r.cidadesByCodigoTOM[codigo_tom]
 	if !found {
-		return nil, fmt.Errorf("cidade com código TOM %s não encontrada", codigo_tom)
+		return nil, domain.NaoEncontrado(domain.MsgCidadeTOMNaoEncontrada, codigo_tom)
 	}
 
 	return &cidade, nil
 }
+
+// FindCidadeByCEP busca a cidade cuja faixa de CEP contém o CEP informado (8 dígitos, sem máscara)
+func (r *MemoryRepository) FindCidadeByCEP(cep string) (*domain.Cidade, error) {
+	faixa, found := findFaixaCEP(r.faixasCEPCidades, cep)
+	if !found {
+		return nil, domain.NaoEncontrado(domain.MsgCidadeCEPNaoEncontrada, cep)
+	}
+
+	cidade, found := r.cidadesByCodigo[strconv.Itoa(faixa.CodigoIBGE)]
+	if !found {
+		return nil, domain.NaoEncontrado(domain.MsgCidadeNaoEncontrada, faixa.CodigoIBGE)
+	}
+
+	return &cidade, nil
+}
+
+// FindEstadoByCEP busca o estado cuja faixa de CEP contém o CEP informado (8 dígitos, sem máscara)
+func (r *MemoryRepository) FindEstadoByCEP(cep string) (*domain.Estado, error) {
+	faixa, found := findFaixaCEP(r.faixasCEPEstados, cep)
+	if !found {
+		return nil, domain.NaoEncontrado(domain.MsgEstadoCEPNaoEncontrado, cep)
+	}
+
+	estado, found := r.estadosByUF[strings.ToUpper(faixa.UF)]
+	if !found {
+		return nil, domain.NaoEncontrado(domain.MsgEstadoSiglaNaoEncontrado, faixa.UF)
+	}
+
+	return &estado, nil
+}
+
+// FindAllFaixasCEP retorna as faixas de CEP estaduais seguidas das municipais, cada grupo
+// ordenado pelo CEP inicial.
+func (r *MemoryRepository) FindAllFaixasCEP() ([]domain.FaixaCEP, error) {
+	faixas := make([]domain.FaixaCEP, 0, len(r.faixasCEPEstados)+len(r.faixasCEPCidades))
+	faixas = append(faixas, r.faixasCEPEstados...)
+	return append(faixas, r.faixasCEPCidades...), nil
+}
+
+// FindCidadesByNome busca cidades cujo nome oficial ou nome alternativo contém o termo informado,
+// sem diferenciar acentos, caixa e pontuação. Correspondências exatas vêm primeiro, seguidas
+// das que começam com o termo. Se a UF for informada, apenas cidades desse estado são consideradas.
+func (r *MemoryRepository) FindCidadesByNome(nome string, uf string) ([]domain.Cidade, error) {
+	termo := domain.NormalizarNome(nome)
+	if termo == "" {
+		return nil, domain.ArgumentoInvalido(domain.MsgNomeCidadeInvalido, nome)
+	}
+
+	// Agrupar por relevância: 0 = exata, 1 = prefixo, 2 = contém
+	grupos := make([][]domain.Cidade, 3)
+	for _, cidade := range r.cidades {
+		if uf != "" && !strings.EqualFold(cidade.EstadoSigla, uf) {
+			continue
+		}
+		melhor := -1
+		for _, conhecido := range cidade.NomesConhecidos() {
+			normalizado := domain.NormalizarNome(conhecido)
+			relevancia := -1
+			switch {
+			case normalizado == termo:
+				relevancia = 0
+			case strings.HasPrefix(normalizado, termo):
+				relevancia = 1
+			case strings.Contains(normalizado, termo):
+				relevancia = 2
+			}
+			if relevancia >= 0 && (melhor < 0 || relevancia < melhor) {
+				melhor = relevancia
+			}
+		}
+		if melhor >= 0 {
+			grupos[melhor] = append(grupos[melhor], cidade)
+		}
+	}
+
+	cidades := []domain.Cidade{}
+	for _, grupo := range grupos {
+		cidades = append(cidades, grupo...)
+	}
+	return cidades, nil
+}
+
+// FindEstados retorna os estados que satisfazem a consulta.
+func (r *MemoryRepository) FindEstados(consulta domain.ConsultaEstados) ([]domain.Estado, error) {
+	return consulta.Filtrar(r.estados), nil
+}
+
+// FindCidades retorna as cidades que satisfazem a consulta. Com o estado informado, apenas as
+// cidades dele são percorridas; sem ordenação, a ordem é a da fonte (UF, nome).
+func (r *MemoryRepository) FindCidades(consulta domain.ConsultaCidades) ([]domain.Cidade, error) {
+	cidades := r.cidades
+	if consulta.EstadoCodigoIBGE != 0 {
+		cidades = r.cidadesByEstadoCodigoIbge[strconv.Itoa(consulta.EstadoCodigoIBGE)]
+		consulta.EstadoCodigoIBGE = 0 // O índice já selecionou as cidades do estado
+	}
+	return consulta.Filtrar(cidades), nil
+}
+
+// FindAlteracoesDesde retorna as alterações registradas em versões posteriores à informada.
+// Como o log está ordenado por versão, basta uma busca binária pela primeira alteração seguinte.
+func (r *MemoryRepository) FindAlteracoesDesde(versao int) ([]domain.Alteracao, error) {
+	i := sort.Search(len(r.alteracoes), func(i int) bool {
+		return r.alteracoes[i].Versao > versao
+	})
+	return r.alteracoes[i:], nil
+}
+
+// FindVersaoDataset retorna a versão atual do conjunto de dados.
+func (r *MemoryRepository) FindVersaoDataset() (int, error) {
+	return r.versaoDataset, nil
+}
+
+// FindAtualizadoEm retorna a data da última execução do seed (zero se a fonte não a informar).
+func (r *MemoryRepository) FindAtualizadoEm() (time.Time, error) {
+	return r.atualizadoEm, nil
+}
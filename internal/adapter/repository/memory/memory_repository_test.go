@@ -1,8 +1,10 @@
 package memory
 
 import (
+	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/brauliohms/ibge-service/internal/domain"
 )
@@ -22,7 +24,68 @@ func (m *mockSourceRepository) FindAllCidades() ([]domain.Cidade, map[string][]d
 		"EA": {{CodigoIBGE: 101, Nome: "Cidade A1"}},
 		"EB": {{CodigoIBGE: 201, Nome: "Cidade B1"}, {CodigoIBGE: 202, Nome: "Cidade B2"}},
 	}
-	return nil, cidadesMap, nil // O primeiro retorno não é usado no construtor, podemos deixar nil.
+	var todas []domain.Cidade
+	for _, uf := range []string{"EA", "EB"} {
+		todas = append(todas, cidadesMap[uf]...)
+	}
+	return todas, cidadesMap, nil
+}
+
+func (m *mockSourceRepository) FindAllFaixasCEP() ([]domain.FaixaCEP, error) {
+	// Propositalmente fora de ordem para validar a ordenação feita no construtor.
+	return []domain.FaixaCEP{
+		{UF: "EB", CodigoIBGE: 202, CEPInicial: "20500000", CEPFinal: "20599999"},
+		{UF: "EA", CEPInicial: "10000000", CEPFinal: "19999999"},
+		{UF: "EB", CEPInicial: "20000000", CEPFinal: "29999999"},
+		{UF: "EA", CodigoIBGE: 101, CEPInicial: "10000000", CEPFinal: "10099999"},
+		{UF: "EB", CodigoIBGE: 201, CEPInicial: "20000000", CEPFinal: "20099999"},
+	}, nil
+}
+
+func (m *mockSourceRepository) FindAllFeriados() ([]domain.RegraFeriado, error) {
+	return []domain.RegraFeriado{
+		{Nome: "Data Magna A", Tipo: domain.FeriadoEstadual, Dia: 9, Mes: 7, UF: "EA"},
+		{Nome: "Padroeira B1", Tipo: domain.FeriadoMunicipal, Dia: 8, Mes: 12, UF: "EB", CodigoIBGE: 201},
+	}, nil
+}
+
+func (m *mockSourceRepository) FindAllClassificacoes() ([]domain.ClassificacaoMunicipio, error) {
+	return []domain.ClassificacaoMunicipio{
+		{CodigoIBGE: 202, Classificacao: domain.ClassificacaoFaixaFronteira, PaisesFronteira: []string{"Uruguai"}},
+		{CodigoIBGE: 202, Classificacao: domain.ClassificacaoZonaCosteira},
+	}, nil
+}
+
+func (m *mockSourceRepository) FindAllAliases() (map[int][]string, error) {
+	return map[int][]string{
+		201: {"Vila Velha do B"},
+	}, nil
+}
+
+func (m *mockSourceRepository) FindAllEventosMunicipios() ([]domain.EventoMunicipio, error) {
+	return []domain.EventoMunicipio{
+		{CodigoIBGE: 203, Tipo: domain.EventoExtincao, Data: "2001-01-01", Nome: "Cidade B3", UF: "EB", Sucessores: []int{201}},
+		{CodigoIBGE: 202, Tipo: domain.EventoDesmembramento, Data: "2013-01-01", Origem: []int{201}},
+		{CodigoIBGE: 203, Tipo: domain.EventoCriacao, Data: "1990-01-01", Nome: "Cidade B3", UF: "EB"},
+	}, nil
+}
+
+func (m *mockSourceRepository) FindAllAlteracoes() ([]domain.Alteracao, error) {
+	return []domain.Alteracao{
+		{Versao: 1, Entidade: domain.EntidadeCidade, Tipo: domain.Adicionado, CodigoIBGE: 201, Nome: "Cidade B1", UF: "EB"},
+		{Versao: 1, Entidade: domain.EntidadeCidade, Tipo: domain.Adicionado, CodigoIBGE: 202, Nome: "Cidade B", UF: "EB"},
+		{Versao: 2, Entidade: domain.EntidadeCidade, Tipo: domain.Alterado, CodigoIBGE: 202, Nome: "Cidade B2", UF: "EB", Campos: []domain.DiferencaCampo{
+			{Campo: "nome", Antes: "Cidade B", Depois: "Cidade B2"},
+		}},
+	}, nil
+}
+
+func (m *mockSourceRepository) FindVersaoDataset() (int, error) {
+	return 2, nil
+}
+
+func (m *mockSourceRepository) FindAtualizadoEm() (time.Time, error) {
+	return time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC), nil
 }
 
 func TestMemoryRepository(t *testing.T) {
@@ -32,10 +95,13 @@ func TestMemoryRepository(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Falha ao criar o repositório em memória: %v", err)
 	}
+	sim, nao := true, false
 
 	t.Run("deve encontrar um estado pela UF", func(t *testing.T) {
 		uf := "EA"
-		expected := &domain.Estado{CodigoIBGE: 1, Nome: "Estado A", Sigla: "EA"}
+		expected := &domain.Estado{CodigoIBGE: 1, Nome: "Estado A", Sigla: "EA", Feriados: []domain.RegraFeriado{
+			{Nome: "Data Magna A", Tipo: domain.FeriadoEstadual, Dia: 9, Mes: 7, UF: "EA"},
+		}}
 
 		got, err := repo.FindEstadoByUF(uf)
 
@@ -52,16 +118,29 @@ func TestMemoryRepository(t *testing.T) {
 
 		_, err := repo.FindEstadoByUF(uf)
 
-		if err == nil {
-			t.Errorf("Esperava um erro para UF inexistente, mas não recebi nenhum.")
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Esperava ErrNotFound para UF inexistente, mas recebi: %v", err)
+		}
+	})
+
+	t.Run("deve retornar ErrInvalidCode para códigos que não são números", func(t *testing.T) {
+		if _, err := repo.FindCidadeByCodigo("abc"); !errors.Is(err, domain.ErrInvalidCode) {
+			t.Errorf("Esperava ErrInvalidCode para o código IBGE, mas recebi: %v", err)
+		}
+		if _, err := repo.FindCidadeByCodigoTOM("7x"); !errors.Is(err, domain.ErrInvalidCode) {
+			t.Errorf("Esperava ErrInvalidCode para o código TOM, mas recebi: %v", err)
 		}
 	})
 
 	t.Run("deve encontrar cidades pela UF do estado", func(t *testing.T) {
 		uf := "EB"
 		expected := []domain.Cidade{
-			{CodigoIBGE: 201, Nome: "Cidade B1"},
-			{CodigoIBGE: 202, Nome: "Cidade B2"},
+			{CodigoIBGE: 201, Nome: "Cidade B1", Aliases: []string{"Vila Velha do B"}, FaixaFronteira: &nao, ZonaCosteira: &nao, Feriados: []domain.RegraFeriado{
+				{Nome: "Padroeira B1", Tipo: domain.FeriadoMunicipal, Dia: 8, Mes: 12, UF: "EB", CodigoIBGE: 201},
+			}},
+			{CodigoIBGE: 202, Nome: "Cidade B2", FaixaFronteira: &sim, PaisesFronteira: []string{"Uruguai"}, ZonaCosteira: &sim, Historico: []domain.EventoMunicipio{
+				{CodigoIBGE: 202, Tipo: domain.EventoDesmembramento, Data: "2013-01-01", Origem: []int{201}},
+			}},
 		}
 
 		got, err := repo.FindCidadesByEstadoUF(uf)
@@ -76,4 +155,192 @@ func TestMemoryRepository(t *testing.T) {
 			t.Errorf("Lista de cidades incorreta. got: %v, want: %v", got, expected)
 		}
 	})
+
+	t.Run("deve encontrar a cidade pela faixa de CEP", func(t *testing.T) {
+		testCases := []struct {
+			cep      string
+			expected int
+		}{
+			{"10000000", 101},
+			{"10099999", 101},
+			{"20050000", 201},
+			{"20512345", 202},
+		}
+
+		for _, tc := range testCases {
+			got, err := repo.FindCidadeByCEP(tc.cep)
+			if err != nil {
+				t.Errorf("Esperava não ter erro para o CEP %s, mas recebi: %v", tc.cep, err)
+				continue
+			}
+			if got.CodigoIBGE != tc.expected {
+				t.Errorf("Cidade incorreta para o CEP %s. got: %d, want: %d", tc.cep, got.CodigoIBGE, tc.expected)
+			}
+		}
+	})
+
+	t.Run("deve retornar erro para CEP fora das faixas municipais", func(t *testing.T) {
+		for _, cep := range []string{"10100000", "20100000", "09999999", "30000000"} {
+			if _, err := repo.FindCidadeByCEP(cep); err == nil {
+				t.Errorf("Esperava um erro para o CEP %s, mas não recebi nenhum.", cep)
+			}
+		}
+	})
+
+	t.Run("deve encontrar o estado pela faixa de CEP", func(t *testing.T) {
+		got, err := repo.FindEstadoByCEP("20100000")
+		if err != nil {
+			t.Fatalf("Esperava não ter erro, mas recebi: %v", err)
+		}
+		if got.Sigla != "EB" {
+			t.Errorf("Estado incorreto. got: %s, want: %s", got.Sigla, "EB")
+		}
+	})
+
+	t.Run("deve buscar cidades pelo nome e pelos aliases", func(t *testing.T) {
+		testCases := []struct {
+			nome     string
+			expected []int
+		}{
+			{"cidade b2", []int{202}},
+			{"CIDADE", []int{101, 201, 202}},
+			{"vila-velha", []int{201}},
+			{"Cidade B", []int{201, 202}},
+			{"inexistente", []int{}},
+		}
+
+		for _, tc := range testCases {
+			got, err := repo.FindCidadesByNome(tc.nome, "")
+			if err != nil {
+				t.Errorf("Esperava não ter erro para %q, mas recebi: %v", tc.nome, err)
+				continue
+			}
+			codigos := []int{}
+			for _, c := range got {
+				codigos = append(codigos, c.CodigoIBGE)
+			}
+			if !reflect.DeepEqual(codigos, tc.expected) {
+				t.Errorf("Cidades incorretas para %q. got: %v, want: %v", tc.nome, codigos, tc.expected)
+			}
+		}
+	})
+
+	t.Run("deve retornar os sucessores ao buscar um município extinto", func(t *testing.T) {
+		_, err := repo.FindCidadeByCodigo("203")
+
+		var extinta *domain.CidadeExtintaError
+		if !errors.As(err, &extinta) {
+			t.Fatalf("Esperava CidadeExtintaError, mas recebi: %v", err)
+		}
+		if !errors.Is(err, domain.ErrGone) {
+			t.Errorf("CidadeExtintaError deveria ser um ErrGone")
+		}
+		if extinta.Cidade.Nome != "Cidade B3" || extinta.Cidade.EstadoSigla != "EB" || extinta.Data != "2001-01-01" {
+			t.Errorf("Município extinto incorreto: %+v", extinta)
+		}
+		if len(extinta.Sucessores) != 1 || extinta.Sucessores[0].CodigoIBGE != 201 {
+			t.Errorf("Sucessores incorretos: %v", extinta.Sucessores)
+		}
+	})
+
+	t.Run("deve consultar a situação das cidades em datas passadas", func(t *testing.T) {
+		extintas, err := repo.FindCidadesExtintas()
+		if err != nil {
+			t.Fatalf("Esperava não ter erro, mas recebi: %v", err)
+		}
+		if len(extintas) != 1 || extintas[0].CodigoIBGE != 203 {
+			t.Fatalf("Municípios extintos incorretos: %v", extintas)
+		}
+
+		cidades, _ := repo.FindCidadesByEstadoUF("EB")
+		testCases := []struct {
+			data    string
+			codigo  int
+			existia bool
+			cidade  domain.Cidade
+		}{
+			{"1985-06-01", 203, false, extintas[0]},
+			{"2000-12-31", 203, true, extintas[0]},
+			{"2001-01-01", 203, false, extintas[0]},
+			{"2012-12-31", 202, false, cidades[1]},
+			{"2013-01-01", 202, true, cidades[1]},
+			{"1900-01-01", 201, true, cidades[0]},
+		}
+
+		for _, tc := range testCases {
+			if got := tc.cidade.ExistiaEm(tc.data); got != tc.existia {
+				t.Errorf("Existência de %d em %s incorreta. got: %v, want: %v", tc.codigo, tc.data, got, tc.existia)
+			}
+		}
+	})
+
+	t.Run("deve retornar as alterações posteriores à versão", func(t *testing.T) {
+		testCases := []struct {
+			desde    int
+			expected int
+		}{
+			{0, 3},
+			{1, 1},
+			{2, 0},
+		}
+
+		for _, tc := range testCases {
+			got, err := repo.FindAlteracoesDesde(tc.desde)
+			if err != nil {
+				t.Errorf("Esperava não ter erro para a versão %d, mas recebi: %v", tc.desde, err)
+				continue
+			}
+			if len(got) != tc.expected {
+				t.Errorf("Número de alterações desde a versão %d incorreto. got: %d, want: %d", tc.desde, len(got), tc.expected)
+			}
+			for _, a := range got {
+				if a.Versao <= tc.desde {
+					t.Errorf("Alteração da versão %d retornada para desde=%d", a.Versao, tc.desde)
+				}
+			}
+		}
+
+		versao, _ := repo.FindVersaoDataset()
+		if versao != 2 {
+			t.Errorf("Versão do conjunto de dados incorreta. got: %d, want: 2", versao)
+		}
+		atualizadoEm, _ := repo.FindAtualizadoEm()
+		if want := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC); !atualizadoEm.Equal(want) {
+			t.Errorf("Data de atualização incorreta. got: %v, want: %v", atualizadoEm, want)
+		}
+	})
+
+	t.Run("deve consultar cidades com filtros e ordenação", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			consulta domain.ConsultaCidades
+			expected []int
+		}{
+			{"sem filtros, na ordem da fonte", domain.ConsultaCidades{}, []int{101, 201, 202}},
+			{"por estado", domain.ConsultaCidades{EstadoCodigoIBGE: 2}, []int{201, 202}},
+			{"por nome", domain.ConsultaCidades{NomeContem: "cidade b"}, []int{201, 202}},
+			{"ordem decrescente", domain.ConsultaCidades{Ordenacao: []domain.Ordenacao{{Campo: "codigo_ibge", Decrescente: true}}}, []int{202, 201, 101}},
+			{"estado inexistente", domain.ConsultaCidades{EstadoCodigoIBGE: 9}, []int{}},
+		}
+
+		for _, tc := range testCases {
+			got, err := repo.FindCidades(tc.consulta)
+			if err != nil {
+				t.Errorf("%s: esperava não ter erro, mas recebi: %v", tc.name, err)
+				continue
+			}
+			codigos := []int{}
+			for _, c := range got {
+				codigos = append(codigos, c.CodigoIBGE)
+			}
+			if !reflect.DeepEqual(codigos, tc.expected) {
+				t.Errorf("%s: cidades incorretas. got: %v, want: %v", tc.name, codigos, tc.expected)
+			}
+		}
+
+		estados, _ := repo.FindEstados(domain.ConsultaEstados{NomeContem: "b"})
+		if len(estados) != 1 || estados[0].Sigla != "EB" {
+			t.Errorf("Estados incorretos. got: %v", estados)
+		}
+	})
 }
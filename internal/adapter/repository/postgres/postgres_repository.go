@@ -2,7 +2,10 @@ package postgres
 
 import (
 	"database/sql"
+	"encoding/json"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/brauliohms/ibge-service/internal/domain"
 	_ "github.com/lib/pq" // Driver do PostgreSQL
@@ -26,7 +29,7 @@ func NewPostgresRepository(dataSourceName string) (*PostgresRepository, error) {
 // FindAllEstados busca todos os estados no banco de dados PostgreSQL.
 // Atenção: este repositório é usado apenas para a carga inicial.
 func (r *PostgresRepository) FindAllEstados() ([]domain.Estado, error) {
-	rows, err := r.db.Query("SELECT codigo_ibge, nome, sigla FROM estados ORDER BY nome")
+	rows, err := r.db.Query("SELECT codigo_ibge, nome, sigla, COALESCE(fuso_horario, '') FROM estados ORDER BY nome")
 	if err != nil {
 		return nil, err
 	}
@@ -35,7 +38,7 @@ func (r *PostgresRepository) FindAllEstados() ([]domain.Estado, error) {
 	var estados []domain.Estado
 	for rows.Next() {
 		var e domain.Estado
-		if err := rows.Scan(&e.CodigoIBGE, &e.Nome, &e.Sigla); err != nil {
+		if err := rows.Scan(&e.CodigoIBGE, &e.Nome, &e.Sigla, &e.FusoHorario); err != nil {
 			return nil, err
 		}
 		estados = append(estados, e)
@@ -43,16 +46,27 @@ func (r *PostgresRepository) FindAllEstados() ([]domain.Estado, error) {
 	return estados, nil
 }
 
-// FindEstadoByUF é implementado para satisfazer a interface, mas não será usado em produção (o de memória será).
+// FindEstadoByUF busca um estado pela sigla no PostgreSQL, sem diferenciar caixa.
 func (r *PostgresRepository) FindEstadoByUF(uf string) (*domain.Estado, error) {
-	// Implementação omitida para brevidade, pois o repositório em memória será o principal.
-	return nil, nil
+	var e domain.Estado
+	err := r.db.QueryRow("SELECT codigo_ibge, nome, sigla, COALESCE(fuso_horario, '') FROM estados WHERE sigla = $1", strings.ToUpper(uf)).
+		Scan(&e.CodigoIBGE, &e.Nome, &e.Sigla, &e.FusoHorario)
+	if err == sql.ErrNoRows {
+		return nil, domain.NaoEncontrado(domain.MsgEstadoSiglaNaoEncontrado, uf)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
 }
 
-// FindCidadesByEstadoUF é implementado para satisfazer a interface, mas não será usado em produção.
+// FindCidadesByEstadoUF busca as cidades de um estado pela sigla no PostgreSQL, como FindCidades.
 func (r *PostgresRepository) FindCidadesByEstadoUF(uf string) ([]domain.Cidade, error) {
-	// Implementação omitida para brevidade.
-	return nil, nil
+	estado, err := r.FindEstadoByUF(uf)
+	if err != nil {
+		return nil, err
+	}
+	return r.FindCidades(domain.ConsultaCidades{EstadoCodigoIBGE: estado.CodigoIBGE})
 }
 
 // FindAllCidades - um método auxiliar para a carga inicial
@@ -61,42 +75,302 @@ func (r *PostgresRepository) FindAllCidades() ([]domain.Cidade, map[string][]dom
 	// 	SELECT c.codigo_ibge, c.nome, c.codigo_tom, c.micro_regiao, c.regiao_imediata, e.sigla
 	// 	FROM cidades c
 	// 	JOIN estados e ON c.estado_codigo_ibge = e.codigo_ibge`
-	query := `
+	query := selectCidades + "\n\t\tORDER BY e.sigla, c.nome, c.codigo_ibge"
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	allCidades := []domain.Cidade{}
+	cidadesPorEstado := make(map[string][]domain.Cidade)
+
+	for rows.Next() {
+		c, err := lerCidade(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		ucSigla := strings.ToUpper(c.EstadoSigla)
+		allCidades = append(allCidades, c)
+		cidadesPorEstado[ucSigla] = append(cidadesPorEstado[ucSigla], c)
+	}
+	return allCidades, cidadesPorEstado, nil
+}
+
+// selectCidades busca as cidades com os dados do estado; as consultas acrescentam WHERE e ORDER BY.
+const selectCidades = `
 		SELECT 
 			c.codigo_ibge, 
 			c.nome, 
 			COALESCE(c.codigo_tom, '') as codigo_tom,
 			COALESCE(c.micro_regiao, '') as micro_regiao,
 			COALESCE(c.regiao_imediata, '') as regiao_imediata,
+			COALESCE(c.fuso_horario, '') as fuso_horario,
+			COALESCE(c.gentilico, '') as gentilico,
 			e.sigla,
 			e.nome,
 			e.codigo_ibge
 		FROM cidades c
-		INNER JOIN estados e ON c.estado_codigo_ibge = e.codigo_ibge
-		ORDER BY e.sigla, c.nome
-	`
-	rows, err := r.db.Query(query)
+		INNER JOIN estados e ON c.estado_codigo_ibge = e.codigo_ibge`
+
+// lerCidade lê uma linha de selectCidades.
+func lerCidade(rows *sql.Rows) (domain.Cidade, error) {
+	var c domain.Cidade
+	var codigoTom sql.NullString
+	if err := rows.Scan(&c.CodigoIBGE, &c.Nome, &codigoTom, &c.MicroRegiao, &c.RegiaoImediata, &c.FusoHorario, &c.Gentilico, &c.EstadoSigla, &c.EstadoNome, &c.EstadoCodigoIBGE); err != nil {
+		return c, err
+	}
+	if codigoTom.Valid {
+		c.CodigoTOM = codigoTom.String
+	}
+	return c, nil
+}
+
+// FindEstados busca os estados que satisfazem a consulta. A comparação de nomes sem acentos não
+// tem equivalente em SQL padrão, então o filtro e a ordenação seguem as regras do domínio.
+func (r *PostgresRepository) FindEstados(consulta domain.ConsultaEstados) ([]domain.Estado, error) {
+	estados, err := r.FindAllEstados()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+	return consulta.Filtrar(estados), nil
+}
+
+// FindCidades busca as cidades que satisfazem a consulta. Os filtros de estado, microrregião,
+// região imediata e código TOM vão para o WHERE; o filtro por nome e a ordenação,
+// que ignoram acentos, seguem as regras do domínio. Como na carga inicial, as cidades vêm sem
+// feriados, aliases, histórico e classificações.
+func (r *PostgresRepository) FindCidades(consulta domain.ConsultaCidades) ([]domain.Cidade, error) {
+	var condicoes []string
+	var args []interface{}
+	condicao := func(expressao string, valor interface{}) {
+		args = append(args, valor)
+		condicoes = append(condicoes, strings.Replace(expressao, "?", "$"+strconv.Itoa(len(args)), 1))
+	}
+	if consulta.EstadoCodigoIBGE != 0 {
+		condicao("c.estado_codigo_ibge = ?", consulta.EstadoCodigoIBGE)
+	}
+	if consulta.MicroRegiao != "" {
+		condicao("c.micro_regiao = ?", consulta.MicroRegiao)
+	}
+	if consulta.RegiaoImediata != "" {
+		condicao("c.regiao_imediata = ?", consulta.RegiaoImediata)
+	}
+	if consulta.TemCodigoTOM != nil {
+		if *consulta.TemCodigoTOM {
+			condicoes = append(condicoes, "c.codigo_tom IS NOT NULL")
+		} else {
+			condicoes = append(condicoes, "c.codigo_tom IS NULL")
+		}
+	}
+	query := selectCidades
+	if len(condicoes) > 0 {
+		query += "\n\t\tWHERE " + strings.Join(condicoes, " AND ")
+	}
+	rows, err := r.db.Query(query+"\n\t\tORDER BY e.sigla, c.nome, c.codigo_ibge", args...)
+	if err != nil {
+		return nil, err
 	}
 	defer rows.Close()
 
-	allCidades := []domain.Cidade{}
-	cidadesPorEstado := make(map[string][]domain.Cidade)
+	cidades := []domain.Cidade{}
+	for rows.Next() {
+		c, err := lerCidade(rows)
+		if err != nil {
+			return nil, err
+		}
+		cidades = append(cidades, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	restante := domain.ConsultaCidades{NomeContem: consulta.NomeContem, Ordenacao: consulta.Ordenacao}
+	return restante.Filtrar(cidades), nil
+}
 
+// FindAllFaixasCEP busca todas as faixas de CEP (estaduais e municipais) no PostgreSQL.
+func (r *PostgresRepository) FindAllFaixasCEP() ([]domain.FaixaCEP, error) {
+	rows, err := r.db.Query("SELECT uf, codigo_ibge, cep_inicial, cep_final FROM faixas_cep ORDER BY cep_inicial")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var faixas []domain.FaixaCEP
 	for rows.Next() {
-		var c domain.Cidade
-		var codigoTom sql.NullString
-		// var estadoSigla string
-		if err := rows.Scan(&c.CodigoIBGE, &c.Nome, &c.CodigoTOM, &c.MicroRegiao, &c.RegiaoImediata, &c.EstadoSigla, &c.EstadoNome, &c.EstadoCodigoIBGE); err != nil {
-			return nil, nil, err
+		var f domain.FaixaCEP
+		if err := rows.Scan(&f.UF, &f.CodigoIBGE, &f.CEPInicial, &f.CEPFinal); err != nil {
+			return nil, err
+		}
+		faixas = append(faixas, f)
+	}
+	return faixas, nil
+}
+
+// FindAllFeriados busca as regras de feriados estaduais e municipais no PostgreSQL.
+func (r *PostgresRepository) FindAllFeriados() ([]domain.RegraFeriado, error) {
+	rows, err := r.db.Query("SELECT uf, codigo_ibge, nome, dia, mes, dias_apos_pascoa FROM feriados ORDER BY uf, codigo_ibge, mes, dia")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var feriados []domain.RegraFeriado
+	for rows.Next() {
+		var f domain.RegraFeriado
+		var dia, mes, diasAposPascoa sql.NullInt64
+		if err := rows.Scan(&f.UF, &f.CodigoIBGE, &f.Nome, &dia, &mes, &diasAposPascoa); err != nil {
+			return nil, err
 		}
-		if codigoTom.Valid {
-			c.CodigoTOM = codigoTom.String
+		f.Dia = int(dia.Int64)
+		f.Mes = int(mes.Int64)
+		if diasAposPascoa.Valid {
+			d := int(diasAposPascoa.Int64)
+			f.DiasAposPascoa = &d
 		}
-		ucSigla := strings.ToUpper(c.EstadoSigla)
-		allCidades = append(allCidades, c)
-		cidadesPorEstado[ucSigla] = append(cidadesPorEstado[ucSigla], c)
+		f.Tipo = domain.FeriadoEstadual
+		if f.CodigoIBGE != 0 {
+			f.Tipo = domain.FeriadoMunicipal
+		}
+		feriados = append(feriados, f)
 	}
-	return allCidades, cidadesPorEstado, nil
+	return feriados, nil
+}
+
+// FindAllClassificacoes busca as classificações territoriais dos municípios no PostgreSQL.
+func (r *PostgresRepository) FindAllClassificacoes() ([]domain.ClassificacaoMunicipio, error) {
+	rows, err := r.db.Query("SELECT codigo_ibge, classificacao, COALESCE(paises_fronteira, '') FROM classificacoes_territoriais")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var classificacoes []domain.ClassificacaoMunicipio
+	for rows.Next() {
+		var c domain.ClassificacaoMunicipio
+		var paises string
+		if err := rows.Scan(&c.CodigoIBGE, &c.Classificacao, &paises); err != nil {
+			return nil, err
+		}
+		if paises != "" {
+			c.PaisesFronteira = strings.Split(paises, ",")
+		}
+		classificacoes = append(classificacoes, c)
+	}
+	return classificacoes, nil
+}
+
+// FindAllAliases busca os nomes alternativos das cidades no PostgreSQL, indexados por código IBGE.
+func (r *PostgresRepository) FindAllAliases() (map[int][]string, error) {
+	rows, err := r.db.Query("SELECT codigo_ibge, alias FROM cidades_aliases ORDER BY codigo_ibge, alias")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	aliases := make(map[int][]string)
+	for rows.Next() {
+		var codigo int
+		var alias string
+		if err := rows.Scan(&codigo, &alias); err != nil {
+			return nil, err
+		}
+		aliases[codigo] = append(aliases[codigo], alias)
+	}
+	return aliases, nil
+}
+
+// FindAllEventosMunicipios busca o histórico de criações, renomeações e extinções de municípios no PostgreSQL.
+func (r *PostgresRepository) FindAllEventosMunicipios() ([]domain.EventoMunicipio, error) {
+	rows, err := r.db.Query(`SELECT codigo_ibge, tipo, data, COALESCE(nome, ''), COALESCE(nome_anterior, ''), COALESCE(uf, ''),
+		COALESCE(origem, ''), COALESCE(sucessores, '') FROM municipios_historico ORDER BY codigo_ibge, data`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var eventos []domain.EventoMunicipio
+	for rows.Next() {
+		var e domain.EventoMunicipio
+		var origem, sucessores string
+		if err := rows.Scan(&e.CodigoIBGE, &e.Tipo, &e.Data, &e.Nome, &e.NomeAnterior, &e.UF, &origem, &sucessores); err != nil {
+			return nil, err
+		}
+		if e.Origem, err = separarCodigos(origem); err != nil {
+			return nil, err
+		}
+		if e.Sucessores, err = separarCodigos(sucessores); err != nil {
+			return nil, err
+		}
+		eventos = append(eventos, e)
+	}
+	return eventos, nil
+}
+
+// FindAllAlteracoes busca o log de alterações registrado pelo seed no PostgreSQL, em ordem de versão.
+func (r *PostgresRepository) FindAllAlteracoes() ([]domain.Alteracao, error) {
+	rows, err := r.db.Query(`SELECT versao, entidade, tipo, codigo_ibge, nome, COALESCE(uf, ''), COALESCE(campos, '')
+		FROM alteracoes ORDER BY versao, sequencia`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var alteracoes []domain.Alteracao
+	for rows.Next() {
+		var a domain.Alteracao
+		var campos string
+		if err := rows.Scan(&a.Versao, &a.Entidade, &a.Tipo, &a.CodigoIBGE, &a.Nome, &a.UF, &campos); err != nil {
+			return nil, err
+		}
+		if campos != "" {
+			if err := json.Unmarshal([]byte(campos), &a.Campos); err != nil {
+				return nil, err
+			}
+		}
+		alteracoes = append(alteracoes, a)
+	}
+	return alteracoes, nil
+}
+
+// FindVersaoDataset busca a versão do conjunto de dados gravada pelo seed no PostgreSQL (zero se não houver).
+func (r *PostgresRepository) FindVersaoDataset() (int, error) {
+	var valor string
+	err := r.db.QueryRow("SELECT valor FROM metadados WHERE chave = $1", "versao_dataset").Scan(&valor)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(valor)
+}
+
+// FindAtualizadoEm busca a data da última execução do seed gravada no PostgreSQL (zero se não houver).
+func (r *PostgresRepository) FindAtualizadoEm() (time.Time, error) {
+	var valor string
+	err := r.db.QueryRow("SELECT valor FROM metadados WHERE chave = $1", "atualizado_em").Scan(&valor)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, valor)
+}
+
+// separarCodigos converte uma lista de códigos IBGE separados por vírgula.
+func separarCodigos(valor string) ([]int, error) {
+	if valor == "" {
+		return nil, nil
+	}
+	var codigos []int
+	for _, parte := range strings.Split(valor, ",") {
+		codigo, err := strconv.Atoi(strings.TrimSpace(parte))
+		if err != nil {
+			return nil, err
+		}
+		codigos = append(codigos, codigo)
+	}
+	return codigos, nil
 }
@@ -0,0 +1,176 @@
+// Package diff compara duas edições do conjunto de dados (bancos SQLite/PostgreSQL ou
+// diretórios JSON) e relata os estados e municípios adicionados, removidos e alterados.
+package diff
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// Fonte é qualquer origem capaz de listar estados e cidades, como os repositórios SQLite e PostgreSQL.
+type Fonte interface {
+	FindAllEstados() ([]domain.Estado, error)
+	FindAllCidades() ([]domain.Cidade, map[string][]domain.Cidade, error)
+	FindAllClassificacoes() ([]domain.ClassificacaoMunicipio, error)
+	FindAllAliases() (map[int][]string, error)
+}
+
+// Conjunto é uma edição do conjunto de dados carregada para comparação.
+type Conjunto struct {
+	Estados []domain.Estado
+	Cidades []domain.Cidade
+}
+
+// Carregar lê todos os estados e cidades de uma fonte, com os aliases e as classificações territoriais.
+func Carregar(fonte Fonte) (*Conjunto, error) {
+	estados, err := fonte.FindAllEstados()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar estados: %w", err)
+	}
+	cidades, _, err := fonte.FindAllCidades()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar cidades: %w", err)
+	}
+	classificacoes, err := fonte.FindAllClassificacoes()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar classificações territoriais: %w", err)
+	}
+	aliases, err := fonte.FindAllAliases()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar aliases: %w", err)
+	}
+	Enriquecer(cidades, aliases, classificacoes)
+	return &Conjunto{Estados: estados, Cidades: cidades}, nil
+}
+
+// Enriquecer preenche os aliases e as classificações territoriais das cidades, como o repositório
+// em memória faz ao servi-las, para que as duas edições sejam comparadas pelos mesmos valores.
+func Enriquecer(cidades []domain.Cidade, aliases map[int][]string, classificacoes []domain.ClassificacaoMunicipio) {
+	classificacoesByCidade := make(map[int][]domain.ClassificacaoMunicipio)
+	for _, c := range classificacoes {
+		classificacoesByCidade[c.CodigoIBGE] = append(classificacoesByCidade[c.CodigoIBGE], c)
+	}
+	disponiveis := domain.ClassificacoesCarregadas(classificacoes)
+	for i := range cidades {
+		cidades[i].Aliases = aliases[cidades[i].CodigoIBGE]
+		cidades[i].Classificar(disponiveis, classificacoesByCidade[cidades[i].CodigoIBGE])
+	}
+}
+
+// Relatorio reúne as alterações entre duas edições, com os estados antes dos municípios
+// e cada grupo ordenado por código IBGE.
+type Relatorio struct {
+	Alteracoes []domain.Alteracao `json:"alteracoes"`
+}
+
+// Contar retorna quantas alterações do tipo informado existem para a entidade.
+func (r *Relatorio) Contar(entidade domain.EntidadeAlterada, tipo domain.TipoAlteracao) int {
+	total := 0
+	for _, a := range r.Alteracoes {
+		if a.Entidade == entidade && a.Tipo == tipo {
+			total++
+		}
+	}
+	return total
+}
+
+// campo associa o nome de um campo comparado à função que extrai o seu valor.
+type campo[T any] struct {
+	nome  string
+	valor func(T) string
+}
+
+var camposEstado = []campo[domain.Estado]{
+	{"nome", func(e domain.Estado) string { return e.Nome }},
+	{"sigla", func(e domain.Estado) string { return e.Sigla }},
+	{"fuso_horario", func(e domain.Estado) string { return e.FusoHorario }},
+}
+
+var camposCidade = []campo[domain.Cidade]{
+	{"nome", func(c domain.Cidade) string { return c.Nome }},
+	{"gentilico", func(c domain.Cidade) string { return c.Gentilico }},
+	{"aliases", func(c domain.Cidade) string { return juntarOrdenados(c.Aliases) }},
+	{"codigo_tom", func(c domain.Cidade) string { return c.CodigoTOM }},
+	{"micro_regiao", func(c domain.Cidade) string { return c.MicroRegiao }},
+	{"regiao_imediata", func(c domain.Cidade) string { return c.RegiaoImediata }},
+	{"fuso_horario", func(c domain.Cidade) string { return c.FusoHorario }},
+	{"estado_sigla", func(c domain.Cidade) string { return c.EstadoSigla }},
+	{"amazonia_legal", func(c domain.Cidade) string { return formatarClassificacao(c.AmazoniaLegal) }},
+	{"semiarido", func(c domain.Cidade) string { return formatarClassificacao(c.Semiarido) }},
+	{"faixa_fronteira", func(c domain.Cidade) string { return formatarClassificacao(c.FaixaFronteira) }},
+	{"paises_fronteira", func(c domain.Cidade) string { return juntarOrdenados(c.PaisesFronteira) }},
+	{"zona_costeira", func(c domain.Cidade) string { return formatarClassificacao(c.ZonaCosteira) }},
+	{"matopiba", func(c domain.Cidade) string { return formatarClassificacao(c.Matopiba) }},
+}
+
+// juntarOrdenados serializa uma lista sem depender da ordem nem das repetições da fonte.
+func juntarOrdenados(valores []string) string {
+	return strings.Join(slices.Compact(slices.Sorted(slices.Values(valores))), ", ")
+}
+
+// formatarClassificacao retorna true ou false, ou vazio quando a classificação não está disponível.
+func formatarClassificacao(pertence *bool) string {
+	if pertence == nil {
+		return ""
+	}
+	return strconv.FormatBool(*pertence)
+}
+
+// Comparar relata as diferenças da edição antiga para a nova.
+func Comparar(antigo, novo *Conjunto) *Relatorio {
+	relatorio := &Relatorio{Alteracoes: []domain.Alteracao{}}
+	relatorio.Alteracoes = append(relatorio.Alteracoes, comparar(domain.EntidadeEstado, antigo.Estados, novo.Estados, camposEstado,
+		func(e domain.Estado) (int, string, string) { return e.CodigoIBGE, e.Nome, e.Sigla })...)
+	relatorio.Alteracoes = append(relatorio.Alteracoes, comparar(domain.EntidadeCidade, antigo.Cidades, novo.Cidades, camposCidade,
+		func(c domain.Cidade) (int, string, string) { return c.CodigoIBGE, c.Nome, c.EstadoSigla })...)
+	return relatorio
+}
+
+// comparar casa os registros das duas edições pelo código IBGE e compara os campos informados.
+func comparar[T any](entidade domain.EntidadeAlterada, antigos, novos []T, campos []campo[T], identificar func(T) (int, string, string)) []domain.Alteracao {
+	antigosByCodigo := make(map[int]T, len(antigos))
+	for _, a := range antigos {
+		codigo, _, _ := identificar(a)
+		antigosByCodigo[codigo] = a
+	}
+
+	var alteracoes []domain.Alteracao
+	novosByCodigo := make(map[int]bool, len(novos))
+	for _, n := range novos {
+		codigo, nome, uf := identificar(n)
+		novosByCodigo[codigo] = true
+
+		a, existia := antigosByCodigo[codigo]
+		if !existia {
+			alteracoes = append(alteracoes, domain.Alteracao{Entidade: entidade, Tipo: domain.Adicionado, CodigoIBGE: codigo, Nome: nome, UF: uf})
+			continue
+		}
+
+		var diferencas []domain.DiferencaCampo
+		for _, c := range campos {
+			if antes, depois := c.valor(a), c.valor(n); antes != depois {
+				diferencas = append(diferencas, domain.DiferencaCampo{Campo: c.nome, Antes: antes, Depois: depois})
+			}
+		}
+		if len(diferencas) > 0 {
+			alteracoes = append(alteracoes, domain.Alteracao{Entidade: entidade, Tipo: domain.Alterado, CodigoIBGE: codigo, Nome: nome, UF: uf, Campos: diferencas})
+		}
+	}
+
+	for _, a := range antigos {
+		codigo, nome, uf := identificar(a)
+		if !novosByCodigo[codigo] {
+			alteracoes = append(alteracoes, domain.Alteracao{Entidade: entidade, Tipo: domain.Removido, CodigoIBGE: codigo, Nome: nome, UF: uf})
+		}
+	}
+
+	sort.SliceStable(alteracoes, func(i, j int) bool {
+		return alteracoes[i].CodigoIBGE < alteracoes[j].CodigoIBGE
+	})
+	return alteracoes
+}
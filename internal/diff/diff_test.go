@@ -0,0 +1,166 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+func novosConjuntos() (*Conjunto, *Conjunto) {
+	antigo := &Conjunto{
+		Estados: []domain.Estado{
+			{CodigoIBGE: 1, Nome: "Estado A", Sigla: "EA"},
+			{CodigoIBGE: 2, Nome: "Estado B", Sigla: "EB"},
+		},
+		Cidades: []domain.Cidade{
+			{CodigoIBGE: 101, Nome: "Cidade A1", CodigoTOM: "1", MicroRegiao: "10", EstadoSigla: "EA"},
+			{CodigoIBGE: 102, Nome: "Cidade A2", CodigoTOM: "2", MicroRegiao: "10", EstadoSigla: "EA"},
+			{CodigoIBGE: 201, Nome: "Cidade B1", CodigoTOM: "3", MicroRegiao: "20", EstadoSigla: "EB"},
+		},
+	}
+	novo := &Conjunto{
+		Estados: []domain.Estado{
+			{CodigoIBGE: 1, Nome: "Estado A", Sigla: "EA"},
+			{CodigoIBGE: 2, Nome: "Estado Bê", Sigla: "EB"},
+		},
+		Cidades: []domain.Cidade{
+			{CodigoIBGE: 101, Nome: "Cidade A1", CodigoTOM: "1", MicroRegiao: "10", EstadoSigla: "EA"},
+			{CodigoIBGE: 201, Nome: "Nova B1", CodigoTOM: "3", MicroRegiao: "21", EstadoSigla: "EB"},
+			{CodigoIBGE: 202, Nome: "Cidade B2", CodigoTOM: "4", MicroRegiao: "20", EstadoSigla: "EB"},
+		},
+	}
+	return antigo, novo
+}
+
+func TestComparar(t *testing.T) {
+	antigo, novo := novosConjuntos()
+
+	t.Run("deve relatar estados e cidades adicionados, removidos e alterados", func(t *testing.T) {
+		expected := []domain.Alteracao{
+			{Entidade: domain.EntidadeEstado, Tipo: domain.Alterado, CodigoIBGE: 2, Nome: "Estado Bê", UF: "EB", Campos: []domain.DiferencaCampo{
+				{Campo: "nome", Antes: "Estado B", Depois: "Estado Bê"},
+			}},
+			{Entidade: domain.EntidadeCidade, Tipo: domain.Removido, CodigoIBGE: 102, Nome: "Cidade A2", UF: "EA"},
+			{Entidade: domain.EntidadeCidade, Tipo: domain.Alterado, CodigoIBGE: 201, Nome: "Nova B1", UF: "EB", Campos: []domain.DiferencaCampo{
+				{Campo: "nome", Antes: "Cidade B1", Depois: "Nova B1"},
+				{Campo: "micro_regiao", Antes: "20", Depois: "21"},
+			}},
+			{Entidade: domain.EntidadeCidade, Tipo: domain.Adicionado, CodigoIBGE: 202, Nome: "Cidade B2", UF: "EB"},
+		}
+
+		got := Comparar(antigo, novo)
+
+		if !reflect.DeepEqual(got.Alteracoes, expected) {
+			t.Errorf("Alterações incorretas.\ngot:  %+v\nwant: %+v", got.Alteracoes, expected)
+		}
+		if got.Contar(domain.EntidadeCidade, domain.Alterado) != 1 || got.Contar(domain.EntidadeEstado, domain.Removido) != 0 {
+			t.Errorf("Contagem de alterações incorreta")
+		}
+	})
+
+	t.Run("deve retornar relatório vazio para edições iguais", func(t *testing.T) {
+		got := Comparar(antigo, antigo)
+
+		if len(got.Alteracoes) != 0 {
+			t.Errorf("Esperava nenhuma alteração, mas recebi: %+v", got.Alteracoes)
+		}
+	})
+
+	t.Run("deve comparar fuso, gentílico, aliases e classificações territoriais", func(t *testing.T) {
+		cidade := domain.Cidade{CodigoIBGE: 101, Nome: "Cidade A1", EstadoSigla: "EA", Gentilico: "a1ense", FusoHorario: "America/Sao_Paulo"}
+		antigo := &Conjunto{Estados: []domain.Estado{{CodigoIBGE: 1, Nome: "Estado A", Sigla: "EA"}}, Cidades: []domain.Cidade{cidade}}
+		Enriquecer(antigo.Cidades, map[int][]string{101: {"A Um", "Aum"}}, nil)
+
+		novo := &Conjunto{Estados: []domain.Estado{{CodigoIBGE: 1, Nome: "Estado A", Sigla: "EA", FusoHorario: "America/Manaus"}}}
+		cidade.Gentilico, cidade.FusoHorario = "umense", "America/Manaus"
+		novo.Cidades = []domain.Cidade{cidade}
+		Enriquecer(novo.Cidades, map[int][]string{101: {"Aum", "A Um"}}, []domain.ClassificacaoMunicipio{
+			{CodigoIBGE: 101, Classificacao: domain.ClassificacaoFaixaFronteira, PaisesFronteira: []string{"Peru", "Bolívia"}},
+			{CodigoIBGE: 999, Classificacao: domain.ClassificacaoAmazoniaLegal},
+		})
+
+		expected := []domain.Alteracao{
+			{Entidade: domain.EntidadeEstado, Tipo: domain.Alterado, CodigoIBGE: 1, Nome: "Estado A", UF: "EA", Campos: []domain.DiferencaCampo{
+				{Campo: "fuso_horario", Antes: "", Depois: "America/Manaus"},
+			}},
+			{Entidade: domain.EntidadeCidade, Tipo: domain.Alterado, CodigoIBGE: 101, Nome: "Cidade A1", UF: "EA", Campos: []domain.DiferencaCampo{
+				{Campo: "gentilico", Antes: "a1ense", Depois: "umense"},
+				{Campo: "fuso_horario", Antes: "America/Sao_Paulo", Depois: "America/Manaus"},
+				{Campo: "amazonia_legal", Antes: "", Depois: "false"},
+				{Campo: "faixa_fronteira", Antes: "", Depois: "true"},
+				{Campo: "paises_fronteira", Antes: "", Depois: "Bolívia, Peru"},
+			}},
+		}
+
+		got := Comparar(antigo, novo)
+
+		if !reflect.DeepEqual(got.Alteracoes, expected) {
+			t.Errorf("Alterações incorretas.\ngot:  %+v\nwant: %+v", got.Alteracoes, expected)
+		}
+	})
+}
+
+func TestRelatorioEscrever(t *testing.T) {
+	antigo, novo := novosConjuntos()
+	relatorio := Comparar(antigo, novo)
+
+	t.Run("texto", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := relatorio.Escrever(&buf, FormatoTexto); err != nil {
+			t.Fatalf("Esperava não ter erro, mas recebi: %v", err)
+		}
+		saida := buf.String()
+		for _, trecho := range []string{
+			"cidade: 1 adicionado(s), 1 removido(s), 1 alterado(s)",
+			"- cidade 102 Cidade A2 (EA)",
+			`    micro_regiao: "20" -> "21"`,
+			"+ cidade 202 Cidade B2 (EB)",
+		} {
+			if !strings.Contains(saida, trecho) {
+				t.Errorf("Saída em texto não contém %q:\n%s", trecho, saida)
+			}
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := relatorio.Escrever(&buf, FormatoJSON); err != nil {
+			t.Fatalf("Esperava não ter erro, mas recebi: %v", err)
+		}
+		var got Relatorio
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatalf("Erro ao decodificar JSON: %v", err)
+		}
+		if !reflect.DeepEqual(got.Alteracoes, relatorio.Alteracoes) {
+			t.Errorf("JSON incorreto: %s", buf.String())
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := relatorio.Escrever(&buf, FormatoCSV); err != nil {
+			t.Fatalf("Esperava não ter erro, mas recebi: %v", err)
+		}
+		expected := strings.Join([]string{
+			"entidade,tipo,codigo_ibge,nome,uf,campo,antes,depois",
+			"estado,alterado,2,Estado Bê,EB,nome,Estado B,Estado Bê",
+			"cidade,removido,102,Cidade A2,EA,,,",
+			"cidade,alterado,201,Nova B1,EB,nome,Cidade B1,Nova B1",
+			"cidade,alterado,201,Nova B1,EB,micro_regiao,20,21",
+			"cidade,adicionado,202,Cidade B2,EB,,,",
+		}, "\n") + "\n"
+		if buf.String() != expected {
+			t.Errorf("CSV incorreto.\ngot:\n%s\nwant:\n%s", buf.String(), expected)
+		}
+	})
+
+	t.Run("formato inválido", func(t *testing.T) {
+		if err := relatorio.Escrever(&bytes.Buffer{}, "xml"); err == nil {
+			t.Error("Esperava erro para formato inválido")
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package diff
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// Formatos de saída suportados pelo relatório.
+const (
+	FormatoTexto = "texto"
+	FormatoJSON  = "json"
+	FormatoCSV   = "csv"
+)
+
+// Escrever grava o relatório no formato informado (texto, json ou csv).
+func (r *Relatorio) Escrever(w io.Writer, formato string) error {
+	switch formato {
+	case FormatoTexto, "":
+		return r.EscreverTexto(w)
+	case FormatoJSON:
+		return r.EscreverJSON(w)
+	case FormatoCSV:
+		return r.EscreverCSV(w)
+	default:
+		return fmt.Errorf("formato de saída %q inválido (use texto, json ou csv)", formato)
+	}
+}
+
+// EscreverTexto grava um resumo seguido de uma linha por alteração, legível para revisão humana.
+func (r *Relatorio) EscreverTexto(w io.Writer) error {
+	for _, entidade := range []domain.EntidadeAlterada{domain.EntidadeEstado, domain.EntidadeCidade} {
+		if _, err := fmt.Fprintf(w, "%s: %d adicionado(s), %d removido(s), %d alterado(s)\n", entidade,
+			r.Contar(entidade, domain.Adicionado), r.Contar(entidade, domain.Removido), r.Contar(entidade, domain.Alterado)); err != nil {
+			return err
+		}
+	}
+
+	if len(r.Alteracoes) == 0 {
+		_, err := fmt.Fprintln(w, "\nNenhuma diferença encontrada.")
+		return err
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	simbolos := map[domain.TipoAlteracao]string{domain.Adicionado: "+", domain.Removido: "-", domain.Alterado: "~"}
+	for _, a := range r.Alteracoes {
+		if _, err := fmt.Fprintf(w, "%s %s %d %s (%s)\n", simbolos[a.Tipo], a.Entidade, a.CodigoIBGE, a.Nome, a.UF); err != nil {
+			return err
+		}
+		for _, c := range a.Campos {
+			if _, err := fmt.Fprintf(w, "    %s: %q -> %q\n", c.Campo, c.Antes, c.Depois); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// EscreverJSON grava o relatório completo em JSON indentado.
+func (r *Relatorio) EscreverJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// EscreverCSV grava uma linha por campo alterado, ou uma linha por registro adicionado ou removido.
+func (r *Relatorio) EscreverCSV(w io.Writer) error {
+	escritor := csv.NewWriter(w)
+	if err := escritor.Write([]string{"entidade", "tipo", "codigo_ibge", "nome", "uf", "campo", "antes", "depois"}); err != nil {
+		return err
+	}
+
+	for _, a := range r.Alteracoes {
+		base := []string{string(a.Entidade), string(a.Tipo), strconv.Itoa(a.CodigoIBGE), a.Nome, a.UF}
+		if len(a.Campos) == 0 {
+			if err := escritor.Write(append(base, "", "", "")); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, c := range a.Campos {
+			linha := append(append([]string{}, base...), c.Campo, c.Antes, c.Depois)
+			if err := escritor.Write(linha); err != nil {
+				return err
+			}
+		}
+	}
+
+	escritor.Flush()
+	return escritor.Error()
+}
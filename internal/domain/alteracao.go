@@ -0,0 +1,46 @@
+package domain
+
+// EntidadeAlterada identifica o tipo de registro alterado entre duas versões do conjunto de dados.
+type EntidadeAlterada string
+
+const (
+	EntidadeEstado EntidadeAlterada = "estado"
+	EntidadeCidade EntidadeAlterada = "cidade"
+)
+
+// TipoAlteracao classifica a diferença de um registro entre duas versões do conjunto de dados.
+type TipoAlteracao string
+
+const (
+	Adicionado TipoAlteracao = "adicionado"
+	Removido   TipoAlteracao = "removido"
+	Alterado   TipoAlteracao = "alterado"
+)
+
+// DiferencaCampo descreve a mudança de valor de um campo de um registro alterado.
+type DiferencaCampo struct {
+	Campo  string `json:"campo"`
+	Antes  string `json:"antes"`
+	Depois string `json:"depois"`
+}
+
+// Alteracao é uma diferença em um estado ou município entre duas versões do conjunto de dados.
+// Nome e UF são os da versão nova, ou os da antiga quando o registro foi removido.
+// Versao é a versão do conjunto de dados em que a alteração foi registrada pelo seed.
+type Alteracao struct {
+	Versao     int              `json:"versao,omitempty"`
+	Entidade   EntidadeAlterada `json:"entidade"`
+	Tipo       TipoAlteracao    `json:"tipo"`
+	CodigoIBGE int              `json:"codigo_ibge"`
+	Nome       string           `json:"nome"`
+	UF         string           `json:"uf"`
+	Campos     []DiferencaCampo `json:"campos,omitempty"`
+}
+
+// FeedAlteracoes é a resposta do feed de sincronização: as alterações registradas depois
+// da versão Desde, até a VersaoAtual do conjunto de dados.
+type FeedAlteracoes struct {
+	Desde       int         `json:"desde"`
+	VersaoAtual int         `json:"versao_atual"`
+	Alteracoes  []Alteracao `json:"alteracoes"`
+}
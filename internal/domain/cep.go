@@ -0,0 +1,44 @@
+package domain
+
+// FaixaCEP representa um intervalo de CEPs atribuído a um estado ou município.
+// Quando CodigoIBGE é zero, a faixa pertence ao estado como um todo.
+type FaixaCEP struct {
+	UF         string `json:"uf"`
+	CodigoIBGE int    `json:"codigo_ibge,omitempty"`
+	CEPInicial string `json:"cep_inicial"`
+	CEPFinal   string `json:"cep_final"`
+}
+
+// Contem verifica se o CEP (8 dígitos, sem máscara) está dentro da faixa.
+func (f FaixaCEP) Contem(cep string) bool {
+	return cep >= f.CEPInicial && cep <= f.CEPFinal
+}
+
+// ValidacaoEndereco é o resultado da verificação de consistência entre CEP, cidade e UF.
+// O caso de uso preenche Inconsistencias; os adaptadores escrevem as Mensagens no idioma do
+// cliente com Traduzir.
+type ValidacaoEndereco struct {
+	CEP             string           `json:"cep"`
+	Valido          bool             `json:"valido"`
+	UFConfere       bool             `json:"uf_confere"`
+	CidadeConfere   bool             `json:"cidade_confere"`
+	EstadoEsperado  *Estado          `json:"estado_esperado,omitempty"`
+	CidadeEsperada  *Cidade          `json:"cidade_esperada,omitempty"`
+	Inconsistencias []Inconsistencia `json:"-"`
+	Mensagens       []string         `json:"inconsistencias,omitempty"`
+}
+
+// Inconsistencia é uma divergência encontrada na validação de um endereço. Como os erros do
+// domínio, guarda o código da mensagem e os argumentos em vez do texto.
+type Inconsistencia struct {
+	Codigo CodigoMensagem
+	Args   []interface{}
+}
+
+// Traduzir escreve as mensagens das inconsistências no idioma.
+func (v *ValidacaoEndereco) Traduzir(idioma Idioma) {
+	v.Mensagens = nil
+	for _, i := range v.Inconsistencias {
+		v.Mensagens = append(v.Mensagens, Mensagem(idioma, i.Codigo, i.Args...))
+	}
+}
@@ -2,12 +2,42 @@ package domain
 
 // Cidade representa um município brasileiro.
 type Cidade struct {
-	CodigoIBGE       int    `json:"codigo_ibge"`
-	Nome             string `json:"nome"`
-	CodigoTOM        string `json:"codigo_tom,omitempty"`
-	MicroRegiao      string `json:"micro_regiao,omitempty"`
-	RegiaoImediata   string `json:"regiao_imediata,omitempty"`
-	EstadoCodigoIBGE int    `json:"estado_codigo_ibge"`
-	EstadoSigla      string `json:"estado_sigla"`
-	EstadoNome       string `json:"estado_nome"`
+	CodigoIBGE       int      `json:"codigo_ibge"`
+	Nome             string   `json:"nome"`
+	Gentilico        string   `json:"gentilico,omitempty"`
+	Aliases          []string `json:"aliases,omitempty"`
+	CodigoTOM        string   `json:"codigo_tom,omitempty"`
+	MicroRegiao      string   `json:"micro_regiao,omitempty"`
+	RegiaoImediata   string   `json:"regiao_imediata,omitempty"`
+	FusoHorario      string   `json:"fuso_horario,omitempty"`
+	EstadoCodigoIBGE int      `json:"estado_codigo_ibge"`
+	EstadoSigla      string   `json:"estado_sigla"`
+	EstadoNome       string   `json:"estado_nome"`
+	// Classificações territoriais: nil quando a lista oficial completa do recorte não foi carregada
+	AmazoniaLegal   *bool             `json:"amazonia_legal,omitempty"`
+	Semiarido       *bool             `json:"semiarido,omitempty"`
+	FaixaFronteira  *bool             `json:"faixa_fronteira,omitempty"`
+	PaisesFronteira []string          `json:"paises_fronteira,omitempty"`
+	ZonaCosteira    *bool             `json:"zona_costeira,omitempty"`
+	Matopiba        *bool             `json:"matopiba,omitempty"`
+	Feriados        []RegraFeriado    `json:"feriados,omitempty"`
+	Historico       []EventoMunicipio `json:"historico,omitempty"`
+}
+
+// NomesConhecidos retorna o nome oficial seguido dos nomes alternativos da cidade
+// (grafias antigas, abreviações e nomes anteriores a renomeações).
+func (c Cidade) NomesConhecidos() []string {
+	return append([]string{c.Nome}, c.Aliases...)
+}
+
+// ConhecidaComo verifica se o nome informado corresponde ao nome oficial ou a um dos
+// nomes alternativos da cidade, sem diferenciar acentos, caixa e pontuação.
+func (c Cidade) ConhecidaComo(nome string) bool {
+	normalizado := NormalizarNome(nome)
+	for _, conhecido := range c.NomesConhecidos() {
+		if NormalizarNome(conhecido) == normalizado {
+			return true
+		}
+	}
+	return false
 }
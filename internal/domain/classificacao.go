@@ -0,0 +1,86 @@
+package domain
+
+// Classificacao identifica um recorte territorial oficial ao qual um município pode pertencer.
+type Classificacao string
+
+const (
+	ClassificacaoAmazoniaLegal  Classificacao = "amazonia_legal"
+	ClassificacaoSemiarido      Classificacao = "semiarido"
+	ClassificacaoFaixaFronteira Classificacao = "faixa_fronteira"
+	ClassificacaoZonaCosteira   Classificacao = "zona_costeira"
+	ClassificacaoMatopiba       Classificacao = "matopiba"
+)
+
+// ClassificacaoMunicipio associa um município a uma classificação territorial.
+// PaisesFronteira só é preenchido na faixa de fronteira, para municípios lindeiros.
+type ClassificacaoMunicipio struct {
+	CodigoIBGE      int
+	Classificacao   Classificacao
+	PaisesFronteira []string
+}
+
+// Classificacoes lista as classificações territoriais, na ordem em que aparecem nas respostas.
+var Classificacoes = []Classificacao{
+	ClassificacaoAmazoniaLegal,
+	ClassificacaoSemiarido,
+	ClassificacaoFaixaFronteira,
+	ClassificacaoZonaCosteira,
+	ClassificacaoMatopiba,
+}
+
+// campoClassificacao retorna o campo da cidade que guarda a classificação.
+func (c *Cidade) campoClassificacao(cl Classificacao) **bool {
+	switch cl {
+	case ClassificacaoAmazoniaLegal:
+		return &c.AmazoniaLegal
+	case ClassificacaoSemiarido:
+		return &c.Semiarido
+	case ClassificacaoFaixaFronteira:
+		return &c.FaixaFronteira
+	case ClassificacaoZonaCosteira:
+		return &c.ZonaCosteira
+	case ClassificacaoMatopiba:
+		return &c.Matopiba
+	}
+	return nil
+}
+
+// ClassificacoesCarregadas lista, na ordem de Classificacoes, as classificações com algum município.
+// O seed só grava as classificações com a lista oficial completa, então essas são as disponíveis.
+func ClassificacoesCarregadas(classificacoes []ClassificacaoMunicipio) []Classificacao {
+	carregadas := make(map[Classificacao]bool)
+	for _, c := range classificacoes {
+		carregadas[c.Classificacao] = true
+	}
+	var disponiveis []Classificacao
+	for _, c := range Classificacoes {
+		if carregadas[c] {
+			disponiveis = append(disponiveis, c)
+		}
+	}
+	return disponiveis
+}
+
+// Classificar preenche as classificações territoriais da cidade. As disponíveis, cuja lista
+// oficial completa foi carregada, ficam true ou false conforme a cidade esteja ou não entre as
+// classificacoes do município; as demais ficam nil, pois não estar em uma lista incompleta não
+// diz que a cidade está fora do recorte.
+func (c *Cidade) Classificar(disponiveis []Classificacao, classificacoes []ClassificacaoMunicipio) {
+	for _, cl := range disponiveis {
+		if campo := c.campoClassificacao(cl); campo != nil {
+			pertence := false
+			*campo = &pertence
+		}
+	}
+	for _, cl := range classificacoes {
+		campo := c.campoClassificacao(cl.Classificacao)
+		if campo == nil || *campo == nil {
+			continue
+		}
+		pertence := true
+		*campo = &pertence
+		if cl.Classificacao == ClassificacaoFaixaFronteira {
+			c.PaisesFronteira = cl.PaisesFronteira
+		}
+	}
+}
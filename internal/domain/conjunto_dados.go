@@ -0,0 +1,10 @@
+package domain
+
+// ConjuntoDados reúne todos os dados de uma edição do conjunto de dados, para exportá-la por completo.
+type ConjuntoDados struct {
+	Versao          int        // Versão registrada pelo seed na tabela de metadados
+	Estados         []Estado   // Com os feriados estaduais
+	Cidades         []Cidade   // Com feriados, classificações, aliases e histórico
+	CidadesExtintas []Cidade   // Municípios extintos, que constam apenas do histórico
+	FaixasCEP       []FaixaCEP // Faixas estaduais e municipais
+}
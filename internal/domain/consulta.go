@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Ordenacao é um critério de ordenação de uma consulta: o campo (nome JSON) e a direção.
+type Ordenacao struct {
+	Campo       string
+	Decrescente bool
+}
+
+// ParseOrdenacao lê critérios no formato "nome,-codigo_ibge", em que o "-" indica ordem
+// decrescente, aceitando apenas os campos informados.
+func ParseOrdenacao(valor string, campos []string) ([]Ordenacao, error) {
+	var criterios []Ordenacao
+	for _, campo := range strings.Split(valor, ",") {
+		campo = strings.TrimSpace(campo)
+		if campo == "" {
+			continue
+		}
+		criterio := Ordenacao{Campo: strings.TrimPrefix(campo, "-"), Decrescente: strings.HasPrefix(campo, "-")}
+		if !slices.Contains(campos, criterio.Campo) {
+			return nil, ArgumentoInvalido(MsgOrdenacaoInvalida, criterio.Campo, strings.Join(campos, ", "))
+		}
+		criterios = append(criterios, criterio)
+	}
+	return criterios, nil
+}
+
+// ConsultaEstados descreve uma consulta de estados: os filtros e a ordenação.
+type ConsultaEstados struct {
+	NomeContem string // Parte do nome, sem diferenciar acentos e caixa
+	Ordenacao  []Ordenacao
+}
+
+// CamposOrdenacaoEstados são os campos aceitos na ordenação de estados.
+var CamposOrdenacaoEstados = []string{"codigo_ibge", "nome", "sigla"}
+
+var comparadoresEstados = map[string]func(a, b Estado) int{
+	"codigo_ibge": func(a, b Estado) int { return cmp.Compare(a.CodigoIBGE, b.CodigoIBGE) },
+	"nome":        func(a, b Estado) int { return compararNomes(a.Nome, b.Nome) },
+	"sigla":       func(a, b Estado) int { return cmp.Compare(a.Sigla, b.Sigla) },
+}
+
+// Aceita verifica se o estado satisfaz os filtros da consulta.
+func (c ConsultaEstados) Aceita(e Estado) bool {
+	return contemNome(e.Nome, c.NomeContem)
+}
+
+// Filtrar retorna apenas os estados aceitos pela consulta, na ordem da consulta.
+func (c ConsultaEstados) Filtrar(estados []Estado) []Estado {
+	filtrados := make([]Estado, 0, len(estados))
+	for _, e := range estados {
+		if c.Aceita(e) {
+			filtrados = append(filtrados, e)
+		}
+	}
+	c.Ordenar(filtrados)
+	return filtrados
+}
+
+// Ordenar aplica os critérios de ordenação da consulta, com empates desfeitos pelo código IBGE.
+func (c ConsultaEstados) Ordenar(estados []Estado) {
+	ordenar(estados, c.Ordenacao, comparadoresEstados, comparadoresEstados["codigo_ibge"])
+}
+
+// ConsultaCidades descreve uma consulta de cidades: o estado, os filtros e a ordenação.
+// Campos vazios (ou nil) não filtram.
+type ConsultaCidades struct {
+	EstadoCodigoIBGE int    // 0 consulta as cidades de todos os estados
+	NomeContem       string // Parte do nome, sem diferenciar acentos e caixa
+	MicroRegiao      string
+	RegiaoImediata   string
+	TemCodigoTOM     *bool
+	Ordenacao        []Ordenacao
+}
+
+// CamposOrdenacaoCidades são os campos aceitos na ordenação de cidades.
+var CamposOrdenacaoCidades = []string{"codigo_ibge", "nome", "estado_codigo_ibge", "estado_sigla", "codigo_tom", "micro_regiao", "regiao_imediata"}
+
+var comparadoresCidades = map[string]func(a, b Cidade) int{
+	"codigo_ibge":        func(a, b Cidade) int { return cmp.Compare(a.CodigoIBGE, b.CodigoIBGE) },
+	"nome":               func(a, b Cidade) int { return compararNomes(a.Nome, b.Nome) },
+	"estado_codigo_ibge": func(a, b Cidade) int { return cmp.Compare(a.EstadoCodigoIBGE, b.EstadoCodigoIBGE) },
+	"estado_sigla":       func(a, b Cidade) int { return cmp.Compare(a.EstadoSigla, b.EstadoSigla) },
+	"codigo_tom":         func(a, b Cidade) int { return cmp.Compare(a.CodigoTOM, b.CodigoTOM) },
+	"micro_regiao":       func(a, b Cidade) int { return cmp.Compare(a.MicroRegiao, b.MicroRegiao) },
+	"regiao_imediata":    func(a, b Cidade) int { return cmp.Compare(a.RegiaoImediata, b.RegiaoImediata) },
+}
+
+// Aceita verifica se a cidade satisfaz todos os filtros da consulta.
+func (c ConsultaCidades) Aceita(cidade Cidade) bool {
+	switch {
+	case c.EstadoCodigoIBGE != 0 && cidade.EstadoCodigoIBGE != c.EstadoCodigoIBGE:
+		return false
+	case c.MicroRegiao != "" && cidade.MicroRegiao != c.MicroRegiao:
+		return false
+	case c.RegiaoImediata != "" && cidade.RegiaoImediata != c.RegiaoImediata:
+		return false
+	case c.TemCodigoTOM != nil && *c.TemCodigoTOM != (cidade.CodigoTOM != ""):
+		return false
+	}
+	return contemNome(cidade.Nome, c.NomeContem)
+}
+
+// Filtrar retorna apenas as cidades aceitas pela consulta, na ordem da consulta. Sem
+// critérios de ordenação, a ordem original é mantida.
+func (c ConsultaCidades) Filtrar(cidades []Cidade) []Cidade {
+	filtradas := make([]Cidade, 0, len(cidades))
+	for _, cidade := range cidades {
+		if c.Aceita(cidade) {
+			filtradas = append(filtradas, cidade)
+		}
+	}
+	c.Ordenar(filtradas)
+	return filtradas
+}
+
+// Ordenar aplica os critérios de ordenação da consulta. Empates são desfeitos pelo código IBGE,
+// para que a ordem seja sempre a mesma (e a paginação, estável).
+func (c ConsultaCidades) Ordenar(cidades []Cidade) {
+	ordenar(cidades, c.Ordenacao, comparadoresCidades, comparadoresCidades["codigo_ibge"])
+}
+
+func ordenar[T any](itens []T, criterios []Ordenacao, comparadores map[string]func(a, b T) int, desempate func(a, b T) int) {
+	if len(criterios) == 0 {
+		return
+	}
+	slices.SortStableFunc(itens, func(a, b T) int {
+		for _, criterio := range criterios {
+			comparar, found := comparadores[criterio.Campo]
+			if !found {
+				continue
+			}
+			if r := comparar(a, b); r != 0 {
+				if criterio.Decrescente {
+					return -r
+				}
+				return r
+			}
+		}
+		return desempate(a, b)
+	})
+}
+
+// compararNomes ordena os nomes sem considerar acentos e caixa (ex: "Águas" antes de "Bauru").
+func compararNomes(a, b string) int {
+	if r := cmp.Compare(NormalizarNome(a), NormalizarNome(b)); r != 0 {
+		return r
+	}
+	return cmp.Compare(a, b)
+}
+
+func contemNome(nome, termo string) bool {
+	return termo == "" || strings.Contains(NormalizarNome(nome), NormalizarNome(termo))
+}
@@ -0,0 +1,62 @@
+package domain
+
+import "errors"
+
+// Erros que classificam as falhas do domínio. Repositórios e casos de uso os retornam envolvidos
+// em erros com o código da mensagem (ver NaoEncontrado, CodigoInvalido e ArgumentoInvalido), e cada
+// adaptador os traduz para o seu protocolo em um único lugar, com errors.Is (ex: 404 no HTTP,
+// NotFound no gRPC).
+var (
+	ErrNotFound        = errors.New("recurso não encontrado")
+	ErrInvalidCode     = errors.New("código inválido")
+	ErrInvalidArgument = errors.New("parâmetro inválido")
+	ErrGone            = errors.New("recurso extinto")
+)
+
+// ErroTraduzivel é um erro cuja mensagem está no catálogo e pode ser escrita em qualquer idioma.
+type ErroTraduzivel interface {
+	error
+	CodigoMensagem() CodigoMensagem
+	Mensagem(idioma Idioma) string
+}
+
+// Erro é um erro do domínio classificado por um dos erros acima. Em vez do texto, guarda o código
+// da mensagem e os argumentos, para que os adaptadores a escrevam no idioma do cliente; Error
+// retorna a mensagem no idioma padrão.
+type Erro struct {
+	Tipo   error
+	Codigo CodigoMensagem
+	Args   []interface{}
+}
+
+func (e *Erro) Error() string {
+	return e.Mensagem(Idiomas[0])
+}
+
+func (e *Erro) Unwrap() error {
+	return e.Tipo
+}
+
+func (e *Erro) CodigoMensagem() CodigoMensagem {
+	return e.Codigo
+}
+
+func (e *Erro) Mensagem(idioma Idioma) string {
+	return Mensagem(idioma, e.Codigo, e.Args...)
+}
+
+// NaoEncontrado cria um erro ErrNotFound com a mensagem do catálogo.
+func NaoEncontrado(codigo CodigoMensagem, args ...interface{}) error {
+	return &Erro{Tipo: ErrNotFound, Codigo: codigo, Args: args}
+}
+
+// CodigoInvalido cria um erro ErrInvalidCode com a mensagem do catálogo, para códigos (IBGE, TOM,
+// CEP) mal formados, que não poderiam existir.
+func CodigoInvalido(codigo CodigoMensagem, args ...interface{}) error {
+	return &Erro{Tipo: ErrInvalidCode, Codigo: codigo, Args: args}
+}
+
+// ArgumentoInvalido cria um erro ErrInvalidArgument com a mensagem do catálogo.
+func ArgumentoInvalido(codigo CodigoMensagem, args ...interface{}) error {
+	return &Erro{Tipo: ErrInvalidArgument, Codigo: codigo, Args: args}
+}
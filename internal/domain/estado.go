@@ -2,7 +2,9 @@ package domain
 
 // Estado representa uma Unidade Federativa do Brasil.
 type Estado struct {
-	CodigoIBGE int    `json:"codigo_ibge"`
-	Nome       string `json:"nome"`
-	Sigla      string `json:"sigla"`
+	CodigoIBGE  int            `json:"codigo_ibge"`
+	Nome        string         `json:"nome"`
+	Sigla       string         `json:"sigla"`
+	FusoHorario string         `json:"fuso_horario,omitempty"`
+	Feriados    []RegraFeriado `json:"feriados,omitempty"`
 }
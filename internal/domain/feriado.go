@@ -0,0 +1,44 @@
+package domain
+
+// TipoFeriado classifica o feriado pela esfera que o instituiu.
+type TipoFeriado string
+
+const (
+	FeriadoNacional  TipoFeriado = "nacional"
+	FeriadoEstadual  TipoFeriado = "estadual"
+	FeriadoMunicipal TipoFeriado = "municipal"
+)
+
+// RegraFeriado descreve como obter a data de um feriado em qualquer ano.
+// Feriados fixos usam Dia e Mes; feriados móveis usam DiasAposPascoa (ex: Carnaval = -47).
+type RegraFeriado struct {
+	Nome           string      `json:"nome"`
+	Tipo           TipoFeriado `json:"tipo"`
+	Dia            int         `json:"dia,omitempty"`
+	Mes            int         `json:"mes,omitempty"`
+	DiasAposPascoa *int        `json:"dias_apos_pascoa,omitempty"`
+	DesdeAno       int         `json:"desde_ano,omitempty"`
+	UF             string      `json:"-"`
+	CodigoIBGE     int         `json:"-"`
+}
+
+// Movel indica se a data do feriado depende da Páscoa.
+func (r RegraFeriado) Movel() bool {
+	return r.DiasAposPascoa != nil
+}
+
+// Feriado é a ocorrência de uma regra de feriado em uma data concreta (AAAA-MM-DD).
+type Feriado struct {
+	Data string      `json:"data"`
+	Nome string      `json:"nome"`
+	Tipo TipoFeriado `json:"tipo"`
+}
+
+// DiasUteis é o resultado do cálculo de prazo em dias úteis para um município.
+type DiasUteis struct {
+	CodigoIBGE           int       `json:"codigo_ibge"`
+	Inicio               string    `json:"inicio"`
+	Dias                 int       `json:"dias"`
+	DataFinal            string    `json:"data_final"`
+	FeriadosConsiderados []Feriado `json:"feriados_considerados"`
+}
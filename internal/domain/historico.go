@@ -0,0 +1,140 @@
+package domain
+
+import "sort"
+
+// TipoEvento classifica as alterações sofridas por um município ao longo do tempo.
+type TipoEvento string
+
+const (
+	EventoCriacao        TipoEvento = "criacao"
+	EventoDesmembramento TipoEvento = "desmembramento"
+	EventoRenomeacao     TipoEvento = "renomeacao"
+	EventoExtincao       TipoEvento = "extincao"
+)
+
+// EventoMunicipio registra uma alteração territorial com a data em que passou a vigorar (AAAA-MM-DD).
+// Origem lista os municípios dos quais o território foi desmembrado; Sucessores lista
+// os municípios que absorveram o território de um município extinto.
+type EventoMunicipio struct {
+	CodigoIBGE   int        `json:"codigo_ibge"`
+	Tipo         TipoEvento `json:"tipo"`
+	Data         string     `json:"data"`
+	Nome         string     `json:"nome,omitempty"`
+	NomeAnterior string     `json:"nome_anterior,omitempty"`
+	UF           string     `json:"uf,omitempty"`
+	Origem       []int      `json:"origem,omitempty"`
+	Sucessores   []int      `json:"sucessores,omitempty"`
+}
+
+// inicioVigencia indica se o evento faz o município passar a existir.
+func (e EventoMunicipio) inicioVigencia() bool {
+	return e.Tipo == EventoCriacao || e.Tipo == EventoDesmembramento
+}
+
+// OrdenarEventos ordena os eventos por data, do mais antigo para o mais recente.
+func OrdenarEventos(eventos []EventoMunicipio) {
+	sort.SliceStable(eventos, func(i, j int) bool {
+		return eventos[i].Data < eventos[j].Data
+	})
+}
+
+// ExistiaEm verifica se o município existia na data informada (AAAA-MM-DD).
+// Municípios sem eventos de criação são considerados existentes desde sempre.
+// O Historico deve estar ordenado por data.
+func (c Cidade) ExistiaEm(data string) bool {
+	existia := len(c.Historico) == 0 || !c.Historico[0].inicioVigencia()
+	for _, e := range c.Historico {
+		if e.Data > data {
+			break
+		}
+		switch {
+		case e.inicioVigencia():
+			existia = true
+		case e.Tipo == EventoExtincao:
+			existia = false
+		}
+	}
+	return existia
+}
+
+// NaData retorna a situação do município na data informada, desfazendo as renomeações
+// posteriores a ela. O segundo retorno é false se o município não existia naquela data.
+func (c Cidade) NaData(data string) (Cidade, bool) {
+	if !c.ExistiaEm(data) {
+		return Cidade{}, false
+	}
+	for i := len(c.Historico) - 1; i >= 0; i-- {
+		e := c.Historico[i]
+		if e.Data <= data {
+			break
+		}
+		if e.Tipo == EventoRenomeacao && e.NomeAnterior != "" {
+			c.Nome = e.NomeAnterior
+		}
+	}
+	return c, true
+}
+
+// OrigemNaData retorna os municípios dos quais o território foi desmembrado, se o município
+// ainda não existia na data informada por ter sido criado depois dela.
+func (c Cidade) OrigemNaData(data string) []int {
+	for _, e := range c.Historico {
+		if e.inicioVigencia() && e.Data > data {
+			return e.Origem
+		}
+	}
+	return nil
+}
+
+// Extincao retorna o evento de extinção vigente, se o município estiver extinto hoje.
+func (c Cidade) Extincao() (EventoMunicipio, bool) {
+	if len(c.Historico) == 0 {
+		return EventoMunicipio{}, false
+	}
+	ultimo := c.Historico[len(c.Historico)-1]
+	return ultimo, ultimo.Tipo == EventoExtincao
+}
+
+// ExtincaoEm retorna o evento de extinção vigente na data informada, se o município estava
+// extinto naquela data (ex: extinto e depois reinstalado). O Historico deve estar ordenado por data.
+func (c Cidade) ExtincaoEm(data string) (EventoMunicipio, bool) {
+	var vigente EventoMunicipio
+	extinta := false
+	for _, e := range c.Historico {
+		if e.Data > data {
+			break
+		}
+		switch {
+		case e.inicioVigencia():
+			extinta = false
+		case e.Tipo == EventoExtincao:
+			vigente, extinta = e, true
+		}
+	}
+	return vigente, extinta
+}
+
+// CidadeExtintaError é retornado quando o código consultado pertence a um município extinto (hoje
+// ou na data da consulta histórica). Sucessores contém os municípios que absorveram o seu
+// território, como eram nessa data.
+type CidadeExtintaError struct {
+	Cidade     Cidade
+	Data       string
+	Sucessores []Cidade
+}
+
+func (e *CidadeExtintaError) Error() string {
+	return e.Mensagem(Idiomas[0])
+}
+
+func (e *CidadeExtintaError) CodigoMensagem() CodigoMensagem {
+	return MsgCidadeExtinta
+}
+
+func (e *CidadeExtintaError) Mensagem(idioma Idioma) string {
+	return Mensagem(idioma, MsgCidadeExtinta, e.Cidade.Nome, e.Cidade.CodigoIBGE, e.Data)
+}
+
+func (e *CidadeExtintaError) Unwrap() error {
+	return ErrGone
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// HoraLocal representa a hora corrente no fuso horário de um município.
+type HoraLocal struct {
+	CodigoIBGE   int       `json:"codigo_ibge"`
+	Nome         string    `json:"nome"`
+	EstadoSigla  string    `json:"estado_sigla"`
+	FusoHorario  string    `json:"fuso_horario"`
+	HoraLocal    time.Time `json:"hora_local"`
+	UTCOffset    string    `json:"utc_offset"`
+	Abreviacao   string    `json:"abreviacao"`
+	HorarioVerao bool      `json:"horario_verao"`
+}
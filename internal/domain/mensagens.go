@@ -0,0 +1,373 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Idioma é um idioma do catálogo de mensagens, identificado pela tag BCP 47.
+type Idioma string
+
+const (
+	IdiomaPtBR Idioma = "pt-BR"
+	IdiomaEn   Idioma = "en"
+)
+
+// Idiomas lista os idiomas do catálogo; o primeiro é o padrão.
+var Idiomas = []Idioma{IdiomaPtBR, IdiomaEn}
+
+// CodigoMensagem identifica uma mensagem do catálogo. Os códigos são estáveis entre idiomas e
+// versões, e podem ser usados pelos clientes para tratar cada erro sem depender do texto.
+type CodigoMensagem string
+
+// Mensagens de erro dos repositórios, dos casos de uso e dos adaptadores.
+const (
+	MsgEstadoSiglaNaoEncontrado     CodigoMensagem = "estado_sigla_nao_encontrado"
+	MsgEstadoCodigoNaoEncontrado    CodigoMensagem = "estado_codigo_nao_encontrado"
+	MsgEstadoCEPNaoEncontrado       CodigoMensagem = "estado_cep_nao_encontrado"
+	MsgCidadeNaoEncontrada          CodigoMensagem = "cidade_nao_encontrada"
+	MsgCidadeTOMNaoEncontrada       CodigoMensagem = "cidade_tom_nao_encontrada"
+	MsgCidadeCEPNaoEncontrada       CodigoMensagem = "cidade_cep_nao_encontrada"
+	MsgCidadeInexistenteNaData      CodigoMensagem = "cidade_inexistente_na_data"
+	MsgCidadeExtinta                CodigoMensagem = "cidade_extinta"
+	MsgRegiaoNaoEncontrada          CodigoMensagem = "regiao_nao_encontrada"
+	MsgFusoNaoCadastrado            CodigoMensagem = "fuso_nao_cadastrado"
+	MsgCodigoIBGEInvalido           CodigoMensagem = "codigo_ibge_invalido"
+	MsgCodigoTOMInvalido            CodigoMensagem = "codigo_tom_invalido"
+	MsgCEPInvalido                  CodigoMensagem = "cep_invalido"
+	MsgNomeCidadeInvalido           CodigoMensagem = "nome_cidade_invalido"
+	MsgDataInvalida                 CodigoMensagem = "data_invalida"
+	MsgDataSemHistorico             CodigoMensagem = "data_sem_historico"
+	MsgOrdenacaoInvalida            CodigoMensagem = "ordenacao_invalida"
+	MsgVersaoDatasetNaoEncontrada   CodigoMensagem = "versao_dataset_nao_encontrada"
+	MsgVersaoAlteracoesInvalida     CodigoMensagem = "versao_alteracoes_invalida"
+	MsgFormatoInvalido              CodigoMensagem = "formato_invalido"
+	MsgFormatoSemEsquema            CodigoMensagem = "formato_sem_esquema"
+	MsgFormatoDownloadNaoEncontrado CodigoMensagem = "formato_download_nao_encontrado"
+	MsgCampoInvalido                CodigoMensagem = "campo_invalido"
+	MsgCampoSemSubcampos            CodigoMensagem = "campo_sem_subcampos"
+	MsgExpansaoInvalida             CodigoMensagem = "expansao_invalida"
+	MsgExpansaoIndisponivel         CodigoMensagem = "expansao_indisponivel"
+	MsgValorBooleanoInvalido        CodigoMensagem = "valor_booleano_invalido"
+	MsgUFFiltroNaoEncontrada        CodigoMensagem = "uf_filtro_nao_encontrada"
+	MsgRegiaoFiltroNaoEncontrada    CodigoMensagem = "regiao_filtro_nao_encontrada"
+	MsgCursorInvalido               CodigoMensagem = "cursor_invalido"
+	MsgCursorOutraVersao            CodigoMensagem = "cursor_outra_versao"
+	MsgPaginaECursor                CodigoMensagem = "pagina_e_cursor"
+	MsgPaginaInvalida               CodigoMensagem = "pagina_invalida"
+	MsgPorPaginaInvalido            CodigoMensagem = "por_pagina_invalido"
+	MsgNomeBuscaCurto               CodigoMensagem = "nome_busca_curto"
+	MsgCidadeEUFObrigatorios        CodigoMensagem = "cidade_e_uf_obrigatorios"
+	MsgCidadeObrigatoria            CodigoMensagem = "cidade_obrigatoria"
+	MsgAnoInvalido                  CodigoMensagem = "ano_invalido"
+	MsgInicioInvalido               CodigoMensagem = "inicio_invalido"
+	MsgDiasInvalido                 CodigoMensagem = "dias_invalido"
+	MsgDesdeInvalido                CodigoMensagem = "desde_invalido"
+	MsgCorpoInvalido                CodigoMensagem = "corpo_invalido"
+	MsgGraphQLEstadoSemArgumento    CodigoMensagem = "graphql_estado_sem_argumento"
+	MsgGraphQLCidadeSemArgumento    CodigoMensagem = "graphql_cidade_sem_argumento"
+	MsgGraphQLProfundidade          CodigoMensagem = "graphql_profundidade"
+	MsgGraphQLComplexidade          CodigoMensagem = "graphql_complexidade"
+	MsgGRPCEstadoSemIdentificador   CodigoMensagem = "grpc_estado_sem_identificador"
+	MsgGRPCCidadeSemIdentificador   CodigoMensagem = "grpc_cidade_sem_identificador"
+	MsgGRPCNomeObrigatorio          CodigoMensagem = "grpc_nome_obrigatorio"
+	MsgErroInterno                  CodigoMensagem = "erro_interno"
+)
+
+// Inconsistências da validação de endereços (ver ValidacaoEndereco).
+const (
+	MsgCEPDeOutroEstado     CodigoMensagem = "cep_de_outro_estado"
+	MsgCEPSemFaixaMunicipal CodigoMensagem = "cep_sem_faixa_municipal"
+	MsgCEPDeOutraCidade     CodigoMensagem = "cep_de_outra_cidade"
+)
+
+// Títulos das classes de erro, usados nas respostas de erro dos adaptadores.
+const (
+	MsgTituloParametroInvalido CodigoMensagem = "titulo_parametro_invalido"
+	MsgTituloCodigoInvalido    CodigoMensagem = "titulo_codigo_invalido"
+	MsgTituloNaoEncontrado     CodigoMensagem = "titulo_nao_encontrado"
+	MsgTituloMunicipioExtinto  CodigoMensagem = "titulo_municipio_extinto"
+	MsgTituloErroInterno       CodigoMensagem = "titulo_erro_interno"
+)
+
+// catalogoMensagens guarda o texto de cada mensagem por idioma, com os verbos de fmt.Sprintf para
+// os argumentos, que são os mesmos em todos os idiomas.
+var catalogoMensagens = map[CodigoMensagem]map[Idioma]string{
+	MsgEstadoSiglaNaoEncontrado: {
+		IdiomaPtBR: "estado com a sigla %s não encontrado",
+		IdiomaEn:   "state with abbreviation %s not found",
+	},
+	MsgEstadoCodigoNaoEncontrado: {
+		IdiomaPtBR: "estado com o código ibge %s não encontrado",
+		IdiomaEn:   "state with IBGE code %s not found",
+	},
+	MsgEstadoCEPNaoEncontrado: {
+		IdiomaPtBR: "nenhum estado encontrado para o CEP %s",
+		IdiomaEn:   "no state found for postal code (CEP) %s",
+	},
+	MsgCidadeNaoEncontrada: {
+		IdiomaPtBR: "cidade com código IBGE %v não encontrada",
+		IdiomaEn:   "city with IBGE code %v not found",
+	},
+	MsgCidadeTOMNaoEncontrada: {
+		IdiomaPtBR: "cidade com código TOM %s não encontrada",
+		IdiomaEn:   "city with TOM code %s not found",
+	},
+	MsgCidadeCEPNaoEncontrada: {
+		IdiomaPtBR: "nenhuma cidade encontrada para o CEP %s",
+		IdiomaEn:   "no city found for postal code (CEP) %s",
+	},
+	MsgCidadeInexistenteNaData: {
+		IdiomaPtBR: "a cidade com código IBGE %d não existia em %s",
+		IdiomaEn:   "the city with IBGE code %d did not exist on %s",
+	},
+	MsgCidadeExtinta: {
+		IdiomaPtBR: "o município %s (código IBGE %d) foi extinto em %s",
+		IdiomaEn:   "the municipality %s (IBGE code %d) was abolished on %s",
+	},
+	MsgRegiaoNaoEncontrada: {
+		IdiomaPtBR: "região %s não encontrada",
+		IdiomaEn:   "region %s not found",
+	},
+	MsgFusoNaoCadastrado: {
+		IdiomaPtBR: "fuso horário da cidade com código IBGE %s não cadastrado",
+		IdiomaEn:   "time zone of the city with IBGE code %s not registered",
+	},
+	MsgCodigoIBGEInvalido: {
+		IdiomaPtBR: "código IBGE inválido: %s deve ser um número",
+		IdiomaEn:   "invalid IBGE code: %s must be a number",
+	},
+	MsgCodigoTOMInvalido: {
+		IdiomaPtBR: "código TOM inválido: %s deve ser um número",
+		IdiomaEn:   "invalid TOM code: %s must be a number",
+	},
+	MsgCEPInvalido: {
+		IdiomaPtBR: "CEP inválido: deve conter 8 dígitos",
+		IdiomaEn:   "invalid postal code (CEP): it must have 8 digits",
+	},
+	MsgNomeCidadeInvalido: {
+		IdiomaPtBR: "nome de cidade inválido: %q",
+		IdiomaEn:   "invalid city name: %q",
+	},
+	MsgDataInvalida: {
+		IdiomaPtBR: "data inválida: use o formato AAAA-MM-DD",
+		IdiomaEn:   "invalid date: use the YYYY-MM-DD format",
+	},
+	MsgDataSemHistorico: {
+		IdiomaPtBR: "o parâmetro data não é aceito nas consultas de estados: o histórico territorial cobre apenas os municípios",
+		IdiomaEn:   "the data parameter is not accepted in state lookups: the territorial history covers municipalities only",
+	},
+	MsgOrdenacaoInvalida: {
+		IdiomaPtBR: "campo de ordenação inválido: %q (use %s)",
+		IdiomaEn:   "invalid sort field: %q (use %s)",
+	},
+	MsgVersaoDatasetNaoEncontrada: {
+		IdiomaPtBR: "versão do conjunto de dados %s não encontrada; disponíveis: %v",
+		IdiomaEn:   "dataset version %s not found; available: %v",
+	},
+	MsgVersaoAlteracoesInvalida: {
+		IdiomaPtBR: "versão %d inválida: deve estar entre 0 e a versão atual (%d)",
+		IdiomaEn:   "invalid version %d: it must be between 0 and the current version (%d)",
+	},
+	MsgFormatoInvalido: {
+		IdiomaPtBR: "formato %q inválido (use %s)",
+		IdiomaEn:   "invalid format %q (use %s)",
+	},
+	MsgFormatoSemEsquema: {
+		IdiomaPtBR: "esta resposta não tem mensagem definida em %s; use outro formato",
+		IdiomaEn:   "this response has no message defined in %s; use another format",
+	},
+	MsgFormatoDownloadNaoEncontrado: {
+		IdiomaPtBR: "formato de download %s não encontrado (use xlsx, csv ou sql)",
+		IdiomaEn:   "download format %s not found (use xlsx, csv or sql)",
+	},
+	MsgCampoInvalido: {
+		IdiomaPtBR: "campo %q inválido em fields (use %s)",
+		IdiomaEn:   "invalid field %q in fields (use %s)",
+	},
+	MsgCampoSemSubcampos: {
+		IdiomaPtBR: "o campo %q de fields não tem subcampos",
+		IdiomaEn:   "the field %q in fields has no subfields",
+	},
+	MsgExpansaoInvalida: {
+		IdiomaPtBR: "expansão %q inválida (use %s)",
+		IdiomaEn:   "invalid expansion %q (use %s)",
+	},
+	MsgExpansaoIndisponivel: {
+		IdiomaPtBR: "este recurso não aceita expand (recebido %q)",
+		IdiomaEn:   "this resource does not accept expand (got %q)",
+	},
+	MsgValorBooleanoInvalido: {
+		IdiomaPtBR: "valor inválido para %s: %s (use true ou false)",
+		IdiomaEn:   "invalid value for %s: %s (use true or false)",
+	},
+	MsgUFFiltroNaoEncontrada: {
+		IdiomaPtBR: "uf %s não encontrada",
+		IdiomaEn:   "uf %s not found",
+	},
+	MsgRegiaoFiltroNaoEncontrada: {
+		IdiomaPtBR: "região %s não encontrada",
+		IdiomaEn:   "region %s not found",
+	},
+	MsgCursorInvalido: {
+		IdiomaPtBR: "cursor inválido: %q",
+		IdiomaEn:   "invalid cursor: %q",
+	},
+	MsgCursorOutraVersao: {
+		IdiomaPtBR: "cursor gerado para a versão %s do conjunto de dados, não para a %s",
+		IdiomaEn:   "cursor generated for dataset version %s, not for %s",
+	},
+	MsgPaginaECursor: {
+		IdiomaPtBR: "use pagina ou cursor, não os dois",
+		IdiomaEn:   "use pagina or cursor, not both",
+	},
+	MsgPaginaInvalida: {
+		IdiomaPtBR: "parâmetro pagina inválido: %q (a primeira página é 1)",
+		IdiomaEn:   "invalid pagina parameter: %q (the first page is 1)",
+	},
+	MsgPorPaginaInvalido: {
+		IdiomaPtBR: "parâmetro por_pagina inválido: %q (use de 1 a %d)",
+		IdiomaEn:   "invalid por_pagina parameter: %q (use 1 to %d)",
+	},
+	MsgNomeBuscaCurto: {
+		IdiomaPtBR: "o parâmetro nome deve ter ao menos 2 caracteres",
+		IdiomaEn:   "the nome parameter must have at least 2 characters",
+	},
+	MsgCidadeEUFObrigatorios: {
+		IdiomaPtBR: "os parâmetros cidade e uf são obrigatórios",
+		IdiomaEn:   "the cidade and uf parameters are required",
+	},
+	MsgCidadeObrigatoria: {
+		IdiomaPtBR: "o parâmetro cidade é obrigatório",
+		IdiomaEn:   "the cidade parameter is required",
+	},
+	MsgAnoInvalido: {
+		IdiomaPtBR: "ano inválido: deve ser um número entre 1900 e 2199",
+		IdiomaEn:   "invalid ano: it must be a number between 1900 and 2199",
+	},
+	MsgInicioInvalido: {
+		IdiomaPtBR: "inicio inválido: use o formato AAAA-MM-DD",
+		IdiomaEn:   "invalid inicio: use the YYYY-MM-DD format",
+	},
+	MsgDiasInvalido: {
+		IdiomaPtBR: "dias inválido: deve ser um número entre %d e %d",
+		IdiomaEn:   "invalid dias: it must be a number between %d and %d",
+	},
+	MsgDesdeInvalido: {
+		IdiomaPtBR: "desde inválido: deve ser um número inteiro",
+		IdiomaEn:   "invalid desde: it must be an integer",
+	},
+	MsgCorpoInvalido: {
+		IdiomaPtBR: "corpo da requisição inválido: %v",
+		IdiomaEn:   "invalid request body: %v",
+	},
+	MsgGraphQLEstadoSemArgumento: {
+		IdiomaPtBR: "informe uf ou codigo",
+		IdiomaEn:   "provide uf or codigo",
+	},
+	MsgGraphQLCidadeSemArgumento: {
+		IdiomaPtBR: "informe codigo ou tom",
+		IdiomaEn:   "provide codigo or tom",
+	},
+	MsgGraphQLProfundidade: {
+		IdiomaPtBR: "consulta com profundidade %d excede o limite de %d",
+		IdiomaEn:   "query depth %d exceeds the limit of %d",
+	},
+	MsgGraphQLComplexidade: {
+		IdiomaPtBR: "consulta com complexidade %d excede o limite de %d",
+		IdiomaEn:   "query complexity %d exceeds the limit of %d",
+	},
+	MsgGRPCEstadoSemIdentificador: {
+		IdiomaPtBR: "informe uf ou codigo_ibge",
+		IdiomaEn:   "provide uf or codigo_ibge",
+	},
+	MsgGRPCCidadeSemIdentificador: {
+		IdiomaPtBR: "informe codigo_ibge ou codigo_tom",
+		IdiomaEn:   "provide codigo_ibge or codigo_tom",
+	},
+	MsgGRPCNomeObrigatorio: {
+		IdiomaPtBR: "o nome é obrigatório",
+		IdiomaEn:   "the name is required",
+	},
+	MsgErroInterno: {
+		IdiomaPtBR: "Erro interno do servidor",
+		IdiomaEn:   "Internal server error",
+	},
+	MsgCEPDeOutroEstado: {
+		IdiomaPtBR: "o CEP %s pertence ao estado %s, não a %s",
+		IdiomaEn:   "postal code (CEP) %s belongs to the state %s, not %s",
+	},
+	MsgCEPSemFaixaMunicipal: {
+		IdiomaPtBR: "não há faixa municipal cadastrada para o CEP %s",
+		IdiomaEn:   "there is no municipal range registered for postal code (CEP) %s",
+	},
+	MsgCEPDeOutraCidade: {
+		IdiomaPtBR: "o CEP %s pertence a %s/%s, não a %s",
+		IdiomaEn:   "postal code (CEP) %s belongs to %s/%s, not %s",
+	},
+	MsgTituloParametroInvalido: {
+		IdiomaPtBR: "Parâmetro inválido",
+		IdiomaEn:   "Invalid parameter",
+	},
+	MsgTituloCodigoInvalido: {
+		IdiomaPtBR: "Código inválido",
+		IdiomaEn:   "Invalid code",
+	},
+	MsgTituloNaoEncontrado: {
+		IdiomaPtBR: "Recurso não encontrado",
+		IdiomaEn:   "Resource not found",
+	},
+	MsgTituloMunicipioExtinto: {
+		IdiomaPtBR: "Município extinto",
+		IdiomaEn:   "Abolished municipality",
+	},
+	MsgTituloErroInterno: {
+		IdiomaPtBR: "Erro interno do servidor",
+		IdiomaEn:   "Internal Server Error",
+	},
+}
+
+// Mensagem retorna o texto da mensagem no idioma, com os argumentos. Sem tradução para o idioma, a
+// mensagem sai no idioma padrão; um código fora do catálogo é retornado como está.
+func Mensagem(idioma Idioma, codigo CodigoMensagem, args ...interface{}) string {
+	traducoes, found := catalogoMensagens[codigo]
+	if !found {
+		return string(codigo)
+	}
+	formato, found := traducoes[idioma]
+	if !found {
+		formato = traducoes[Idiomas[0]]
+	}
+	return fmt.Sprintf(formato, args...)
+}
+
+// IdiomaPreferido escolhe o idioma do catálogo pela lista de preferências no formato do header
+// Accept-Language (ex: "en-US,en;q=0.9,pt;q=0.5"), respeitando o fator q. As tags são comparadas
+// pelo idioma principal (pt-PT e pt servem pt-BR, en-GB serve en); sem nenhuma correspondência,
+// retorna o idioma padrão.
+func IdiomaPreferido(preferencias string) Idioma {
+	escolhido, melhorQ := Idiomas[0], 0.0
+	for _, parte := range strings.Split(preferencias, ",") {
+		tag, params, _ := strings.Cut(parte, ";")
+		q := 1.0
+		if valor, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if q, err = strconv.ParseFloat(valor, 64); err != nil {
+				continue
+			}
+		}
+		principal, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+		for _, idioma := range Idiomas {
+			candidato, _, _ := strings.Cut(string(idioma), "-")
+			if principal != "*" && !strings.EqualFold(principal, candidato) {
+				continue
+			}
+			if q > melhorQ {
+				escolhido, melhorQ = idioma, q
+			}
+			break
+		}
+	}
+	return escolhido
+}
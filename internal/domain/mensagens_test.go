@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"testing"
+)
+
+// codigosDeclarados lê de mensagens.go as constantes do tipo CodigoMensagem, para que um código
+// novo sem tradução falhe o teste mesmo que ninguém se lembre de incluí-lo em uma lista.
+func codigosDeclarados(t *testing.T) map[string]CodigoMensagem {
+	t.Helper()
+	arquivo, err := parser.ParseFile(token.NewFileSet(), "mensagens.go", nil, 0)
+	if err != nil {
+		t.Fatalf("Erro ao ler mensagens.go: %v", err)
+	}
+	codigos := map[string]CodigoMensagem{}
+	for _, decl := range arquivo.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			valor := spec.(*ast.ValueSpec)
+			if tipo, ok := valor.Type.(*ast.Ident); !ok || tipo.Name != "CodigoMensagem" {
+				continue
+			}
+			for i, nome := range valor.Names {
+				literal, ok := valor.Values[i].(*ast.BasicLit)
+				if !ok {
+					t.Fatalf("Constante %s sem valor literal", nome.Name)
+				}
+				codigos[nome.Name] = CodigoMensagem(literal.Value[1 : len(literal.Value)-1])
+			}
+		}
+	}
+	if len(codigos) == 0 {
+		t.Fatal("Nenhuma constante CodigoMensagem encontrada em mensagens.go")
+	}
+	return codigos
+}
+
+// verbosFormato extrai os verbos de fmt (%s, %d, %v...) na ordem em que aparecem.
+var verbosFormato = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+func TestCatalogoMensagens(t *testing.T) {
+	codigos := codigosDeclarados(t)
+
+	t.Run("todo código deve ter texto em todos os idiomas", func(t *testing.T) {
+		nomes := make([]string, 0, len(codigos))
+		for nome := range codigos {
+			nomes = append(nomes, nome)
+		}
+		sort.Strings(nomes)
+		for _, nome := range nomes {
+			traducoes, found := catalogoMensagens[codigos[nome]]
+			if !found {
+				t.Errorf("%s (%q) não está no catálogo", nome, codigos[nome])
+				continue
+			}
+			for _, idioma := range Idiomas {
+				if traducoes[idioma] == "" {
+					t.Errorf("%s (%q) sem texto em %s", nome, codigos[nome], idioma)
+				}
+			}
+		}
+	})
+
+	t.Run("as traduções devem usar os mesmos argumentos", func(t *testing.T) {
+		for codigo, traducoes := range catalogoMensagens {
+			padrao := verbosFormato.FindAllString(traducoes[Idiomas[0]], -1)
+			for _, idioma := range Idiomas[1:] {
+				verbos := verbosFormato.FindAllString(traducoes[idioma], -1)
+				if len(verbos) != len(padrao) {
+					t.Errorf("%q: %s usa %v e %s usa %v", codigo, Idiomas[0], padrao, idioma, verbos)
+					continue
+				}
+				for i := range verbos {
+					if verbos[i] != padrao[i] {
+						t.Errorf("%q: %s usa %v e %s usa %v", codigo, Idiomas[0], padrao, idioma, verbos)
+						break
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("o catálogo não deve ter códigos sem constante", func(t *testing.T) {
+		declarados := map[CodigoMensagem]bool{}
+		for _, codigo := range codigos {
+			declarados[codigo] = true
+		}
+		for codigo := range catalogoMensagens {
+			if !declarados[codigo] {
+				t.Errorf("%q está no catálogo mas não tem constante em mensagens.go", codigo)
+			}
+		}
+	})
+}
+
+func TestIdiomaPreferido(t *testing.T) {
+	casos := []struct {
+		preferencias string
+		esperado     Idioma
+	}{
+		{"", IdiomaPtBR},
+		{"en", IdiomaEn},
+		{"en-US,en;q=0.9", IdiomaEn},
+		{"pt-PT", IdiomaPtBR},
+		{"en;q=0.4,pt;q=0.8", IdiomaPtBR},
+		{"fr,en;q=0.5", IdiomaEn},
+		{"fr", IdiomaPtBR},
+		{"*", IdiomaPtBR},
+	}
+	for _, caso := range casos {
+		if obtido := IdiomaPreferido(caso.preferencias); obtido != caso.esperado {
+			t.Errorf("IdiomaPreferido(%q) = %s, esperado %s", caso.preferencias, obtido, caso.esperado)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "strings"
+
+// acentos mapeia caracteres acentuados para sua forma sem acento.
+var acentos = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// separadores são tratados como espaço na comparação de nomes (ex: "S. J. dos Campos", "Moji-Guaçu").
+var separadores = strings.NewReplacer("-", " ", "'", " ", "’", " ", ".", " ")
+
+// NormalizarNome prepara um nome para comparação: caixa baixa, sem acentos,
+// com hífens, pontos e apóstrofos tratados como espaço e espaços repetidos removidos.
+func NormalizarNome(nome string) string {
+	nome = acentos.Replace(strings.ToLower(nome))
+	nome = separadores.Replace(nome)
+	return strings.Join(strings.Fields(nome), " ")
+}
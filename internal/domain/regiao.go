@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Regiao representa uma das cinco Grandes Regiões do Brasil.
+type Regiao struct {
+	CodigoIBGE int    `json:"codigo_ibge"`
+	Sigla      string `json:"sigla"`
+	Nome       string `json:"nome"`
+}
+
+// regioes lista as Grandes Regiões na ordem dos códigos IBGE. O código da região é o
+// primeiro dígito do código IBGE de cada estado (ex: 35, São Paulo, pertence à região 3).
+var regioes = []Regiao{
+	{CodigoIBGE: 1, Sigla: "N", Nome: "Norte"},
+	{CodigoIBGE: 2, Sigla: "NE", Nome: "Nordeste"},
+	{CodigoIBGE: 3, Sigla: "SE", Nome: "Sudeste"},
+	{CodigoIBGE: 4, Sigla: "S", Nome: "Sul"},
+	{CodigoIBGE: 5, Sigla: "CO", Nome: "Centro-Oeste"},
+}
+
+// Regioes retorna as cinco Grandes Regiões, ordenadas pelo código IBGE.
+func Regioes() []Regiao {
+	return append([]Regiao(nil), regioes...)
+}
+
+// RegiaoPorCodigoOuSigla encontra uma região pelo código IBGE (ex: "3") ou pela sigla (ex: "SE").
+func RegiaoPorCodigoOuSigla(codigoOuSigla string) (Regiao, bool) {
+	for _, r := range regioes {
+		if codigoOuSigla == strconv.Itoa(r.CodigoIBGE) || strings.EqualFold(codigoOuSigla, r.Sigla) {
+			return r, true
+		}
+	}
+	return Regiao{}, false
+}
+
+// Regiao retorna a Grande Região a que o estado pertence.
+func (e Estado) Regiao() Regiao {
+	codigo := e.CodigoIBGE / 10
+	if codigo < 1 || codigo > len(regioes) {
+		return Regiao{}
+	}
+	return regioes[codigo-1]
+}
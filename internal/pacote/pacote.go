@@ -0,0 +1,142 @@
+package pacote
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// Formato é um formato de pacote para download.
+type Formato struct {
+	Nome         string // Valor usado na URL (ex: xlsx)
+	Extensao     string // Sufixo do nome do arquivo (ex: .xlsx, -csv.zip)
+	TipoConteudo string
+	gerar        func(w io.Writer, tabelas []Tabela) error
+}
+
+// Formatos lista os formatos de pacote disponíveis.
+var Formatos = []Formato{
+	{Nome: "xlsx", Extensao: ".xlsx", TipoConteudo: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", gerar: gerarXLSX},
+	{Nome: "csv", Extensao: "-csv.zip", TipoConteudo: "application/zip", gerar: gerarZipCSV},
+	{Nome: "sql", Extensao: ".sql", TipoConteudo: "application/sql; charset=utf-8", gerar: gerarSQL},
+}
+
+// FormatoPorNome busca um formato pelo nome, sem diferenciar caixa.
+func FormatoPorNome(nome string) (Formato, bool) {
+	for _, f := range Formatos {
+		if strings.EqualFold(f.Nome, nome) {
+			return f, true
+		}
+	}
+	return Formato{}, false
+}
+
+// NomeArquivo retorna o nome do arquivo do pacote para a edição informada (ex: ibge-2022.xlsx).
+func (f Formato) NomeArquivo(versao string) string {
+	return "ibge-" + versao + f.Extensao
+}
+
+// Gerar monta o pacote com todas as tabelas do conjunto de dados. O conteúdo depende apenas
+// dos dados (os arquivos do ZIP não levam data de modificação), então a mesma edição sempre
+// gera os mesmos bytes e o mesmo checksum.
+func Gerar(f Formato, dados *domain.ConjuntoDados) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := f.gerar(&buf, Tabelas(dados)); err != nil {
+		return nil, fmt.Errorf("falha ao gerar o pacote %s: %w", f.Nome, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// gerarZipCSV escreve um arquivo CSV por tabela (ex: cidades.csv), com linha de cabeçalho.
+// Valores NULL viram campos vazios.
+func gerarZipCSV(w io.Writer, tabelas []Tabela) error {
+	arquivo := zip.NewWriter(w)
+	for _, t := range tabelas {
+		entrada, err := arquivo.CreateHeader(&zip.FileHeader{Name: t.Nome + ".csv", Method: zip.Deflate})
+		if err != nil {
+			return err
+		}
+		escritor := csv.NewWriter(entrada)
+		if err := escritor.Write(t.NomesColunas()); err != nil {
+			return err
+		}
+		for _, linha := range t.Linhas {
+			registro := make([]string, len(linha))
+			for i, valor := range linha {
+				if valor != nil {
+					registro[i] = fmt.Sprint(valor)
+				}
+			}
+			if err := escritor.Write(registro); err != nil {
+				return err
+			}
+		}
+		escritor.Flush()
+		if err := escritor.Error(); err != nil {
+			return err
+		}
+	}
+	return arquivo.Close()
+}
+
+// linhasPorInsert limita a quantidade de linhas de cada INSERT do dump SQL.
+const linhasPorInsert = 500
+
+// gerarSQL escreve um dump com CREATE TABLE e INSERT para cada tabela, em SQL padrão aceito
+// por SQLite, PostgreSQL e MySQL, dentro de uma única transação.
+func gerarSQL(w io.Writer, tabelas []Tabela) error {
+	var b strings.Builder
+	b.WriteString("-- Conjunto de dados do IBGE, no esquema de create_ibge.sql.\n")
+	b.WriteString("-- Sem a tabela alteracoes, o log de sincronização do seed (ver /api/v1/alteracoes).\n")
+	b.WriteString("BEGIN;\n")
+	for _, t := range tabelas {
+		definicoes := make([]string, 0, len(t.Colunas)+len(t.Restricoes))
+		for _, c := range t.Colunas {
+			definicoes = append(definicoes, c.Nome+" "+c.Tipo)
+		}
+		definicoes = append(definicoes, t.Restricoes...)
+		fmt.Fprintf(&b, "\nCREATE TABLE %s (\n    %s\n);\n", t.Nome, strings.Join(definicoes, ",\n    "))
+		for _, indice := range t.Indices {
+			b.WriteString(indice + ";\n")
+		}
+
+		colunas := strings.Join(t.NomesColunas(), ", ")
+		for inicio := 0; inicio < len(t.Linhas); inicio += linhasPorInsert {
+			fim := min(inicio+linhasPorInsert, len(t.Linhas))
+			fmt.Fprintf(&b, "INSERT INTO %s (%s) VALUES\n", t.Nome, colunas)
+			for i, linha := range t.Linhas[inicio:fim] {
+				valores := make([]string, len(linha))
+				for j, valor := range linha {
+					valores[j] = valorSQL(valor)
+				}
+				separador := ",\n"
+				if inicio+i == fim-1 {
+					separador = ";\n"
+				}
+				b.WriteString("    (" + strings.Join(valores, ", ") + ")" + separador)
+			}
+		}
+	}
+	b.WriteString("\nCOMMIT;\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// valorSQL formata um valor como literal SQL, escapando aspas simples nos textos.
+func valorSQL(valor interface{}) string {
+	switch v := valor.(type) {
+	case nil:
+		return "NULL"
+	case int:
+		return strconv.Itoa(v)
+	default:
+		return "'" + strings.ReplaceAll(fmt.Sprint(v), "'", "''") + "'"
+	}
+}
@@ -0,0 +1,221 @@
+package pacote
+
+import (
+	"archive/zip"
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func novoConjuntoDados() *domain.ConjuntoDados {
+	carnaval, naFronteira := -47, true
+	return &domain.ConjuntoDados{
+		Versao: 3,
+		Estados: []domain.Estado{
+			{CodigoIBGE: 35, Nome: "São Paulo", Sigla: "SP", FusoHorario: "America/Sao_Paulo", Feriados: []domain.RegraFeriado{
+				{Nome: "Revolução Constitucionalista de 1932", Tipo: domain.FeriadoEstadual, Dia: 9, Mes: 7},
+			}},
+			{CodigoIBGE: 50, Nome: "Mato Grosso do Sul", Sigla: "MS"},
+		},
+		Cidades: []domain.Cidade{
+			{CodigoIBGE: 3550308, Nome: "São Paulo", CodigoTOM: "7107", MicroRegiao: "35061", Gentilico: "paulistano", EstadoCodigoIBGE: 35, EstadoSigla: "SP",
+				Feriados: []domain.RegraFeriado{{Nome: "Carnaval municipal", Tipo: domain.FeriadoMunicipal, DiasAposPascoa: &carnaval}}},
+			{CodigoIBGE: 3513801, Nome: "Embu das Artes", CodigoTOM: "6443", EstadoCodigoIBGE: 35, EstadoSigla: "SP", Aliases: []string{"Embu", "M'Boy"},
+				Historico: []domain.EventoMunicipio{{CodigoIBGE: 3513801, Tipo: domain.EventoRenomeacao, Data: "2011-03-18", Nome: "Embu das Artes", NomeAnterior: "Embu"}}},
+			{CodigoIBGE: 5003207, Nome: "Corumbá", EstadoCodigoIBGE: 50, EstadoSigla: "MS", FaixaFronteira: &naFronteira, PaisesFronteira: []string{"Bolívia", "Paraguai"}},
+		},
+		CidadesExtintas: []domain.Cidade{
+			{CodigoIBGE: 3500000, Nome: "Vila Extinta", EstadoSigla: "SP", Historico: []domain.EventoMunicipio{
+				{CodigoIBGE: 3500000, Tipo: domain.EventoExtincao, Data: "2005-01-01", UF: "SP", Sucessores: []int{3550308, 3513801}},
+			}},
+		},
+		FaixasCEP: []domain.FaixaCEP{
+			{UF: "SP", CEPInicial: "01000000", CEPFinal: "19999999"},
+			{UF: "SP", CodigoIBGE: 3550308, CEPInicial: "01000000", CEPFinal: "05999999"},
+		},
+	}
+}
+
+func TestGerarSQL(t *testing.T) {
+	formato, _ := FormatoPorNome("sql")
+	dump, err := Gerar(formato, novoConjuntoDados())
+	if err != nil {
+		t.Fatalf("Erro ao gerar o dump: %v", err)
+	}
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Erro ao abrir o SQLite: %v", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(string(dump)); err != nil {
+		t.Fatalf("O dump deveria ser carregado pelo SQLite: %v", err)
+	}
+
+	contagens := map[string]int{
+		"estados":                     2,
+		"cidades":                     3,
+		"faixas_cep":                  2,
+		"feriados":                    2,
+		"classificacoes_territoriais": 1,
+		"cidades_aliases":             2,
+		"municipios_historico":        2,
+		"metadados":                   1,
+	}
+	for tabela, esperado := range contagens {
+		var total int
+		if err := db.QueryRow("SELECT COUNT(*) FROM " + tabela).Scan(&total); err != nil {
+			t.Fatalf("Erro ao contar %s: %v", tabela, err)
+		}
+		if total != esperado {
+			t.Errorf("Quantidade de linhas em %s incorreta: got %d want %d", tabela, total, esperado)
+		}
+	}
+
+	var codigoTOM sql.NullInt64
+	var regiaoImediata sql.NullInt64
+	if err := db.QueryRow("SELECT codigo_tom, regiao_imediata FROM cidades WHERE codigo_ibge = 3550308").Scan(&codigoTOM, &regiaoImediata); err != nil {
+		t.Fatal(err)
+	}
+	if codigoTOM.Int64 != 7107 || regiaoImediata.Valid {
+		t.Errorf("Colunas de cidades incorretas: codigo_tom=%v regiao_imediata=%v", codigoTOM, regiaoImediata)
+	}
+
+	var alias string
+	if err := db.QueryRow("SELECT alias FROM cidades_aliases WHERE alias LIKE 'M%'").Scan(&alias); err != nil || alias != "M'Boy" {
+		t.Errorf("Aspas simples deveriam ser escapadas: got %q (%v)", alias, err)
+	}
+
+	var paises string
+	if err := db.QueryRow("SELECT paises_fronteira FROM classificacoes_territoriais WHERE codigo_ibge = 5003207").Scan(&paises); err != nil || paises != "Bolívia,Paraguai" {
+		t.Errorf("Países da fronteira incorretos: got %q (%v)", paises, err)
+	}
+
+	var dia, mes sql.NullInt64
+	var diasAposPascoa int
+	if err := db.QueryRow("SELECT dia, mes, dias_apos_pascoa FROM feriados WHERE codigo_ibge = 3550308").Scan(&dia, &mes, &diasAposPascoa); err != nil {
+		t.Fatal(err)
+	}
+	if dia.Valid || mes.Valid || diasAposPascoa != -47 {
+		t.Errorf("Feriado móvel incorreto: dia=%v mes=%v dias_apos_pascoa=%d", dia, mes, diasAposPascoa)
+	}
+
+	var sucessores string
+	if err := db.QueryRow("SELECT sucessores FROM municipios_historico WHERE tipo = 'extincao'").Scan(&sucessores); err != nil || sucessores != "3550308,3513801" {
+		t.Errorf("Sucessores incorretos: got %q (%v)", sucessores, err)
+	}
+}
+
+func TestGerarZipCSV(t *testing.T) {
+	formato, _ := FormatoPorNome("CSV")
+	conteudo, err := Gerar(formato, novoConjuntoDados())
+	if err != nil {
+		t.Fatalf("Erro ao gerar o ZIP: %v", err)
+	}
+
+	arquivos := lerZip(t, conteudo)
+	esperados := []string{"estados.csv", "cidades.csv", "faixas_cep.csv", "feriados.csv", "classificacoes_territoriais.csv",
+		"cidades_aliases.csv", "municipios_historico.csv", "metadados.csv"}
+	for _, nome := range esperados {
+		if _, found := arquivos[nome]; !found {
+			t.Errorf("Arquivo %s ausente do ZIP", nome)
+		}
+	}
+
+	registros, err := csv.NewReader(strings.NewReader(arquivos["cidades.csv"])).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV de cidades inválido: %v", err)
+	}
+	cabecalho := []string{"codigo_ibge", "nome", "codigo_tom", "micro_regiao", "regiao_imediata", "fuso_horario", "gentilico", "estado_codigo_ibge"}
+	if !reflect.DeepEqual(registros[0], cabecalho) {
+		t.Errorf("Cabeçalho incorreto: got %v want %v", registros[0], cabecalho)
+	}
+	primeira := []string{"3550308", "São Paulo", "7107", "35061", "", "", "paulistano", "35"}
+	if !reflect.DeepEqual(registros[1], primeira) {
+		t.Errorf("Linha incorreta: got %v want %v", registros[1], primeira)
+	}
+
+	// Sem data de modificação nos arquivos, a mesma edição gera sempre o mesmo conteúdo
+	novamente, _ := Gerar(formato, novoConjuntoDados())
+	if !bytes.Equal(conteudo, novamente) {
+		t.Error("O pacote deveria ser determinístico")
+	}
+}
+
+func TestGerarXLSX(t *testing.T) {
+	formato, _ := FormatoPorNome("xlsx")
+	conteudo, err := Gerar(formato, novoConjuntoDados())
+	if err != nil {
+		t.Fatalf("Erro ao gerar a planilha: %v", err)
+	}
+
+	partes := lerZip(t, conteudo)
+	for nome, parte := range partes {
+		decoder := xml.NewDecoder(strings.NewReader(parte))
+		for {
+			if _, err := decoder.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("Parte %s com XML inválido: %v", nome, err)
+			}
+		}
+	}
+
+	for _, aba := range []string{`name="estados"`, `name="cidades"`, `name="metadados"`} {
+		if !strings.Contains(partes["xl/workbook.xml"], aba) {
+			t.Errorf("Aba %s ausente do workbook", aba)
+		}
+	}
+	if _, found := partes["xl/worksheets/sheet8.xml"]; !found {
+		t.Error("Deveria haver uma aba por tabela")
+	}
+
+	cidades := partes["xl/worksheets/sheet2.xml"]
+	for _, esperado := range []string{
+		`<c r="A1" s="1" t="inlineStr"><is><t>codigo_ibge</t></is></c>`,
+		`<c r="A2"><v>3550308</v></c>`,
+		`<c r="B3" t="inlineStr"><is><t xml:space="preserve">Embu das Artes</t></is></c>`,
+	} {
+		if !strings.Contains(cidades, esperado) {
+			t.Errorf("Aba de cidades deveria conter %s", esperado)
+		}
+	}
+}
+
+func TestColunaXLSX(t *testing.T) {
+	for indice, esperado := range map[int]string{0: "A", 7: "H", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		if coluna := colunaXLSX(indice); coluna != esperado {
+			t.Errorf("colunaXLSX(%d) = %s, want %s", indice, coluna, esperado)
+		}
+	}
+}
+
+func lerZip(t *testing.T, conteudo []byte) map[string]string {
+	t.Helper()
+	leitor, err := zip.NewReader(bytes.NewReader(conteudo), int64(len(conteudo)))
+	if err != nil {
+		t.Fatalf("ZIP inválido: %v", err)
+	}
+	arquivos := map[string]string{}
+	for _, f := range leitor.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		dados, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		arquivos[f.Name] = string(dados)
+	}
+	return arquivos
+}
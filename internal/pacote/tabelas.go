@@ -0,0 +1,234 @@
+// Package pacote gera pacotes para download com a edição completa do conjunto de dados:
+// uma planilha XLSX, um ZIP de arquivos CSV e um dump SQL portável, todos com as tabelas e
+// colunas de create_ibge.sql, exceto o log de alterações do seed.
+package pacote
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// Coluna é uma coluna de tabela, com o tipo e as restrições da definição em create_ibge.sql.
+type Coluna struct {
+	Nome string
+	Tipo string // Ex: "INT PRIMARY KEY", "VARCHAR(50) NOT NULL"
+}
+
+// Tabela é uma tabela do conjunto de dados. Os valores das linhas são int, string ou nil (NULL).
+type Tabela struct {
+	Nome       string
+	Colunas    []Coluna
+	Restricoes []string // Chaves primárias compostas e estrangeiras
+	Indices    []string // Comandos CREATE INDEX
+	Linhas     [][]interface{}
+}
+
+// NomesColunas retorna os nomes das colunas, na ordem da tabela.
+func (t Tabela) NomesColunas() []string {
+	nomes := make([]string, len(t.Colunas))
+	for i, c := range t.Colunas {
+		nomes[i] = c.Nome
+	}
+	return nomes
+}
+
+// Tabelas converte o conjunto de dados nas tabelas de create_ibge.sql, em ordem que respeita
+// as chaves estrangeiras. A tabela de alterações, que é o log de sincronização do seed, não
+// faz parte do pacote.
+func Tabelas(dados *domain.ConjuntoDados) []Tabela {
+	estados := Tabela{
+		Nome: "estados",
+		Colunas: []Coluna{
+			{"codigo_ibge", "INT PRIMARY KEY"},
+			{"nome", "VARCHAR(30) NOT NULL UNIQUE"},
+			{"sigla", "CHAR(2) NOT NULL UNIQUE"},
+			{"fuso_horario", "VARCHAR(40)"},
+		},
+	}
+	cidades := Tabela{
+		Nome: "cidades",
+		Colunas: []Coluna{
+			{"codigo_ibge", "INT PRIMARY KEY"},
+			{"nome", "VARCHAR(50) NOT NULL"},
+			{"codigo_tom", "INT"},
+			{"micro_regiao", "INT"},
+			{"regiao_imediata", "INT"},
+			{"fuso_horario", "VARCHAR(40)"},
+			{"gentilico", "VARCHAR(60)"},
+			{"estado_codigo_ibge", "INT NOT NULL"},
+		},
+		Restricoes: []string{"CONSTRAINT fk_estado FOREIGN KEY(estado_codigo_ibge) REFERENCES estados(codigo_ibge)"},
+		Indices:    []string{"CREATE INDEX idx_cidades_por_estado ON cidades(estado_codigo_ibge)"},
+	}
+	faixasCEP := Tabela{
+		Nome: "faixas_cep",
+		Colunas: []Coluna{
+			{"cep_inicial", "CHAR(8) NOT NULL"},
+			{"cep_final", "CHAR(8) NOT NULL"},
+			{"uf", "CHAR(2) NOT NULL"},
+			{"codigo_ibge", "INT NOT NULL DEFAULT 0"},
+		},
+		Restricoes: []string{"PRIMARY KEY(cep_inicial, codigo_ibge)"},
+	}
+	feriados := Tabela{
+		Nome: "feriados",
+		Colunas: []Coluna{
+			{"uf", "CHAR(2) NOT NULL"},
+			{"codigo_ibge", "INT NOT NULL DEFAULT 0"},
+			{"nome", "VARCHAR(80) NOT NULL"},
+			{"dia", "INT"},
+			{"mes", "INT"},
+			{"dias_apos_pascoa", "INT"},
+		},
+		Restricoes: []string{"PRIMARY KEY(uf, codigo_ibge, nome)"},
+	}
+	classificacoes := Tabela{
+		Nome: "classificacoes_territoriais",
+		Colunas: []Coluna{
+			{"codigo_ibge", "INT NOT NULL"},
+			{"classificacao", "VARCHAR(20) NOT NULL"},
+			{"paises_fronteira", "VARCHAR(200)"},
+		},
+		Restricoes: []string{
+			"PRIMARY KEY(codigo_ibge, classificacao)",
+			"CONSTRAINT fk_cidade FOREIGN KEY(codigo_ibge) REFERENCES cidades(codigo_ibge)",
+		},
+	}
+	aliases := Tabela{
+		Nome: "cidades_aliases",
+		Colunas: []Coluna{
+			{"codigo_ibge", "INT NOT NULL"},
+			{"alias", "VARCHAR(80) NOT NULL"},
+		},
+		Restricoes: []string{
+			"PRIMARY KEY(codigo_ibge, alias)",
+			"CONSTRAINT fk_cidade FOREIGN KEY(codigo_ibge) REFERENCES cidades(codigo_ibge)",
+		},
+	}
+	historico := Tabela{
+		Nome: "municipios_historico",
+		Colunas: []Coluna{
+			{"codigo_ibge", "INT NOT NULL"},
+			{"tipo", "VARCHAR(20) NOT NULL"},
+			{"data", "CHAR(10) NOT NULL"},
+			{"nome", "VARCHAR(80)"},
+			{"nome_anterior", "VARCHAR(80)"},
+			{"uf", "CHAR(2)"},
+			{"origem", "VARCHAR(200)"},
+			{"sucessores", "VARCHAR(200)"},
+		},
+		Restricoes: []string{"PRIMARY KEY(codigo_ibge, tipo, data)"},
+	}
+	metadados := Tabela{
+		Nome: "metadados",
+		Colunas: []Coluna{
+			{"chave", "VARCHAR(40) PRIMARY KEY"},
+			{"valor", "VARCHAR(200) NOT NULL"},
+		},
+		Linhas: [][]interface{}{{"versao_dataset", strconv.Itoa(dados.Versao)}},
+	}
+
+	for _, e := range dados.Estados {
+		estados.Linhas = append(estados.Linhas, []interface{}{e.CodigoIBGE, e.Nome, e.Sigla, texto(e.FusoHorario)})
+		for _, f := range e.Feriados {
+			feriados.Linhas = append(feriados.Linhas, linhaFeriado(e.Sigla, 0, f))
+		}
+	}
+
+	var eventos []domain.EventoMunicipio
+	for _, c := range dados.Cidades {
+		cidades.Linhas = append(cidades.Linhas, []interface{}{
+			c.CodigoIBGE, c.Nome, numero(c.CodigoTOM), numero(c.MicroRegiao), numero(c.RegiaoImediata),
+			texto(c.FusoHorario), texto(c.Gentilico), c.EstadoCodigoIBGE,
+		})
+		for _, f := range c.Feriados {
+			feriados.Linhas = append(feriados.Linhas, linhaFeriado(c.EstadoSigla, c.CodigoIBGE, f))
+		}
+		classificacoes.Linhas = append(classificacoes.Linhas, linhasClassificacoes(c)...)
+		for _, alias := range c.Aliases {
+			aliases.Linhas = append(aliases.Linhas, []interface{}{c.CodigoIBGE, alias})
+		}
+		eventos = append(eventos, c.Historico...)
+	}
+	for _, c := range dados.CidadesExtintas {
+		eventos = append(eventos, c.Historico...)
+	}
+	sort.SliceStable(eventos, func(i, j int) bool {
+		if eventos[i].CodigoIBGE != eventos[j].CodigoIBGE {
+			return eventos[i].CodigoIBGE < eventos[j].CodigoIBGE
+		}
+		return eventos[i].Data < eventos[j].Data
+	})
+	for _, e := range eventos {
+		historico.Linhas = append(historico.Linhas, []interface{}{
+			e.CodigoIBGE, string(e.Tipo), e.Data, texto(e.Nome), texto(e.NomeAnterior), texto(e.UF),
+			codigos(e.Origem), codigos(e.Sucessores),
+		})
+	}
+
+	for _, f := range dados.FaixasCEP {
+		faixasCEP.Linhas = append(faixasCEP.Linhas, []interface{}{f.CEPInicial, f.CEPFinal, f.UF, f.CodigoIBGE})
+	}
+
+	return []Tabela{estados, cidades, faixasCEP, feriados, classificacoes, aliases, historico, metadados}
+}
+
+func linhaFeriado(uf string, codigoIBGE int, f domain.RegraFeriado) []interface{} {
+	if f.Movel() {
+		return []interface{}{uf, codigoIBGE, f.Nome, nil, nil, *f.DiasAposPascoa}
+	}
+	return []interface{}{uf, codigoIBGE, f.Nome, f.Dia, f.Mes, nil}
+}
+
+func linhasClassificacoes(c domain.Cidade) [][]interface{} {
+	var linhas [][]interface{}
+	for _, cl := range []struct {
+		classificacao domain.Classificacao
+		pertence      *bool
+	}{
+		{domain.ClassificacaoAmazoniaLegal, c.AmazoniaLegal},
+		{domain.ClassificacaoSemiarido, c.Semiarido},
+		{domain.ClassificacaoFaixaFronteira, c.FaixaFronteira},
+		{domain.ClassificacaoZonaCosteira, c.ZonaCosteira},
+		{domain.ClassificacaoMatopiba, c.Matopiba},
+	} {
+		if cl.pertence == nil || !*cl.pertence {
+			continue
+		}
+		var paises interface{}
+		if cl.classificacao == domain.ClassificacaoFaixaFronteira {
+			paises = texto(strings.Join(c.PaisesFronteira, ","))
+		}
+		linhas = append(linhas, []interface{}{c.CodigoIBGE, string(cl.classificacao), paises})
+	}
+	return linhas
+}
+
+// texto converte campos opcionais: o texto vazio vira NULL.
+func texto(valor string) interface{} {
+	if valor == "" {
+		return nil
+	}
+	return valor
+}
+
+// numero converte os códigos guardados como texto no domínio para as colunas INT; vazio vira NULL.
+func numero(valor string) interface{} {
+	n, err := strconv.Atoi(valor)
+	if err != nil {
+		return nil
+	}
+	return n
+}
+
+// codigos une listas de códigos IBGE separados por vírgula, como na coluna do banco; vazia vira NULL.
+func codigos(valores []int) interface{} {
+	partes := make([]string, len(valores))
+	for i, v := range valores {
+		partes[i] = strconv.Itoa(v)
+	}
+	return texto(strings.Join(partes, ","))
+}
@@ -0,0 +1,111 @@
+package pacote
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Partes fixas de uma planilha Office Open XML (SpreadsheetML) mínima.
+const (
+	xlsxRelacionamentos = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+	xlsxEstilos = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts><fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills><borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders><cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs><cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs></styleSheet>`
+	xlsxNamespace = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+)
+
+// gerarXLSX escreve uma planilha com uma aba por tabela. A primeira linha de cada aba é o
+// cabeçalho em negrito, congelado na rolagem; valores NULL viram células vazias.
+func gerarXLSX(w io.Writer, tabelas []Tabela) error {
+	arquivo := zip.NewWriter(w)
+	escrever := func(nome, conteudo string) error {
+		parte, err := arquivo.CreateHeader(&zip.FileHeader{Name: nome, Method: zip.Deflate})
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(parte, conteudo)
+		return err
+	}
+
+	var tipos, abas, relacionamentos strings.Builder
+	tipos.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i, t := range tabelas {
+		n := i + 1
+		fmt.Fprintf(&tipos, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, n)
+		fmt.Fprintf(&abas, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, textoXML(t.Nome), n, n)
+		fmt.Fprintf(&relacionamentos, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, n, n)
+		if err := escrever(fmt.Sprintf("xl/worksheets/sheet%d.xml", n), abaXLSX(t)); err != nil {
+			return err
+		}
+	}
+	tipos.WriteString(`</Types>`)
+	fmt.Fprintf(&relacionamentos, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, len(tabelas)+1)
+
+	partes := []struct{ nome, conteudo string }{
+		{"[Content_Types].xml", tipos.String()},
+		{"_rels/.rels", xlsxRelacionamentos},
+		{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="` + xlsxNamespace + `" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>` + abas.String() + `</sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` + relacionamentos.String() + `</Relationships>`},
+		{"xl/styles.xml", xlsxEstilos},
+	}
+	for _, p := range partes {
+		if err := escrever(p.nome, p.conteudo); err != nil {
+			return err
+		}
+	}
+	return arquivo.Close()
+}
+
+// abaXLSX monta a aba de uma tabela, com textos inline e números como valores numéricos.
+func abaXLSX(t Tabela) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="` + xlsxNamespace + `"><sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews><sheetData>`)
+
+	b.WriteString(`<row r="1">`)
+	for j, nome := range t.NomesColunas() {
+		fmt.Fprintf(&b, `<c r="%s1" s="1" t="inlineStr"><is><t>%s</t></is></c>`, colunaXLSX(j), textoXML(nome))
+	}
+	b.WriteString(`</row>`)
+
+	for i, linha := range t.Linhas {
+		n := i + 2
+		fmt.Fprintf(&b, `<row r="%d">`, n)
+		for j, valor := range linha {
+			referencia := colunaXLSX(j) + strconv.Itoa(n)
+			switch v := valor.(type) {
+			case nil:
+			case int:
+				fmt.Fprintf(&b, `<c r="%s"><v>%d</v></c>`, referencia, v)
+			default:
+				fmt.Fprintf(&b, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, referencia, textoXML(fmt.Sprint(v)))
+			}
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+// colunaXLSX converte o índice da coluna (a partir de 0) na letra da planilha: A, B, ..., Z, AA.
+func colunaXLSX(indice int) string {
+	letras := ""
+	for indice >= 0 {
+		letras = string(rune('A'+indice%26)) + letras
+		indice = indice/26 - 1
+	}
+	return letras
+}
+
+func textoXML(valor string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(valor))
+	return b.String()
+}
@@ -0,0 +1,384 @@
+package seed
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brauliohms/ibge-service/internal/diff"
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// Chaves da tabela de metadados.
+const (
+	chaveVersaoDataset = "versao_dataset"
+	chaveAtualizadoEm  = "atualizado_em"
+	chaveChecksumDados = "checksum_dados"
+)
+
+// tabelasServidas são as tabelas cujo conteúdo a API serve. Uma mudança em qualquer uma delas
+// (ex: faixas de CEP ou feriados) muda a versão do conjunto de dados, mesmo sem alterações nos
+// campos de estados e cidades que vão para o feed.
+var tabelasServidas = []string{
+	"estados", "cidades", "faixas_cep", "feriados", "classificacoes_territoriais", "cidades_aliases", "municipios_historico",
+}
+
+// compararComBanco compara os dados dos arquivos JSON com os estados e cidades já gravados,
+// incluindo fusos, gentílicos, aliases e classificações territoriais. Em um banco vazio, todos os
+// registros aparecem como adicionados.
+func (s *Seeder) compararComBanco(cidadesJSON []CidadeIBGE, tomData map[int]string, fusos *FusosHorarios,
+	gentilicosAliases map[int]GentilicoAliasesJSON, classificacoes ClassificacoesJSON) (*diff.Relatorio, error) {
+	atual, err := s.carregarConjuntoAtual()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sem o arquivo de classificações, o seed mantém as já gravadas
+	if classificacoes == nil {
+		classificacoes, err = s.carregarClassificacoesGravadas()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	estados, cidades := montarConjunto(cidadesJSON, tomData, fusos, gentilicosAliases, classificacoes)
+	relatorio := diff.Comparar(atual, &diff.Conjunto{Estados: estados, Cidades: cidades})
+	log.Printf("Alterações em relação ao banco: %d", len(relatorio.Alteracoes))
+	return relatorio, nil
+}
+
+// carregarConjuntoAtual lê os estados e cidades gravados no banco, com os mesmos campos
+// que o seed grava a partir dos arquivos JSON.
+func (s *Seeder) carregarConjuntoAtual() (*diff.Conjunto, error) {
+	conjunto := &diff.Conjunto{}
+
+	rows, err := s.db.Query("SELECT codigo_ibge, nome, sigla, fuso_horario FROM estados")
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler estados: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var e domain.Estado
+		var fusoHorario sql.NullString
+		if err := rows.Scan(&e.CodigoIBGE, &e.Nome, &e.Sigla, &fusoHorario); err != nil {
+			return nil, err
+		}
+		e.FusoHorario = fusoHorario.String
+		conjunto.Estados = append(conjunto.Estados, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = s.db.Query(`
+		SELECT c.codigo_ibge, c.nome, c.gentilico, c.codigo_tom, c.micro_regiao, c.regiao_imediata, c.fuso_horario, e.sigla
+		FROM cidades c
+		INNER JOIN estados e ON c.estado_codigo_ibge = e.codigo_ibge`)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler cidades: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var c domain.Cidade
+		var gentilico, codigoTOM, microRegiao, regiaoImediata, fusoHorario sql.NullString
+		if err := rows.Scan(&c.CodigoIBGE, &c.Nome, &gentilico, &codigoTOM, &microRegiao, &regiaoImediata, &fusoHorario, &c.EstadoSigla); err != nil {
+			return nil, err
+		}
+		c.Gentilico, c.CodigoTOM, c.MicroRegiao = gentilico.String, codigoTOM.String, microRegiao.String
+		c.RegiaoImediata, c.FusoHorario = regiaoImediata.String, fusoHorario.String
+		conjunto.Cidades = append(conjunto.Cidades, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	aliases := make(map[int][]string)
+	rows, err = s.db.Query("SELECT codigo_ibge, alias FROM cidades_aliases")
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler aliases: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var codigo int
+		var alias string
+		if err := rows.Scan(&codigo, &alias); err != nil {
+			return nil, err
+		}
+		aliases[codigo] = append(aliases[codigo], alias)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	classificacoes, err := s.lerClassificacoes()
+	if err != nil {
+		return nil, err
+	}
+	diff.Enriquecer(conjunto.Cidades, aliases, classificacoes)
+	return conjunto, nil
+}
+
+// lerClassificacoes lê os municípios de cada classificação territorial gravada no banco.
+func (s *Seeder) lerClassificacoes() ([]domain.ClassificacaoMunicipio, error) {
+	rows, err := s.db.Query("SELECT codigo_ibge, classificacao, paises_fronteira FROM classificacoes_territoriais")
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler classificações territoriais: %w", err)
+	}
+	defer rows.Close()
+
+	var classificacoes []domain.ClassificacaoMunicipio
+	for rows.Next() {
+		var c domain.ClassificacaoMunicipio
+		var paises sql.NullString
+		if err := rows.Scan(&c.CodigoIBGE, &c.Classificacao, &paises); err != nil {
+			return nil, err
+		}
+		if paises.String != "" {
+			c.PaisesFronteira = strings.Split(paises.String, ",")
+		}
+		classificacoes = append(classificacoes, c)
+	}
+	return classificacoes, rows.Err()
+}
+
+// carregarClassificacoesGravadas monta, a partir das classificações gravadas no banco, o conteúdo
+// equivalente do arquivo classificacoes-territoriais.json, com cada recorte como lista completa.
+func (s *Seeder) carregarClassificacoesGravadas() (ClassificacoesJSON, error) {
+	gravadas, err := s.lerClassificacoes()
+	if err != nil {
+		return nil, err
+	}
+	classificacoes := ClassificacoesJSON{}
+	for _, c := range gravadas {
+		recorte := classificacoes[string(c.Classificacao)]
+		recorte.Completa = true
+		recorte.Municipios = append(recorte.Municipios, MunicipioRecorteJSON{CodigoIBGE: c.CodigoIBGE, Paises: c.PaisesFronteira})
+		classificacoes[string(c.Classificacao)] = recorte
+	}
+	return classificacoes, nil
+}
+
+// removerAusentes apaga as cidades e estados que deixaram de existir nos arquivos JSON,
+// junto com os registros que dependem deles.
+func (s *Seeder) removerAusentes(relatorio *diff.Relatorio) error {
+	placeholder := "?"
+	if s.driverName == "postgres" {
+		placeholder = "$1"
+	}
+
+	var cidades, estados []int
+	for _, a := range relatorio.Alteracoes {
+		if a.Tipo != domain.Removido {
+			continue
+		}
+		if a.Entidade == domain.EntidadeCidade {
+			cidades = append(cidades, a.CodigoIBGE)
+		} else {
+			estados = append(estados, a.CodigoIBGE)
+		}
+	}
+
+	tabelasDependentes := []string{"faixas_cep", "feriados", "classificacoes_territoriais", "cidades_aliases", "cidades"}
+	for _, codigo := range cidades {
+		for _, tabela := range tabelasDependentes {
+			if _, err := s.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE codigo_ibge = %s", tabela, placeholder), codigo); err != nil {
+				return fmt.Errorf("erro ao remover cidade %d de %s: %w", codigo, tabela, err)
+			}
+		}
+	}
+	for _, codigo := range estados {
+		if _, err := s.db.Exec(fmt.Sprintf("DELETE FROM estados WHERE codigo_ibge = %s", placeholder), codigo); err != nil {
+			return fmt.Errorf("erro ao remover estado %d: %w", codigo, err)
+		}
+	}
+
+	if len(cidades)+len(estados) > 0 {
+		log.Printf("Removidos %d estados e %d cidades ausentes dos arquivos", len(estados), len(cidades))
+	}
+	return nil
+}
+
+// registrarAlteracoes grava as alterações no log usado pelo feed de sincronização, sob uma nova
+// versão do conjunto de dados (a atual + 1), e atualiza a data de atualização, que vai no
+// Last-Modified das respostas. A versão também muda quando o seed altera outra tabela servida pela
+// API, pelo checksum do conteúdo gravado; se nada mudou, versão e data são mantidas.
+func (s *Seeder) registrarAlteracoes(relatorio *diff.Relatorio) error {
+	checksum, err := s.checksumDados()
+	if err != nil {
+		return err
+	}
+	checksumAnterior, err := s.lerMetadado(chaveChecksumDados)
+	if err != nil {
+		return err
+	}
+	if len(relatorio.Alteracoes) == 0 && checksum == checksumAnterior {
+		log.Println("Nenhuma alteração a registrar, versão do conjunto de dados mantida")
+		return nil
+	}
+
+	versao, err := s.versaoAtual()
+	if err != nil {
+		return err
+	}
+	versao++
+
+	var insertSQL, metadadoSQL string
+	switch s.driverName {
+	case "postgres":
+		insertSQL = `
+			INSERT INTO alteracoes (versao, sequencia, entidade, tipo, codigo_ibge, nome, uf, campos)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+		metadadoSQL = `
+			INSERT INTO metadados (chave, valor) VALUES ($1, $2)
+			ON CONFLICT (chave) DO UPDATE SET valor = EXCLUDED.valor`
+	case "sqlite3":
+		insertSQL = `
+			INSERT INTO alteracoes (versao, sequencia, entidade, tipo, codigo_ibge, nome, uf, campos)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+		metadadoSQL = `
+			INSERT INTO metadados (chave, valor) VALUES (?, ?)
+			ON CONFLICT (chave) DO UPDATE SET valor = excluded.valor`
+	default:
+		// MySQL e outros
+		insertSQL = `
+			INSERT INTO alteracoes (versao, sequencia, entidade, tipo, codigo_ibge, nome, uf, campos)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+		metadadoSQL = `
+			INSERT INTO metadados (chave, valor) VALUES (?, ?)
+			ON DUPLICATE KEY UPDATE valor = VALUES(valor)`
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar transação: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	stmt, err := tx.Prepare(insertSQL)
+	if err != nil {
+		return fmt.Errorf("erro ao preparar statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for i, a := range relatorio.Alteracoes {
+		var campos *string
+		if len(a.Campos) > 0 {
+			dados, err := json.Marshal(a.Campos)
+			if err != nil {
+				return err
+			}
+			c := string(dados)
+			campos = &c
+		}
+		if _, err := stmt.Exec(versao, i+1, a.Entidade, a.Tipo, a.CodigoIBGE, a.Nome, strings.ToUpper(a.UF), campos); err != nil {
+			return fmt.Errorf("erro ao inserir alteração do %s %d: %w", a.Entidade, a.CodigoIBGE, err)
+		}
+	}
+
+	if _, err := tx.Exec(metadadoSQL, chaveVersaoDataset, strconv.Itoa(versao)); err != nil {
+		return fmt.Errorf("erro ao atualizar a versão do conjunto de dados: %w", err)
+	}
+	if _, err := tx.Exec(metadadoSQL, chaveAtualizadoEm, time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return fmt.Errorf("erro ao atualizar a data de atualização: %w", err)
+	}
+	if _, err := tx.Exec(metadadoSQL, chaveChecksumDados, checksum); err != nil {
+		return fmt.Errorf("erro ao atualizar o checksum do conjunto de dados: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("erro ao confirmar transação: %w", err)
+	}
+
+	if len(relatorio.Alteracoes) == 0 {
+		log.Printf("Versão %d do conjunto de dados registrada sem alterações em estados e cidades: mudaram outras tabelas servidas", versao)
+		return nil
+	}
+	log.Printf("Registradas %d alterações na versão %d do conjunto de dados", len(relatorio.Alteracoes), versao)
+	return nil
+}
+
+// versaoAtual retorna a versão do conjunto de dados gravada no banco, ou zero se ainda não houver.
+func (s *Seeder) versaoAtual() (int, error) {
+	valor, err := s.lerMetadado(chaveVersaoDataset)
+	if err != nil || valor == "" {
+		return 0, err
+	}
+	return strconv.Atoi(valor)
+}
+
+// lerMetadado retorna o valor gravado na tabela de metadados, ou vazio se a chave não existir.
+func (s *Seeder) lerMetadado(chave string) (string, error) {
+	query := "SELECT valor FROM metadados WHERE chave = ?"
+	if s.driverName == "postgres" {
+		query = "SELECT valor FROM metadados WHERE chave = $1"
+	}
+
+	var valor string
+	err := s.db.QueryRow(query, chave).Scan(&valor)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("erro ao ler o metadado %s: %w", chave, err)
+	}
+	return valor, nil
+}
+
+// checksumDados calcula o SHA-256 do conteúdo das tabelas servidas pela API. As linhas de cada
+// tabela são ordenadas antes do cálculo, para que o checksum não dependa da ordem de leitura.
+func (s *Seeder) checksumDados() (string, error) {
+	hash := sha256.New()
+	for _, tabela := range tabelasServidas {
+		linhas, err := s.lerLinhas(tabela)
+		if err != nil {
+			return "", err
+		}
+		sort.Strings(linhas)
+		fmt.Fprintf(hash, "%s\n", tabela)
+		for _, linha := range linhas {
+			fmt.Fprintf(hash, "%s\n", linha)
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// lerLinhas lê todas as linhas da tabela, cada uma com os valores das colunas juntados por chaveLinha.
+func (s *Seeder) lerLinhas(tabela string) ([]string, error) {
+	rows, err := s.db.Query(fmt.Sprintf("SELECT * FROM %s", tabela))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler %s: %w", tabela, err)
+	}
+	defer rows.Close()
+
+	colunas, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var linhas []string
+	for rows.Next() {
+		valores := make([]sql.NullString, len(colunas))
+		destinos := make([]interface{}, len(colunas))
+		for i := range valores {
+			destinos[i] = &valores[i]
+		}
+		if err := rows.Scan(destinos...); err != nil {
+			return nil, fmt.Errorf("erro ao ler %s: %w", tabela, err)
+		}
+		// NULL e vazio são valores diferentes
+		textos := make([]string, len(valores))
+		for i, v := range valores {
+			if v.Valid {
+				textos[i] = "=" + v.String
+			}
+		}
+		linhas = append(linhas, chaveLinha(textos...))
+	}
+	return linhas, rows.Err()
+}
@@ -0,0 +1,95 @@
+package seed
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/brauliohms/ibge-service/internal/diff"
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// CarregarDiretorio lê os arquivos JSON de um diretório de dados (cidades-ibge-uf.json,
+// municipios-TOM-IBGE.json e os opcionais de fusos, gentílicos e aliases e classificações) e monta
+// os estados e cidades como ficariam após o seed, sem gravar nada em banco. É usado para comparar
+// uma nova DTB antes de executar o seed.
+func CarregarDiretorio(dataDir string) ([]domain.Estado, []domain.Cidade, error) {
+	s := &Seeder{}
+
+	cidadesJSON, err := s.loadCidadesData(filepath.Join(dataDir, "cidades-ibge-uf.json"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao carregar dados das cidades: %w", err)
+	}
+
+	tomData, err := s.loadTOMData(filepath.Join(dataDir, "municipios-TOM-IBGE.json"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao carregar dados TOM: %w", err)
+	}
+
+	fusos, err := s.loadFusosHorariosData(filepath.Join(dataDir, "fusos-horarios.json"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao carregar fusos horários: %w", err)
+	}
+
+	gentilicosAliases, err := s.loadGentilicosAliasesData(filepath.Join(dataDir, "gentilicos-aliases.json"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao carregar gentílicos e aliases: %w", err)
+	}
+
+	classificacoes, err := s.loadClassificacoesData(filepath.Join(dataDir, "classificacoes-territoriais.json"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao carregar classificações territoriais: %w", err)
+	}
+
+	estados, cidades := montarConjunto(cidadesJSON, tomData, fusos, gentilicosAliases, classificacoes)
+	return estados, cidades, nil
+}
+
+// montarConjunto converte os dados dos arquivos JSON nos estados e cidades do domínio,
+// com os mesmos valores que o seed grava no banco.
+func montarConjunto(cidadesJSON []CidadeIBGE, tomData map[int]string, fusos *FusosHorarios,
+	gentilicosAliases map[int]GentilicoAliasesJSON, classificacoes ClassificacoesJSON) ([]domain.Estado, []domain.Cidade) {
+	estadosMap := make(map[int]domain.Estado)
+	cidades := make([]domain.Cidade, 0, len(cidadesJSON))
+	aliases := make(map[int][]string, len(gentilicosAliases))
+	for _, c := range cidadesJSON {
+		if _, exists := estadosMap[c.CodigoUF]; !exists {
+			estadosMap[c.CodigoUF] = domain.Estado{CodigoIBGE: c.CodigoUF, Nome: c.UFNome, Sigla: c.UF, FusoHorario: texto(fusos.doEstado(c.UF))}
+		}
+		cidades = append(cidades, domain.Cidade{
+			CodigoIBGE:       c.Codigo,
+			Nome:             c.Nome,
+			Gentilico:        gentilicosAliases[c.Codigo].Gentilico,
+			CodigoTOM:        tomData[c.Codigo],
+			MicroRegiao:      fmt.Sprintf("%d", c.Microregiao),
+			RegiaoImediata:   fmt.Sprintf("%d", c.RegiaoImediata),
+			FusoHorario:      texto(fusos.doMunicipio(c.Codigo, c.UF)),
+			EstadoCodigoIBGE: c.CodigoUF,
+			EstadoSigla:      strings.ToUpper(c.UF),
+			EstadoNome:       c.UFNome,
+		})
+		if ga, ok := gentilicosAliases[c.Codigo]; ok && len(ga.Aliases) > 0 {
+			aliases[c.Codigo] = ga.Aliases
+		}
+	}
+	diff.Enriquecer(cidades, aliases, classificacoesDoArquivo(classificacoes, cidadesJSON))
+
+	estados := make([]domain.Estado, 0, len(estadosMap))
+	for _, e := range estadosMap {
+		estados = append(estados, e)
+	}
+	sort.Slice(estados, func(i, j int) bool {
+		return estados[i].CodigoIBGE < estados[j].CodigoIBGE
+	})
+
+	return estados, cidades
+}
+
+// texto retorna o valor de um campo opcional, ou vazio se ele for nil.
+func texto(valor *string) string {
+	if valor == nil {
+		return ""
+	}
+	return *valor
+}
@@ -5,8 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
 )
 
 // CidadeIBGE representa a estrutura do arquivo cidades-ibge-uf.json
@@ -26,6 +33,92 @@ type MunicipioTOM struct {
 	CodigoTOM           int `json:"CODIGO-MUNICIPIO-TOM"`
 }
 
+// FaixaCEPJSON representa a estrutura do arquivo faixas-cep.json
+type FaixaCEPJSON struct {
+	UF         string `json:"uf"`
+	CodigoIBGE int    `json:"codigo_ibge"`
+	CEPInicial string `json:"cep_inicial"`
+	CEPFinal   string `json:"cep_final"`
+}
+
+// FeriadosJSON representa a estrutura do arquivo feriados.json
+type FeriadosJSON struct {
+	Estaduais []struct {
+		UF             string `json:"uf"`
+		Nome           string `json:"nome"`
+		Dia            int    `json:"dia"`
+		Mes            int    `json:"mes"`
+		DiasAposPascoa *int   `json:"dias_apos_pascoa"`
+	} `json:"estaduais"`
+	Municipais []struct {
+		CodigoIBGE     int    `json:"codigo_ibge"`
+		Nome           string `json:"nome"`
+		Dia            int    `json:"dia"`
+		Mes            int    `json:"mes"`
+		DiasAposPascoa *int   `json:"dias_apos_pascoa"`
+	} `json:"municipais"`
+}
+
+// RecorteTerritorialJSON lista os estados inteiros e os municípios avulsos que pertencem a um recorte.
+// Fonte identifica a lista oficial, e Completa indica que ela foi transcrita por inteiro: só os
+// recortes completos são carregados, pois a API responde false para os municípios fora da lista.
+type RecorteTerritorialJSON struct {
+	Fonte      string                 `json:"fonte"`
+	Completa   bool                   `json:"completa"`
+	Estados    []string               `json:"estados"`
+	Municipios []MunicipioRecorteJSON `json:"municipios"`
+}
+
+// MunicipioRecorteJSON é um município avulso de um recorte, com os países de fronteira, se houver.
+type MunicipioRecorteJSON struct {
+	CodigoIBGE int      `json:"codigo_ibge"`
+	Paises     []string `json:"paises"`
+}
+
+// ClassificacoesJSON representa a estrutura do arquivo classificacoes-territoriais.json,
+// indexada pelo nome da classificação (amazonia_legal, semiarido, faixa_fronteira, ...).
+type ClassificacoesJSON map[string]RecorteTerritorialJSON
+
+// GentilicoAliasesJSON representa a estrutura do arquivo gentilicos-aliases.json
+type GentilicoAliasesJSON struct {
+	CodigoIBGE int      `json:"codigo_ibge"`
+	Gentilico  string   `json:"gentilico"`
+	Aliases    []string `json:"aliases"`
+}
+
+// HistoricoMunicipiosJSON representa a estrutura do arquivo municipios-historico.json
+type HistoricoMunicipiosJSON struct {
+	Eventos []struct {
+		CodigoIBGE   int    `json:"codigo_ibge"`
+		Tipo         string `json:"tipo"`
+		Data         string `json:"data"`
+		Nome         string `json:"nome"`
+		NomeAnterior string `json:"nome_anterior"`
+		UF           string `json:"uf"`
+		Origem       []int  `json:"origem"`
+		Sucessores   []int  `json:"sucessores"`
+	} `json:"eventos"`
+}
+
+// FusosHorariosJSON representa a estrutura do arquivo fusos-horarios.json.
+// Cada estado tem um fuso padrão; municípios listados sobrescrevem o fuso do estado.
+type FusosHorariosJSON struct {
+	Estados []struct {
+		UF          string `json:"uf"`
+		FusoHorario string `json:"fuso_horario"`
+	} `json:"estados"`
+	Municipios []struct {
+		CodigoIBGE  int    `json:"codigo_ibge"`
+		FusoHorario string `json:"fuso_horario"`
+	} `json:"municipios"`
+}
+
+// FusosHorarios contém os fusos horários IANA indexados por UF e por código IBGE do município.
+type FusosHorarios struct {
+	PorUF        map[string]string
+	PorMunicipio map[int]string
+}
+
 // Seeder gerencia o processo de seed do banco de dados
 type Seeder struct {
 	db         *sql.DB
@@ -60,16 +153,83 @@ func (s *Seeder) Run(dataDir string) error {
 		return fmt.Errorf("erro ao carregar dados TOM: %w", err)
 	}
 
-	// 3. Popular estados
-	if err := s.seedEstados(cidades); err != nil {
+	fusos, err := s.loadFusosHorariosData(filepath.Join(dataDir, "fusos-horarios.json"))
+	if err != nil {
+		return fmt.Errorf("erro ao carregar fusos horários: %w", err)
+	}
+
+	gentilicosAliases, err := s.loadGentilicosAliasesData(filepath.Join(dataDir, "gentilicos-aliases.json"))
+	if err != nil {
+		return fmt.Errorf("erro ao carregar gentílicos e aliases: %w", err)
+	}
+
+	classificacoes, err := s.loadClassificacoesData(filepath.Join(dataDir, "classificacoes-territoriais.json"))
+	if err != nil {
+		return fmt.Errorf("erro ao carregar classificações territoriais: %w", err)
+	}
+
+	// 3. Comparar os dados novos com o conteúdo atual do banco, antes de sobrescrevê-lo,
+	// para registrar as alterações no feed de sincronização
+	relatorio, err := s.compararComBanco(cidades, tomData, fusos, gentilicosAliases, classificacoes)
+	if err != nil {
+		return fmt.Errorf("erro ao comparar com os dados atuais: %w", err)
+	}
+
+	// 4. Popular estados
+	if err := s.seedEstados(cidades, fusos); err != nil {
 		return fmt.Errorf("erro ao popular estados: %w", err)
 	}
 
-	// 4. Popular cidades
-	if err := s.seedCidades(cidades, tomData); err != nil {
+	// 5. Popular cidades
+	if err := s.seedCidades(cidades, tomData, fusos, gentilicosAliases); err != nil {
 		return fmt.Errorf("erro ao popular cidades: %w", err)
 	}
 
+	if err := s.removerAusentes(relatorio); err != nil {
+		return fmt.Errorf("erro ao remover estados e cidades ausentes: %w", err)
+	}
+
+	if err := s.seedAliases(gentilicosAliases); err != nil {
+		return fmt.Errorf("erro ao popular aliases: %w", err)
+	}
+
+	// 6. Popular faixas de CEP (arquivo opcional)
+	faixas, err := s.loadFaixasCEPData(filepath.Join(dataDir, "faixas-cep.json"))
+	if err != nil {
+		return fmt.Errorf("erro ao carregar faixas de CEP: %w", err)
+	}
+	if err := s.seedFaixasCEP(faixas); err != nil {
+		return fmt.Errorf("erro ao popular faixas de CEP: %w", err)
+	}
+
+	// 7. Popular feriados estaduais e municipais (arquivo opcional)
+	feriados, err := s.loadFeriadosData(filepath.Join(dataDir, "feriados.json"))
+	if err != nil {
+		return fmt.Errorf("erro ao carregar feriados: %w", err)
+	}
+	if err := s.seedFeriados(feriados, cidades); err != nil {
+		return fmt.Errorf("erro ao popular feriados: %w", err)
+	}
+
+	// 8. Popular classificações territoriais (arquivo opcional)
+	if err := s.seedClassificacoes(classificacoes, cidades); err != nil {
+		return fmt.Errorf("erro ao popular classificações territoriais: %w", err)
+	}
+
+	// 9. Popular histórico de criações, renomeações e extinções de municípios (arquivo opcional)
+	historico, err := s.loadHistoricoData(filepath.Join(dataDir, "municipios-historico.json"))
+	if err != nil {
+		return fmt.Errorf("erro ao carregar histórico de municípios: %w", err)
+	}
+	if err := s.seedHistorico(historico); err != nil {
+		return fmt.Errorf("erro ao popular histórico de municípios: %w", err)
+	}
+
+	// 10. Registrar as alterações e a nova versão, depois de gravar todas as tabelas servidas
+	if err := s.registrarAlteracoes(relatorio); err != nil {
+		return fmt.Errorf("erro ao registrar alterações: %w", err)
+	}
+
 	log.Println("Processo de seed concluído com sucesso!")
 	return nil
 }
@@ -78,7 +238,7 @@ func (s *Seeder) Run(dataDir string) error {
 func (s *Seeder) createTables() error {
 	log.Println("Criando tabelas...")
 
-	var createEstadosSQL, createCidadesSQL, createIndexSQL string
+	var createEstadosSQL, createCidadesSQL, createIndexSQL, createFaixasCEPSQL, createFeriadosSQL, createClassificacoesSQL, createAliasesSQL, createHistoricoSQL, createAlteracoesSQL, createMetadadosSQL string
 
 	switch s.driverName {
 	case "sqlite3":
@@ -86,7 +246,8 @@ func (s *Seeder) createTables() error {
 		CREATE TABLE IF NOT EXISTS estados (
 			codigo_ibge INTEGER PRIMARY KEY,
 			nome VARCHAR(30) NOT NULL UNIQUE,
-			sigla CHAR(2) NOT NULL UNIQUE
+			sigla CHAR(2) NOT NULL UNIQUE,
+			fuso_horario VARCHAR(40)
 		);`
 
 		createCidadesSQL = `
@@ -96,6 +257,8 @@ func (s *Seeder) createTables() error {
 			codigo_tom INT,
 			micro_regiao INT,
 			regiao_imediata INT,
+			fuso_horario VARCHAR(40),
+			gentilico VARCHAR(60),
 			estado_codigo_ibge INTEGER NOT NULL,
 			FOREIGN KEY(estado_codigo_ibge) REFERENCES estados(codigo_ibge)
 		);`
@@ -103,12 +266,82 @@ func (s *Seeder) createTables() error {
 		createIndexSQL = `
 		CREATE INDEX IF NOT EXISTS idx_cidades_por_estado ON cidades(estado_codigo_ibge);`
 
+		createFaixasCEPSQL = `
+		CREATE TABLE IF NOT EXISTS faixas_cep (
+			cep_inicial CHAR(8) NOT NULL,
+			cep_final CHAR(8) NOT NULL,
+			uf CHAR(2) NOT NULL,
+			codigo_ibge INTEGER NOT NULL DEFAULT 0,
+			PRIMARY KEY(cep_inicial, codigo_ibge)
+		);`
+
+		createFeriadosSQL = `
+		CREATE TABLE IF NOT EXISTS feriados (
+			uf CHAR(2) NOT NULL,
+			codigo_ibge INTEGER NOT NULL DEFAULT 0,
+			nome VARCHAR(80) NOT NULL,
+			dia INTEGER,
+			mes INTEGER,
+			dias_apos_pascoa INTEGER,
+			PRIMARY KEY(uf, codigo_ibge, nome)
+		);`
+
+		createClassificacoesSQL = `
+		CREATE TABLE IF NOT EXISTS classificacoes_territoriais (
+			codigo_ibge INTEGER NOT NULL,
+			classificacao VARCHAR(20) NOT NULL,
+			paises_fronteira VARCHAR(200),
+			PRIMARY KEY(codigo_ibge, classificacao),
+			FOREIGN KEY(codigo_ibge) REFERENCES cidades(codigo_ibge)
+		);`
+
+		createAliasesSQL = `
+		CREATE TABLE IF NOT EXISTS cidades_aliases (
+			codigo_ibge INTEGER NOT NULL,
+			alias VARCHAR(80) NOT NULL,
+			PRIMARY KEY(codigo_ibge, alias),
+			FOREIGN KEY(codigo_ibge) REFERENCES cidades(codigo_ibge)
+		);`
+
+		createHistoricoSQL = `
+		CREATE TABLE IF NOT EXISTS municipios_historico (
+			codigo_ibge INTEGER NOT NULL,
+			tipo VARCHAR(20) NOT NULL,
+			data CHAR(10) NOT NULL,
+			nome VARCHAR(80),
+			nome_anterior VARCHAR(80),
+			uf CHAR(2),
+			origem VARCHAR(200),
+			sucessores VARCHAR(200),
+			PRIMARY KEY(codigo_ibge, tipo, data)
+		);`
+
+		createAlteracoesSQL = `
+		CREATE TABLE IF NOT EXISTS alteracoes (
+			versao INTEGER NOT NULL,
+			sequencia INTEGER NOT NULL,
+			entidade VARCHAR(10) NOT NULL,
+			tipo VARCHAR(10) NOT NULL,
+			codigo_ibge INTEGER NOT NULL,
+			nome VARCHAR(80) NOT NULL,
+			uf CHAR(2),
+			campos TEXT,
+			PRIMARY KEY(versao, sequencia)
+		);`
+
+		createMetadadosSQL = `
+		CREATE TABLE IF NOT EXISTS metadados (
+			chave VARCHAR(40) PRIMARY KEY,
+			valor VARCHAR(200) NOT NULL
+		);`
+
 	case "postgres":
 		createEstadosSQL = `
 		CREATE TABLE IF NOT EXISTS estados (
 			codigo_ibge INT PRIMARY KEY,
 			nome VARCHAR(30) NOT NULL UNIQUE,
-			sigla CHAR(2) NOT NULL UNIQUE
+			sigla CHAR(2) NOT NULL UNIQUE,
+			fuso_horario VARCHAR(40)
 		);`
 
 		createCidadesSQL = `
@@ -118,6 +351,8 @@ func (s *Seeder) createTables() error {
 			codigo_tom INT,
 			micro_regiao INT,
 			regiao_imediata INT,
+			fuso_horario VARCHAR(40),
+			gentilico VARCHAR(60),
 			estado_codigo_ibge INT NOT NULL,
 			CONSTRAINT fk_estado
 				FOREIGN KEY(estado_codigo_ibge) 
@@ -127,12 +362,86 @@ func (s *Seeder) createTables() error {
 		createIndexSQL = `
 		CREATE INDEX IF NOT EXISTS idx_cidades_por_estado ON cidades(estado_codigo_ibge);`
 
+		createFaixasCEPSQL = `
+		CREATE TABLE IF NOT EXISTS faixas_cep (
+			cep_inicial CHAR(8) NOT NULL,
+			cep_final CHAR(8) NOT NULL,
+			uf CHAR(2) NOT NULL,
+			codigo_ibge INT NOT NULL DEFAULT 0,
+			PRIMARY KEY(cep_inicial, codigo_ibge)
+		);`
+
+		createFeriadosSQL = `
+		CREATE TABLE IF NOT EXISTS feriados (
+			uf CHAR(2) NOT NULL,
+			codigo_ibge INT NOT NULL DEFAULT 0,
+			nome VARCHAR(80) NOT NULL,
+			dia INT,
+			mes INT,
+			dias_apos_pascoa INT,
+			PRIMARY KEY(uf, codigo_ibge, nome)
+		);`
+
+		createClassificacoesSQL = `
+		CREATE TABLE IF NOT EXISTS classificacoes_territoriais (
+			codigo_ibge INT NOT NULL,
+			classificacao VARCHAR(20) NOT NULL,
+			paises_fronteira VARCHAR(200),
+			PRIMARY KEY(codigo_ibge, classificacao),
+			CONSTRAINT fk_cidade
+				FOREIGN KEY(codigo_ibge) 
+				REFERENCES cidades(codigo_ibge)
+		);`
+
+		createAliasesSQL = `
+		CREATE TABLE IF NOT EXISTS cidades_aliases (
+			codigo_ibge INT NOT NULL,
+			alias VARCHAR(80) NOT NULL,
+			PRIMARY KEY(codigo_ibge, alias),
+			CONSTRAINT fk_cidade
+				FOREIGN KEY(codigo_ibge) 
+				REFERENCES cidades(codigo_ibge)
+		);`
+
+		createHistoricoSQL = `
+		CREATE TABLE IF NOT EXISTS municipios_historico (
+			codigo_ibge INT NOT NULL,
+			tipo VARCHAR(20) NOT NULL,
+			data CHAR(10) NOT NULL,
+			nome VARCHAR(80),
+			nome_anterior VARCHAR(80),
+			uf CHAR(2),
+			origem VARCHAR(200),
+			sucessores VARCHAR(200),
+			PRIMARY KEY(codigo_ibge, tipo, data)
+		);`
+
+		createAlteracoesSQL = `
+		CREATE TABLE IF NOT EXISTS alteracoes (
+			versao INT NOT NULL,
+			sequencia INT NOT NULL,
+			entidade VARCHAR(10) NOT NULL,
+			tipo VARCHAR(10) NOT NULL,
+			codigo_ibge INT NOT NULL,
+			nome VARCHAR(80) NOT NULL,
+			uf CHAR(2),
+			campos TEXT,
+			PRIMARY KEY(versao, sequencia)
+		);`
+
+		createMetadadosSQL = `
+		CREATE TABLE IF NOT EXISTS metadados (
+			chave VARCHAR(40) PRIMARY KEY,
+			valor VARCHAR(200) NOT NULL
+		);`
+
 	default: // MySQL e outros
 		createEstadosSQL = `
 		CREATE TABLE IF NOT EXISTS estados (
 			codigo_ibge INT PRIMARY KEY,
 			nome VARCHAR(30) NOT NULL UNIQUE,
-			sigla CHAR(2) NOT NULL UNIQUE
+			sigla CHAR(2) NOT NULL UNIQUE,
+			fuso_horario VARCHAR(40)
 		);`
 
 		createCidadesSQL = `
@@ -142,6 +451,8 @@ func (s *Seeder) createTables() error {
 			codigo_tom INT,
 			micro_regiao INT,
 			regiao_imediata INT,
+			fuso_horario VARCHAR(40),
+			gentilico VARCHAR(60),
 			estado_codigo_ibge INT NOT NULL,
 			CONSTRAINT fk_estado
 				FOREIGN KEY(estado_codigo_ibge) 
@@ -150,6 +461,79 @@ func (s *Seeder) createTables() error {
 
 		createIndexSQL = `
 		CREATE INDEX idx_cidades_por_estado ON cidades(estado_codigo_ibge);`
+
+		createFaixasCEPSQL = `
+		CREATE TABLE IF NOT EXISTS faixas_cep (
+			cep_inicial CHAR(8) NOT NULL,
+			cep_final CHAR(8) NOT NULL,
+			uf CHAR(2) NOT NULL,
+			codigo_ibge INT NOT NULL DEFAULT 0,
+			PRIMARY KEY(cep_inicial, codigo_ibge)
+		);`
+
+		createFeriadosSQL = `
+		CREATE TABLE IF NOT EXISTS feriados (
+			uf CHAR(2) NOT NULL,
+			codigo_ibge INT NOT NULL DEFAULT 0,
+			nome VARCHAR(80) NOT NULL,
+			dia INT,
+			mes INT,
+			dias_apos_pascoa INT,
+			PRIMARY KEY(uf, codigo_ibge, nome)
+		);`
+
+		createClassificacoesSQL = `
+		CREATE TABLE IF NOT EXISTS classificacoes_territoriais (
+			codigo_ibge INT NOT NULL,
+			classificacao VARCHAR(20) NOT NULL,
+			paises_fronteira VARCHAR(200),
+			PRIMARY KEY(codigo_ibge, classificacao),
+			CONSTRAINT fk_cidade
+				FOREIGN KEY(codigo_ibge) 
+				REFERENCES cidades(codigo_ibge)
+		);`
+
+		createAliasesSQL = `
+		CREATE TABLE IF NOT EXISTS cidades_aliases (
+			codigo_ibge INT NOT NULL,
+			alias VARCHAR(80) NOT NULL,
+			PRIMARY KEY(codigo_ibge, alias),
+			CONSTRAINT fk_cidade
+				FOREIGN KEY(codigo_ibge) 
+				REFERENCES cidades(codigo_ibge)
+		);`
+
+		createHistoricoSQL = `
+		CREATE TABLE IF NOT EXISTS municipios_historico (
+			codigo_ibge INT NOT NULL,
+			tipo VARCHAR(20) NOT NULL,
+			data CHAR(10) NOT NULL,
+			nome VARCHAR(80),
+			nome_anterior VARCHAR(80),
+			uf CHAR(2),
+			origem VARCHAR(200),
+			sucessores VARCHAR(200),
+			PRIMARY KEY(codigo_ibge, tipo, data)
+		);`
+
+		createAlteracoesSQL = `
+		CREATE TABLE IF NOT EXISTS alteracoes (
+			versao INT NOT NULL,
+			sequencia INT NOT NULL,
+			entidade VARCHAR(10) NOT NULL,
+			tipo VARCHAR(10) NOT NULL,
+			codigo_ibge INT NOT NULL,
+			nome VARCHAR(80) NOT NULL,
+			uf CHAR(2),
+			campos TEXT,
+			PRIMARY KEY(versao, sequencia)
+		);`
+
+		createMetadadosSQL = `
+		CREATE TABLE IF NOT EXISTS metadados (
+			chave VARCHAR(40) PRIMARY KEY,
+			valor VARCHAR(200) NOT NULL
+		);`
 	}
 
 	// Executar SQLs
@@ -165,6 +549,34 @@ func (s *Seeder) createTables() error {
 		log.Printf("Aviso ao criar índice (pode já existir): %v", err)
 	}
 
+	if _, err := s.db.Exec(createFaixasCEPSQL); err != nil {
+		return fmt.Errorf("erro ao criar tabela faixas_cep: %w", err)
+	}
+
+	if _, err := s.db.Exec(createFeriadosSQL); err != nil {
+		return fmt.Errorf("erro ao criar tabela feriados: %w", err)
+	}
+
+	if _, err := s.db.Exec(createClassificacoesSQL); err != nil {
+		return fmt.Errorf("erro ao criar tabela classificacoes_territoriais: %w", err)
+	}
+
+	if _, err := s.db.Exec(createAliasesSQL); err != nil {
+		return fmt.Errorf("erro ao criar tabela cidades_aliases: %w", err)
+	}
+
+	if _, err := s.db.Exec(createHistoricoSQL); err != nil {
+		return fmt.Errorf("erro ao criar tabela municipios_historico: %w", err)
+	}
+
+	if _, err := s.db.Exec(createAlteracoesSQL); err != nil {
+		return fmt.Errorf("erro ao criar tabela alteracoes: %w", err)
+	}
+
+	if _, err := s.db.Exec(createMetadadosSQL); err != nil {
+		return fmt.Errorf("erro ao criar tabela metadados: %w", err)
+	}
+
 	log.Println("Tabelas criadas com sucesso!")
 	return nil
 }
@@ -211,8 +623,59 @@ func (s *Seeder) loadTOMData(filePath string) (map[int]string, error) {
 	return tomMap, nil
 }
 
+// loadFusosHorariosData carrega os dados do arquivo fusos-horarios.json.
+// O arquivo é opcional: se não existir, estados e cidades ficam sem fuso horário.
+func (s *Seeder) loadFusosHorariosData(filePath string) (*FusosHorarios, error) {
+	log.Printf("Carregando fusos horários de: %s", filePath)
+
+	fusos := &FusosHorarios{
+		PorUF:        make(map[string]string),
+		PorMunicipio: make(map[int]string),
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Printf("Arquivo %s não encontrado, fusos horários ignorados", filePath)
+			return fusos, nil
+		}
+		return nil, fmt.Errorf("erro ao ler arquivo: %w", err)
+	}
+
+	var fusosJSON FusosHorariosJSON
+	if err := json.Unmarshal(data, &fusosJSON); err != nil {
+		return nil, fmt.Errorf("erro ao fazer unmarshal do JSON: %w", err)
+	}
+
+	for _, e := range fusosJSON.Estados {
+		fusos.PorUF[strings.ToUpper(e.UF)] = e.FusoHorario
+	}
+	for _, m := range fusosJSON.Municipios {
+		fusos.PorMunicipio[m.CodigoIBGE] = m.FusoHorario
+	}
+
+	log.Printf("Carregados fusos de %d estados e %d municípios", len(fusos.PorUF), len(fusos.PorMunicipio))
+	return fusos, nil
+}
+
+// doEstado retorna o fuso horário padrão do estado, ou nil se não houver.
+func (f *FusosHorarios) doEstado(uf string) *string {
+	if fuso, ok := f.PorUF[strings.ToUpper(uf)]; ok {
+		return &fuso
+	}
+	return nil
+}
+
+// doMunicipio retorna o fuso horário do município, caindo para o fuso do estado.
+func (f *FusosHorarios) doMunicipio(codigo int, uf string) *string {
+	if fuso, ok := f.PorMunicipio[codigo]; ok {
+		return &fuso
+	}
+	return f.doEstado(uf)
+}
+
 // seedEstados popula a tabela de estados
-func (s *Seeder) seedEstados(cidades []CidadeIBGE) error {
+func (s *Seeder) seedEstados(cidades []CidadeIBGE, fusos *FusosHorarios) error {
 	log.Println("Populando estados...")
 
 	// Extrair estados únicos
@@ -230,20 +693,25 @@ func (s *Seeder) seedEstados(cidades []CidadeIBGE) error {
 	switch s.driverName {
 	case "postgres":
 		stmt, err = s.db.Prepare(`
-			INSERT INTO estados (codigo_ibge, nome, sigla) 
-			VALUES ($1, $2, $3) 
-			ON CONFLICT (codigo_ibge) DO NOTHING
+			INSERT INTO estados (codigo_ibge, nome, sigla, fuso_horario) 
+			VALUES ($1, $2, $3, $4) 
+			ON CONFLICT (codigo_ibge) DO UPDATE SET 
+				nome = EXCLUDED.nome, sigla = EXCLUDED.sigla, fuso_horario = EXCLUDED.fuso_horario
 		`)
 	case "sqlite3":
 		stmt, err = s.db.Prepare(`
-			INSERT OR IGNORE INTO estados (codigo_ibge, nome, sigla) 
-			VALUES (?, ?, ?)
+			INSERT INTO estados (codigo_ibge, nome, sigla, fuso_horario) 
+			VALUES (?, ?, ?, ?) 
+			ON CONFLICT (codigo_ibge) DO UPDATE SET 
+				nome = excluded.nome, sigla = excluded.sigla, fuso_horario = excluded.fuso_horario
 		`)
 	default:
 		// MySQL e outros
 		stmt, err = s.db.Prepare(`
-			INSERT IGNORE INTO estados (codigo_ibge, nome, sigla) 
-			VALUES (?, ?, ?)
+			INSERT INTO estados (codigo_ibge, nome, sigla, fuso_horario) 
+			VALUES (?, ?, ?, ?) 
+			ON DUPLICATE KEY UPDATE 
+				nome = VALUES(nome), sigla = VALUES(sigla), fuso_horario = VALUES(fuso_horario)
 		`)
 	}
 
@@ -255,7 +723,7 @@ func (s *Seeder) seedEstados(cidades []CidadeIBGE) error {
 	// Inserir estados
 	count := 0
 	for _, estado := range estadosMap {
-		if _, err := stmt.Exec(estado.CodigoUF, estado.UFNome, estado.UF); err != nil {
+		if _, err := stmt.Exec(estado.CodigoUF, estado.UFNome, estado.UF, fusos.doEstado(estado.UF)); err != nil {
 			return fmt.Errorf("erro ao inserir estado %s: %w", estado.UF, err)
 		}
 		count++
@@ -266,7 +734,7 @@ func (s *Seeder) seedEstados(cidades []CidadeIBGE) error {
 }
 
 // seedCidades popula a tabela de cidades
-func (s *Seeder) seedCidades(cidades []CidadeIBGE, tomData map[int]string) error {
+func (s *Seeder) seedCidades(cidades []CidadeIBGE, tomData map[int]string, fusos *FusosHorarios, gentilicosAliases map[int]GentilicoAliasesJSON) error {
 	log.Println("Populando cidades...")
 
 	// Preparar statement baseado no driver
@@ -276,20 +744,31 @@ func (s *Seeder) seedCidades(cidades []CidadeIBGE, tomData map[int]string) error
 	switch s.driverName {
 	case "postgres":
 		stmt, err = s.db.Prepare(`
-			INSERT INTO cidades (codigo_ibge, nome, codigo_tom, micro_regiao, regiao_imediata, estado_codigo_ibge) 
-			VALUES ($1, $2, $3, $4, $5, $6) 
-			ON CONFLICT (codigo_ibge) DO NOTHING
+			INSERT INTO cidades (codigo_ibge, nome, codigo_tom, micro_regiao, regiao_imediata, fuso_horario, gentilico, estado_codigo_ibge) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
+			ON CONFLICT (codigo_ibge) DO UPDATE SET 
+				nome = EXCLUDED.nome, codigo_tom = EXCLUDED.codigo_tom, micro_regiao = EXCLUDED.micro_regiao, 
+				regiao_imediata = EXCLUDED.regiao_imediata, fuso_horario = EXCLUDED.fuso_horario, 
+				gentilico = EXCLUDED.gentilico, estado_codigo_ibge = EXCLUDED.estado_codigo_ibge
 		`)
 	case "sqlite3":
 		stmt, err = s.db.Prepare(`
-			INSERT OR IGNORE INTO cidades (codigo_ibge, nome, codigo_tom, micro_regiao, regiao_imediata, estado_codigo_ibge) 
-			VALUES (?, ?, ?, ?, ?, ?)
+			INSERT INTO cidades (codigo_ibge, nome, codigo_tom, micro_regiao, regiao_imediata, fuso_horario, gentilico, estado_codigo_ibge) 
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?) 
+			ON CONFLICT (codigo_ibge) DO UPDATE SET 
+				nome = excluded.nome, codigo_tom = excluded.codigo_tom, micro_regiao = excluded.micro_regiao, 
+				regiao_imediata = excluded.regiao_imediata, fuso_horario = excluded.fuso_horario, 
+				gentilico = excluded.gentilico, estado_codigo_ibge = excluded.estado_codigo_ibge
 		`)
 	default:
 		// MySQL e outros
 		stmt, err = s.db.Prepare(`
-			INSERT IGNORE INTO cidades (codigo_ibge, nome, codigo_tom, micro_regiao, regiao_imediata, estado_codigo_ibge) 
-			VALUES (?, ?, ?, ?, ?, ?)
+			INSERT INTO cidades (codigo_ibge, nome, codigo_tom, micro_regiao, regiao_imediata, fuso_horario, gentilico, estado_codigo_ibge) 
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?) 
+			ON DUPLICATE KEY UPDATE 
+				nome = VALUES(nome), codigo_tom = VALUES(codigo_tom), micro_regiao = VALUES(micro_regiao), 
+				regiao_imediata = VALUES(regiao_imediata), fuso_horario = VALUES(fuso_horario), 
+				gentilico = VALUES(gentilico), estado_codigo_ibge = VALUES(estado_codigo_ibge)
 		`)
 	}
 
@@ -308,12 +787,20 @@ func (s *Seeder) seedCidades(cidades []CidadeIBGE, tomData map[int]string) error
 			codigoTOMPtr = &codigoTOM
 		}
 
+		// Buscar gentílico se existir
+		var gentilicoPtr *string
+		if ga, ok := gentilicosAliases[cidade.Codigo]; ok && ga.Gentilico != "" {
+			gentilicoPtr = &ga.Gentilico
+		}
+
 		if _, err := stmt.Exec(
 			cidade.Codigo,
 			cidade.Nome,
 			codigoTOMPtr,
 			fmt.Sprintf("%d", cidade.Microregiao),
 			fmt.Sprintf("%d", cidade.RegiaoImediata),
+			fusos.doMunicipio(cidade.Codigo, cidade.UF),
+			gentilicoPtr,
 			cidade.CodigoUF,
 		); err != nil {
 			return fmt.Errorf("erro ao inserir cidade %s (código: %d): %w", cidade.Nome, cidade.Codigo, err)
@@ -324,3 +811,549 @@ func (s *Seeder) seedCidades(cidades []CidadeIBGE, tomData map[int]string) error
 	log.Printf("Processadas %d cidades", count)
 	return nil
 }
+
+// loadFaixasCEPData carrega os dados do arquivo faixas-cep.json.
+// O arquivo é opcional: se não existir, nenhuma faixa é carregada.
+func (s *Seeder) loadFaixasCEPData(filePath string) ([]FaixaCEPJSON, error) {
+	log.Printf("Carregando faixas de CEP de: %s", filePath)
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Printf("Arquivo %s não encontrado, faixas de CEP ignoradas", filePath)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("erro ao ler arquivo: %w", err)
+	}
+
+	var faixas []FaixaCEPJSON
+	if err := json.Unmarshal(data, &faixas); err != nil {
+		return nil, fmt.Errorf("erro ao fazer unmarshal do JSON: %w", err)
+	}
+
+	// Normalizar CEPs para 8 dígitos sem máscara
+	for i := range faixas {
+		faixas[i].CEPInicial = strings.ReplaceAll(faixas[i].CEPInicial, "-", "")
+		faixas[i].CEPFinal = strings.ReplaceAll(faixas[i].CEPFinal, "-", "")
+	}
+
+	log.Printf("Carregadas %d faixas de CEP", len(faixas))
+	return faixas, nil
+}
+
+// seedFaixasCEP popula a tabela de faixas de CEP
+func (s *Seeder) seedFaixasCEP(faixas []FaixaCEPJSON) error {
+	log.Println("Populando faixas de CEP...")
+
+	var stmt *sql.Stmt
+	var err error
+
+	switch s.driverName {
+	case "postgres":
+		stmt, err = s.db.Prepare(`
+			INSERT INTO faixas_cep (cep_inicial, cep_final, uf, codigo_ibge) 
+			VALUES ($1, $2, $3, $4) 
+			ON CONFLICT (cep_inicial, codigo_ibge) DO UPDATE SET 
+				cep_final = EXCLUDED.cep_final, uf = EXCLUDED.uf
+		`)
+	case "sqlite3":
+		stmt, err = s.db.Prepare(`
+			INSERT INTO faixas_cep (cep_inicial, cep_final, uf, codigo_ibge) 
+			VALUES (?, ?, ?, ?) 
+			ON CONFLICT (cep_inicial, codigo_ibge) DO UPDATE SET 
+				cep_final = excluded.cep_final, uf = excluded.uf
+		`)
+	default:
+		// MySQL e outros
+		stmt, err = s.db.Prepare(`
+			INSERT INTO faixas_cep (cep_inicial, cep_final, uf, codigo_ibge) 
+			VALUES (?, ?, ?, ?) 
+			ON DUPLICATE KEY UPDATE 
+				cep_final = VALUES(cep_final), uf = VALUES(uf)
+		`)
+	}
+
+	if err != nil {
+		return fmt.Errorf("erro ao preparar statement: %w", err)
+	}
+	defer stmt.Close()
+
+	count := 0
+	presentes := make(map[string]bool, len(faixas))
+	for _, faixa := range faixas {
+		// Faixas estaduais são gravadas com codigo_ibge = 0
+		if _, err := stmt.Exec(faixa.CEPInicial, faixa.CEPFinal, strings.ToUpper(faixa.UF), faixa.CodigoIBGE); err != nil {
+			return fmt.Errorf("erro ao inserir faixa de CEP %s-%s: %w", faixa.CEPInicial, faixa.CEPFinal, err)
+		}
+		presentes[chaveLinha(faixa.CEPInicial, strconv.Itoa(faixa.CodigoIBGE))] = true
+		count++
+	}
+
+	// Sem o arquivo, as faixas já gravadas são mantidas; com ele, o arquivo é a lista completa
+	if faixas == nil {
+		log.Printf("Processadas %d faixas de CEP", count)
+		return nil
+	}
+	removidas, err := s.removerObsoletas("faixas_cep", []string{"cep_inicial", "codigo_ibge"}, presentes)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Processadas %d faixas de CEP, removidas %d ausentes do arquivo", count, removidas)
+	return nil
+}
+
+// loadFeriadosData carrega os dados do arquivo feriados.json.
+// O arquivo é opcional: se não existir, apenas os feriados nacionais estarão disponíveis.
+func (s *Seeder) loadFeriadosData(filePath string) (*FeriadosJSON, error) {
+	log.Printf("Carregando feriados de: %s", filePath)
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Printf("Arquivo %s não encontrado, feriados estaduais e municipais ignorados", filePath)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("erro ao ler arquivo: %w", err)
+	}
+
+	var feriados FeriadosJSON
+	if err := json.Unmarshal(data, &feriados); err != nil {
+		return nil, fmt.Errorf("erro ao fazer unmarshal do JSON: %w", err)
+	}
+
+	log.Printf("Carregados %d feriados estaduais e %d municipais", len(feriados.Estaduais), len(feriados.Municipais))
+	return &feriados, nil
+}
+
+// seedFeriados popula a tabela de feriados
+func (s *Seeder) seedFeriados(feriados *FeriadosJSON, cidades []CidadeIBGE) error {
+	// Sem o arquivo, os feriados já gravados são mantidos; com ele, o arquivo é a lista completa
+	if feriados == nil {
+		return nil
+	}
+	log.Println("Populando feriados...")
+
+	var stmt *sql.Stmt
+	var err error
+
+	switch s.driverName {
+	case "postgres":
+		stmt, err = s.db.Prepare(`
+			INSERT INTO feriados (uf, codigo_ibge, nome, dia, mes, dias_apos_pascoa) 
+			VALUES ($1, $2, $3, $4, $5, $6) 
+			ON CONFLICT (uf, codigo_ibge, nome) DO UPDATE SET 
+				dia = EXCLUDED.dia, mes = EXCLUDED.mes, dias_apos_pascoa = EXCLUDED.dias_apos_pascoa
+		`)
+	case "sqlite3":
+		stmt, err = s.db.Prepare(`
+			INSERT INTO feriados (uf, codigo_ibge, nome, dia, mes, dias_apos_pascoa) 
+			VALUES (?, ?, ?, ?, ?, ?) 
+			ON CONFLICT (uf, codigo_ibge, nome) DO UPDATE SET 
+				dia = excluded.dia, mes = excluded.mes, dias_apos_pascoa = excluded.dias_apos_pascoa
+		`)
+	default:
+		// MySQL e outros
+		stmt, err = s.db.Prepare(`
+			INSERT INTO feriados (uf, codigo_ibge, nome, dia, mes, dias_apos_pascoa) 
+			VALUES (?, ?, ?, ?, ?, ?) 
+			ON DUPLICATE KEY UPDATE 
+				dia = VALUES(dia), mes = VALUES(mes), dias_apos_pascoa = VALUES(dias_apos_pascoa)
+		`)
+	}
+
+	if err != nil {
+		return fmt.Errorf("erro ao preparar statement: %w", err)
+	}
+	defer stmt.Close()
+
+	// Os feriados municipais são gravados com a UF do município
+	ufPorMunicipio := make(map[int]string)
+	for _, cidade := range cidades {
+		ufPorMunicipio[cidade.Codigo] = cidade.UF
+	}
+
+	count := 0
+	presentes := make(map[string]bool, len(feriados.Estaduais)+len(feriados.Municipais))
+	for _, f := range feriados.Estaduais {
+		if _, err := stmt.Exec(strings.ToUpper(f.UF), 0, f.Nome, f.Dia, f.Mes, f.DiasAposPascoa); err != nil {
+			return fmt.Errorf("erro ao inserir feriado estadual %s (%s): %w", f.Nome, f.UF, err)
+		}
+		presentes[chaveLinha(strings.ToUpper(f.UF), "0", f.Nome)] = true
+		count++
+	}
+	for _, f := range feriados.Municipais {
+		uf, ok := ufPorMunicipio[f.CodigoIBGE]
+		if !ok {
+			return fmt.Errorf("feriado municipal %s referencia município inexistente: %d", f.Nome, f.CodigoIBGE)
+		}
+		if _, err := stmt.Exec(uf, f.CodigoIBGE, f.Nome, f.Dia, f.Mes, f.DiasAposPascoa); err != nil {
+			return fmt.Errorf("erro ao inserir feriado municipal %s (código: %d): %w", f.Nome, f.CodigoIBGE, err)
+		}
+		presentes[chaveLinha(uf, strconv.Itoa(f.CodigoIBGE), f.Nome)] = true
+		count++
+	}
+
+	removidos, err := s.removerObsoletas("feriados", []string{"uf", "codigo_ibge", "nome"}, presentes)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Processados %d feriados, removidos %d ausentes do arquivo", count, removidos)
+	return nil
+}
+
+// loadClassificacoesData carrega os dados do arquivo classificacoes-territoriais.json.
+// O arquivo é opcional: se não existir, nenhuma cidade recebe classificação.
+func (s *Seeder) loadClassificacoesData(filePath string) (ClassificacoesJSON, error) {
+	log.Printf("Carregando classificações territoriais de: %s", filePath)
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Printf("Arquivo %s não encontrado, classificações territoriais ignoradas", filePath)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("erro ao ler arquivo: %w", err)
+	}
+
+	var classificacoes ClassificacoesJSON
+	if err := json.Unmarshal(data, &classificacoes); err != nil {
+		return nil, fmt.Errorf("erro ao fazer unmarshal do JSON: %w", err)
+	}
+
+	log.Printf("Carregadas %d classificações territoriais", len(classificacoes))
+	return classificacoes, nil
+}
+
+// seedClassificacoes popula a tabela de classificações territoriais.
+// Estados listados inteiros são expandidos para todos os seus municípios.
+func (s *Seeder) seedClassificacoes(classificacoes ClassificacoesJSON, cidades []CidadeIBGE) error {
+	log.Println("Populando classificações territoriais...")
+
+	var stmt *sql.Stmt
+	var err error
+
+	switch s.driverName {
+	case "postgres":
+		stmt, err = s.db.Prepare(`
+			INSERT INTO classificacoes_territoriais (codigo_ibge, classificacao, paises_fronteira) 
+			VALUES ($1, $2, $3) 
+			ON CONFLICT (codigo_ibge, classificacao) DO UPDATE SET 
+				paises_fronteira = COALESCE(EXCLUDED.paises_fronteira, classificacoes_territoriais.paises_fronteira)
+		`)
+	case "sqlite3":
+		stmt, err = s.db.Prepare(`
+			INSERT INTO classificacoes_territoriais (codigo_ibge, classificacao, paises_fronteira) 
+			VALUES (?, ?, ?) 
+			ON CONFLICT (codigo_ibge, classificacao) DO UPDATE SET 
+				paises_fronteira = COALESCE(excluded.paises_fronteira, classificacoes_territoriais.paises_fronteira)
+		`)
+	default:
+		// MySQL e outros
+		stmt, err = s.db.Prepare(`
+			INSERT INTO classificacoes_territoriais (codigo_ibge, classificacao, paises_fronteira) 
+			VALUES (?, ?, ?) 
+			ON DUPLICATE KEY UPDATE 
+				paises_fronteira = COALESCE(VALUES(paises_fronteira), paises_fronteira)
+		`)
+	}
+
+	if err != nil {
+		return fmt.Errorf("erro ao preparar statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for classificacao, recorte := range classificacoes {
+		if !recorte.Completa {
+			log.Printf("Classificação %s ignorada: a lista de municípios (%s) não está completa", classificacao, recorte.Fonte)
+		}
+	}
+
+	count := 0
+	presentes := make(map[string]bool)
+	for _, c := range classificacoesDoArquivo(classificacoes, cidades) {
+		var paises *string
+		if len(c.PaisesFronteira) > 0 {
+			p := strings.Join(c.PaisesFronteira, ",")
+			paises = &p
+		}
+		if _, err := stmt.Exec(c.CodigoIBGE, string(c.Classificacao), paises); err != nil {
+			return fmt.Errorf("erro ao inserir classificação %s (código: %d): %w", c.Classificacao, c.CodigoIBGE, err)
+		}
+		presentes[chaveLinha(strconv.Itoa(c.CodigoIBGE), string(c.Classificacao))] = true
+		count++
+	}
+
+	// Sem o arquivo, as classificações já gravadas são mantidas; com ele, os recortes incompletos
+	// ou ausentes do arquivo são apagados
+	if classificacoes == nil {
+		log.Printf("Processadas %d classificações territoriais", count)
+		return nil
+	}
+	removidas, err := s.removerObsoletas("classificacoes_territoriais", []string{"codigo_ibge", "classificacao"}, presentes)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Processadas %d classificações territoriais, removidas %d ausentes do arquivo", count, removidas)
+	return nil
+}
+
+// classificacoesDoArquivo lista os municípios de cada recorte completo do arquivo: os avulsos,
+// com os países de fronteira, e todos os dos estados inteiros. Uma lista parcial faria a API
+// responder false para municípios que pertencem ao recorte, então as incompletas são ignoradas.
+func classificacoesDoArquivo(classificacoes ClassificacoesJSON, cidades []CidadeIBGE) []domain.ClassificacaoMunicipio {
+	municipiosPorUF := make(map[string][]int)
+	for _, cidade := range cidades {
+		municipiosPorUF[strings.ToUpper(cidade.UF)] = append(municipiosPorUF[strings.ToUpper(cidade.UF)], cidade.Codigo)
+	}
+
+	var municipios []domain.ClassificacaoMunicipio
+	for _, nome := range slices.Sorted(maps.Keys(classificacoes)) {
+		recorte := classificacoes[nome]
+		if !recorte.Completa {
+			continue
+		}
+		// Um município avulso de um estado inteiro fica uma vez só, com os países de fronteira
+		incluidos := make(map[int]bool)
+		for _, m := range recorte.Municipios {
+			if !incluidos[m.CodigoIBGE] {
+				incluidos[m.CodigoIBGE] = true
+				municipios = append(municipios, domain.ClassificacaoMunicipio{
+					CodigoIBGE: m.CodigoIBGE, Classificacao: domain.Classificacao(nome), PaisesFronteira: m.Paises,
+				})
+			}
+		}
+		for _, uf := range recorte.Estados {
+			for _, codigo := range municipiosPorUF[strings.ToUpper(uf)] {
+				if !incluidos[codigo] {
+					incluidos[codigo] = true
+					municipios = append(municipios, domain.ClassificacaoMunicipio{CodigoIBGE: codigo, Classificacao: domain.Classificacao(nome)})
+				}
+			}
+		}
+	}
+	return municipios
+}
+
+// loadGentilicosAliasesData carrega os dados do arquivo gentilicos-aliases.json, indexados por código IBGE.
+// O arquivo é opcional: se não existir, as cidades ficam sem gentílico e sem nomes alternativos.
+func (s *Seeder) loadGentilicosAliasesData(filePath string) (map[int]GentilicoAliasesJSON, error) {
+	log.Printf("Carregando gentílicos e aliases de: %s", filePath)
+
+	gentilicosAliases := make(map[int]GentilicoAliasesJSON)
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Printf("Arquivo %s não encontrado, gentílicos e aliases ignorados", filePath)
+			return gentilicosAliases, nil
+		}
+		return nil, fmt.Errorf("erro ao ler arquivo: %w", err)
+	}
+
+	var lista []GentilicoAliasesJSON
+	if err := json.Unmarshal(data, &lista); err != nil {
+		return nil, fmt.Errorf("erro ao fazer unmarshal do JSON: %w", err)
+	}
+
+	for _, ga := range lista {
+		gentilicosAliases[ga.CodigoIBGE] = ga
+	}
+
+	log.Printf("Carregados gentílicos e aliases de %d municípios", len(gentilicosAliases))
+	return gentilicosAliases, nil
+}
+
+// seedAliases popula a tabela de nomes alternativos das cidades
+func (s *Seeder) seedAliases(gentilicosAliases map[int]GentilicoAliasesJSON) error {
+	log.Println("Populando aliases...")
+
+	var stmt *sql.Stmt
+	var err error
+
+	switch s.driverName {
+	case "postgres":
+		stmt, err = s.db.Prepare(`
+			INSERT INTO cidades_aliases (codigo_ibge, alias) 
+			VALUES ($1, $2) 
+			ON CONFLICT (codigo_ibge, alias) DO NOTHING
+		`)
+	case "sqlite3":
+		stmt, err = s.db.Prepare(`
+			INSERT OR IGNORE INTO cidades_aliases (codigo_ibge, alias) 
+			VALUES (?, ?)
+		`)
+	default:
+		// MySQL e outros
+		stmt, err = s.db.Prepare(`
+			INSERT IGNORE INTO cidades_aliases (codigo_ibge, alias) 
+			VALUES (?, ?)
+		`)
+	}
+
+	if err != nil {
+		return fmt.Errorf("erro ao preparar statement: %w", err)
+	}
+	defer stmt.Close()
+
+	count := 0
+	presentes := make(map[string]bool)
+	for codigo, ga := range gentilicosAliases {
+		for _, alias := range ga.Aliases {
+			if _, err := stmt.Exec(codigo, alias); err != nil {
+				return fmt.Errorf("erro ao inserir alias %s (código: %d): %w", alias, codigo, err)
+			}
+			presentes[chaveLinha(strconv.Itoa(codigo), alias)] = true
+			count++
+		}
+	}
+
+	// Como o gentílico, os aliases acompanham o arquivo: os que saíram dele são apagados
+	removidos, err := s.removerObsoletas("cidades_aliases", []string{"codigo_ibge", "alias"}, presentes)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Processados %d aliases, removidos %d ausentes do arquivo", count, removidos)
+	return nil
+}
+
+// loadHistoricoData carrega os dados do arquivo municipios-historico.json.
+// O arquivo é opcional: se não existir, os municípios ficam sem histórico.
+func (s *Seeder) loadHistoricoData(filePath string) (*HistoricoMunicipiosJSON, error) {
+	log.Printf("Carregando histórico de municípios de: %s", filePath)
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Printf("Arquivo %s não encontrado, histórico de municípios ignorado", filePath)
+			return &HistoricoMunicipiosJSON{}, nil
+		}
+		return nil, fmt.Errorf("erro ao ler arquivo: %w", err)
+	}
+
+	var historico HistoricoMunicipiosJSON
+	if err := json.Unmarshal(data, &historico); err != nil {
+		return nil, fmt.Errorf("erro ao fazer unmarshal do JSON: %w", err)
+	}
+
+	for _, e := range historico.Eventos {
+		if _, err := time.Parse("2006-01-02", e.Data); err != nil {
+			return nil, fmt.Errorf("data inválida %q no evento do município %d", e.Data, e.CodigoIBGE)
+		}
+	}
+
+	log.Printf("Carregados %d eventos de histórico de municípios", len(historico.Eventos))
+	return &historico, nil
+}
+
+// seedHistorico popula a tabela de eventos (criação, desmembramento, renomeação e extinção) dos municípios.
+// Não há chave estrangeira para cidades, pois municípios extintos não constam da tabela de cidades.
+func (s *Seeder) seedHistorico(historico *HistoricoMunicipiosJSON) error {
+	log.Println("Populando histórico de municípios...")
+
+	var stmt *sql.Stmt
+	var err error
+
+	switch s.driverName {
+	case "postgres":
+		stmt, err = s.db.Prepare(`
+			INSERT INTO municipios_historico (codigo_ibge, tipo, data, nome, nome_anterior, uf, origem, sucessores) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
+			ON CONFLICT (codigo_ibge, tipo, data) DO NOTHING
+		`)
+	case "sqlite3":
+		stmt, err = s.db.Prepare(`
+			INSERT OR IGNORE INTO municipios_historico (codigo_ibge, tipo, data, nome, nome_anterior, uf, origem, sucessores) 
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		`)
+	default:
+		// MySQL e outros
+		stmt, err = s.db.Prepare(`
+			INSERT IGNORE INTO municipios_historico (codigo_ibge, tipo, data, nome, nome_anterior, uf, origem, sucessores) 
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		`)
+	}
+
+	if err != nil {
+		return fmt.Errorf("erro ao preparar statement: %w", err)
+	}
+	defer stmt.Close()
+
+	count := 0
+	for _, e := range historico.Eventos {
+		if _, err := stmt.Exec(e.CodigoIBGE, e.Tipo, e.Data, e.Nome, e.NomeAnterior, strings.ToUpper(e.UF), juntarCodigos(e.Origem), juntarCodigos(e.Sucessores)); err != nil {
+			return fmt.Errorf("erro ao inserir evento %s do município %d: %w", e.Tipo, e.CodigoIBGE, err)
+		}
+		count++
+	}
+
+	log.Printf("Processados %d eventos de histórico de municípios", count)
+	return nil
+}
+
+// juntarCodigos serializa uma lista de códigos IBGE separados por vírgula.
+func juntarCodigos(codigos []int) string {
+	partes := make([]string, len(codigos))
+	for i, codigo := range codigos {
+		partes[i] = strconv.Itoa(codigo)
+	}
+	return strings.Join(partes, ",")
+}
+
+// chaveLinha junta os valores das colunas da chave primária de uma linha, para comparar as linhas
+// gravadas com as dos arquivos JSON.
+func chaveLinha(valores ...string) string {
+	return strings.Join(valores, "\x00")
+}
+
+// removerObsoletas apaga da tabela as linhas cuja chave primária (as colunas informadas) não está
+// entre as presentes nos arquivos JSON, montadas com chaveLinha. Retorna o número de linhas apagadas.
+func (s *Seeder) removerObsoletas(tabela string, colunas []string, presentes map[string]bool) (int, error) {
+	rows, err := s.db.Query(fmt.Sprintf("SELECT %s FROM %s", strings.Join(colunas, ", "), tabela))
+	if err != nil {
+		return 0, fmt.Errorf("erro ao ler %s: %w", tabela, err)
+	}
+	var obsoletas [][]interface{}
+	for rows.Next() {
+		valores := make([]sql.NullString, len(colunas))
+		destinos := make([]interface{}, len(colunas))
+		for i := range valores {
+			destinos[i] = &valores[i]
+		}
+		if err := rows.Scan(destinos...); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("erro ao ler %s: %w", tabela, err)
+		}
+		chave := make([]string, len(valores))
+		args := make([]interface{}, len(valores))
+		for i, v := range valores {
+			chave[i], args[i] = v.String, v.String
+		}
+		if !presentes[chaveLinha(chave...)] {
+			obsoletas = append(obsoletas, args)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("erro ao ler %s: %w", tabela, err)
+	}
+
+	condicoes := make([]string, len(colunas))
+	for i, coluna := range colunas {
+		placeholder := "?"
+		if s.driverName == "postgres" {
+			placeholder = fmt.Sprintf("$%d", i+1)
+		}
+		condicoes[i] = fmt.Sprintf("%s = %s", coluna, placeholder)
+	}
+	deleteSQL := fmt.Sprintf("DELETE FROM %s WHERE %s", tabela, strings.Join(condicoes, " AND "))
+	for _, args := range obsoletas {
+		if _, err := s.db.Exec(deleteSQL, args...); err != nil {
+			return 0, fmt.Errorf("erro ao remover linha obsoleta de %s: %w", tabela, err)
+		}
+	}
+	return len(obsoletas), nil
+}
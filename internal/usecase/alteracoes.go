@@ -0,0 +1,30 @@
+package usecase
+
+import "github.com/brauliohms/ibge-service/internal/domain"
+
+// GetAlteracoes retorna as alterações de estados e municípios registradas depois da versão
+// informada, para que clientes mantenham cópias locais sincronizadas sem baixar tudo novamente.
+// Use desde = 0 para obter o histórico completo.
+func (uc *IBGEUseCase) GetAlteracoes(desde int) (*domain.FeedAlteracoes, error) {
+	versaoAtual, err := uc.repo.FindVersaoDataset()
+	if err != nil {
+		return nil, err
+	}
+	if desde < 0 || desde > versaoAtual {
+		return nil, domain.ArgumentoInvalido(domain.MsgVersaoAlteracoesInvalida, desde, versaoAtual)
+	}
+
+	alteracoes, err := uc.repo.FindAlteracoesDesde(desde)
+	if err != nil {
+		return nil, err
+	}
+	if alteracoes == nil {
+		alteracoes = []domain.Alteracao{}
+	}
+
+	return &domain.FeedAlteracoes{
+		Desde:       desde,
+		VersaoAtual: versaoAtual,
+		Alteracoes:  alteracoes,
+	}, nil
+}
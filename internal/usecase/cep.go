@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// ErrCEPInvalido indica que o CEP informado não possui 8 dígitos.
+var ErrCEPInvalido = domain.CodigoInvalido(domain.MsgCEPInvalido)
+
+// NormalizarCEP remove a máscara do CEP e valida se restam exatamente 8 dígitos.
+func NormalizarCEP(cep string) (string, error) {
+	var b strings.Builder
+	for _, r := range cep {
+		switch {
+		case unicode.IsDigit(r):
+			b.WriteRune(r)
+		case r == '-' || r == '.' || r == ' ':
+			// Caracteres de máscara são ignorados
+		default:
+			return "", ErrCEPInvalido
+		}
+	}
+	if b.Len() != 8 {
+		return "", ErrCEPInvalido
+	}
+	return b.String(), nil
+}
+
+// GetCidadeByCEP retorna a cidade cuja faixa de CEP contém o CEP informado.
+func (uc *IBGEUseCase) GetCidadeByCEP(cep string) (*domain.Cidade, error) {
+	normalizado, err := NormalizarCEP(cep)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.FindCidadeByCEP(normalizado)
+}
+
+// ValidarEndereco verifica se a tripla (CEP, cidade, UF) de um endereço é consistente.
+// A cidade pode ser informada pelo código IBGE ou pelo nome (sem diferenciar acentos e caixa).
+func (uc *IBGEUseCase) ValidarEndereco(cep, cidade, uf string) (*domain.ValidacaoEndereco, error) {
+	normalizado, err := NormalizarCEP(cep)
+	if err != nil {
+		return nil, err
+	}
+
+	estado, err := uc.repo.FindEstadoByCEP(normalizado)
+	if err != nil {
+		return nil, err
+	}
+
+	resultado := &domain.ValidacaoEndereco{
+		CEP:            normalizado,
+		EstadoEsperado: estado,
+	}
+
+	resultado.UFConfere = strings.EqualFold(strings.TrimSpace(uf), estado.Sigla) ||
+		strings.TrimSpace(uf) == strconv.Itoa(estado.CodigoIBGE)
+	if !resultado.UFConfere {
+		resultado.Inconsistencias = append(resultado.Inconsistencias,
+			domain.Inconsistencia{Codigo: domain.MsgCEPDeOutroEstado, Args: []interface{}{normalizado, estado.Sigla, uf}})
+	}
+
+	cidadeEsperada, err := uc.repo.FindCidadeByCEP(normalizado)
+	if err != nil {
+		// O CEP é válido para o estado, mas não há faixa municipal cadastrada para ele.
+		resultado.Inconsistencias = append(resultado.Inconsistencias,
+			domain.Inconsistencia{Codigo: domain.MsgCEPSemFaixaMunicipal, Args: []interface{}{normalizado}})
+	} else {
+		resultado.CidadeEsperada = cidadeEsperada
+		resultado.CidadeConfere = cidadeConfere(*cidadeEsperada, cidade)
+		if !resultado.CidadeConfere {
+			resultado.Inconsistencias = append(resultado.Inconsistencias, domain.Inconsistencia{Codigo: domain.MsgCEPDeOutraCidade,
+				Args: []interface{}{normalizado, cidadeEsperada.Nome, cidadeEsperada.EstadoSigla, cidade}})
+		}
+	}
+
+	resultado.Valido = resultado.UFConfere && resultado.CidadeConfere
+	return resultado, nil
+}
+
+// cidadeConfere compara a cidade informada (código IBGE ou nome) com a cidade esperada.
+func cidadeConfere(esperada domain.Cidade, informada string) bool {
+	informada = strings.TrimSpace(informada)
+	if informada == strconv.Itoa(esperada.CodigoIBGE) {
+		return true
+	}
+	return esperada.ConhecidaComo(informada)
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"sort"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// GetConjuntoDados retorna a edição completa do conjunto de dados, com estados e cidades
+// ordenados pelo código IBGE.
+func (uc *IBGEUseCase) GetConjuntoDados() (*domain.ConjuntoDados, error) {
+	estados, err := uc.repo.FindAllEstados()
+	if err != nil {
+		return nil, err
+	}
+	estados = append([]domain.Estado{}, estados...)
+	sort.Slice(estados, func(i, j int) bool { return estados[i].CodigoIBGE < estados[j].CodigoIBGE })
+
+	cidades, err := uc.cidadesDosEstados(estados)
+	if err != nil {
+		return nil, err
+	}
+	extintas, err := uc.repo.FindCidadesExtintas()
+	if err != nil {
+		return nil, err
+	}
+	faixas, err := uc.repo.FindAllFaixasCEP()
+	if err != nil {
+		return nil, err
+	}
+	versao, err := uc.repo.FindVersaoDataset()
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ConjuntoDados{
+		Versao:          versao,
+		Estados:         estados,
+		Cidades:         cidades,
+		CidadesExtintas: extintas,
+		FaixasCEP:       faixas,
+	}, nil
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// formatoData é o formato usado para datas nas entradas e saídas de feriados.
+const formatoData = "2006-01-02"
+
+// MaxDiasUteis limita o tamanho do prazo aceito pelo cálculo de dias úteis.
+const MaxDiasUteis = 3650
+
+func diasAposPascoa(d int) *int {
+	return &d
+}
+
+// feriadosNacionais contém os feriados nacionais, incluindo os móveis calculados a partir da Páscoa.
+// Carnaval e Corpus Christi são pontos facultativos federais, mas tratados como feriados pelo comércio e bancos.
+var feriadosNacionais = []domain.RegraFeriado{
+	{Nome: "Confraternização Universal", Tipo: domain.FeriadoNacional, Dia: 1, Mes: 1},
+	{Nome: "Carnaval (segunda-feira)", Tipo: domain.FeriadoNacional, DiasAposPascoa: diasAposPascoa(-48)},
+	{Nome: "Carnaval (terça-feira)", Tipo: domain.FeriadoNacional, DiasAposPascoa: diasAposPascoa(-47)},
+	{Nome: "Sexta-feira Santa", Tipo: domain.FeriadoNacional, DiasAposPascoa: diasAposPascoa(-2)},
+	{Nome: "Tiradentes", Tipo: domain.FeriadoNacional, Dia: 21, Mes: 4},
+	{Nome: "Dia do Trabalho", Tipo: domain.FeriadoNacional, Dia: 1, Mes: 5},
+	{Nome: "Corpus Christi", Tipo: domain.FeriadoNacional, DiasAposPascoa: diasAposPascoa(60)},
+	{Nome: "Independência do Brasil", Tipo: domain.FeriadoNacional, Dia: 7, Mes: 9},
+	{Nome: "Nossa Senhora Aparecida", Tipo: domain.FeriadoNacional, Dia: 12, Mes: 10},
+	{Nome: "Finados", Tipo: domain.FeriadoNacional, Dia: 2, Mes: 11},
+	{Nome: "Proclamação da República", Tipo: domain.FeriadoNacional, Dia: 15, Mes: 11},
+	{Nome: "Dia Nacional de Zumbi e da Consciência Negra", Tipo: domain.FeriadoNacional, Dia: 20, Mes: 11, DesdeAno: 2024},
+	{Nome: "Natal", Tipo: domain.FeriadoNacional, Dia: 25, Mes: 12},
+}
+
+// Pascoa calcula o domingo de Páscoa de um ano pelo algoritmo de Meeus/Jones/Butcher (calendário gregoriano).
+func Pascoa(ano int) time.Time {
+	a := ano % 19
+	b := ano / 100
+	c := ano % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	mes := (h + l - 7*m + 114) / 31
+	dia := (h+l-7*m+114)%31 + 1
+	return time.Date(ano, time.Month(mes), dia, 0, 0, 0, 0, time.UTC)
+}
+
+// ocorrencias converte as regras em feriados concretos no ano informado, ordenados por data.
+func ocorrencias(regras []domain.RegraFeriado, ano int) []domain.Feriado {
+	pascoa := Pascoa(ano)
+	feriados := make([]domain.Feriado, 0, len(regras))
+	for _, regra := range regras {
+		if regra.DesdeAno != 0 && ano < regra.DesdeAno {
+			continue
+		}
+		var data time.Time
+		if regra.Movel() {
+			data = pascoa.AddDate(0, 0, *regra.DiasAposPascoa)
+		} else {
+			data = time.Date(ano, time.Month(regra.Mes), regra.Dia, 0, 0, 0, 0, time.UTC)
+		}
+		feriados = append(feriados, domain.Feriado{
+			Data: data.Format(formatoData),
+			Nome: regra.Nome,
+			Tipo: regra.Tipo,
+		})
+	}
+	sort.SliceStable(feriados, func(i, j int) bool {
+		return feriados[i].Data < feriados[j].Data
+	})
+	return feriados
+}
+
+// regrasFeriados reúne as regras nacionais, estaduais e municipais aplicáveis.
+// Se a cidade for informada, a UF é ignorada e o estado da cidade é usado.
+func (uc *IBGEUseCase) regrasFeriados(codigoCidade, uf string) ([]domain.RegraFeriado, error) {
+	regras := append([]domain.RegraFeriado{}, feriadosNacionais...)
+
+	if codigoCidade != "" {
+		cidade, err := uc.repo.FindCidadeByCodigo(codigoCidade)
+		if err != nil {
+			return nil, err
+		}
+		estado, err := uc.repo.FindEstadoByUF(cidade.EstadoSigla)
+		if err != nil {
+			return nil, err
+		}
+		regras = append(regras, estado.Feriados...)
+		return append(regras, cidade.Feriados...), nil
+	}
+
+	if uf != "" {
+		var estado *domain.Estado
+		var err error
+		if _, parseErr := strconv.Atoi(uf); parseErr == nil {
+			estado, err = uc.repo.FindEstadoByCodigoIbge(uf)
+		} else {
+			estado, err = uc.repo.FindEstadoByUF(uf)
+		}
+		if err != nil {
+			return nil, err
+		}
+		regras = append(regras, estado.Feriados...)
+	}
+
+	return regras, nil
+}
+
+// GetFeriados retorna os feriados de um ano. Sem cidade nem UF, retorna apenas os nacionais.
+func (uc *IBGEUseCase) GetFeriados(ano int, codigoCidade, uf string) ([]domain.Feriado, error) {
+	regras, err := uc.regrasFeriados(codigoCidade, uf)
+	if err != nil {
+		return nil, err
+	}
+	return ocorrencias(regras, ano), nil
+}
+
+// CalcularDiasUteis soma (ou subtrai, se negativo) dias úteis a uma data, pulando fins de semana
+// e os feriados nacionais, estaduais e municipais da cidade. A data de início não é contada.
+func (uc *IBGEUseCase) CalcularDiasUteis(codigoCidade string, inicio time.Time, dias int) (*domain.DiasUteis, error) {
+	regras, err := uc.regrasFeriados(codigoCidade, "")
+	if err != nil {
+		return nil, err
+	}
+	cidade, err := uc.repo.FindCidadeByCodigo(codigoCidade)
+	if err != nil {
+		return nil, err
+	}
+
+	// Índice de feriados por data, calculado sob demanda para cada ano atravessado
+	feriadosPorData := make(map[string]domain.Feriado)
+	anosCalculados := make(map[int]bool)
+	feriadoEm := func(data time.Time) (domain.Feriado, bool) {
+		if !anosCalculados[data.Year()] {
+			for _, f := range ocorrencias(regras, data.Year()) {
+				if _, existe := feriadosPorData[f.Data]; !existe {
+					feriadosPorData[f.Data] = f
+				}
+			}
+			anosCalculados[data.Year()] = true
+		}
+		f, ok := feriadosPorData[data.Format(formatoData)]
+		return f, ok
+	}
+
+	passo := 1
+	restantes := dias
+	if dias < 0 {
+		passo = -1
+		restantes = -dias
+	}
+
+	considerados := []domain.Feriado{}
+	atual := inicio
+	for restantes > 0 {
+		atual = atual.AddDate(0, 0, passo)
+		if atual.Weekday() == time.Saturday || atual.Weekday() == time.Sunday {
+			continue
+		}
+		if f, ok := feriadoEm(atual); ok {
+			considerados = append(considerados, f)
+			continue
+		}
+		restantes--
+	}
+
+	return &domain.DiasUteis{
+		CodigoIBGE:           cidade.CodigoIBGE,
+		Inicio:               inicio.Format(formatoData),
+		Dias:                 dias,
+		DataFinal:            atual.Format(formatoData),
+		FeriadosConsiderados: considerados,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// ErrDataInvalida é retornado quando a data de referência não está no formato AAAA-MM-DD.
+var ErrDataInvalida = domain.ArgumentoInvalido(domain.MsgDataInvalida)
+
+// ValidarData verifica se a data de referência de uma consulta histórica está no formato AAAA-MM-DD.
+func ValidarData(data string) error {
+	if _, err := time.Parse(formatoData, data); err != nil {
+		return ErrDataInvalida
+	}
+	return nil
+}
+
+// GetCidadeByCodigoNaData retorna a situação do município na data informada.
+// Municípios extintos depois dessa data são retornados como eram antes da extinção.
+func (uc *IBGEUseCase) GetCidadeByCodigoNaData(codigo_ibge string, data string) (*domain.Cidade, error) {
+	cidade, err := uc.cidadeIncluindoExtintas(codigo_ibge)
+	if err != nil {
+		return nil, err
+	}
+	return uc.CidadeNaData(cidade, data)
+}
+
+// GetCidadeByCEPNaData retorna o município ao qual o CEP pertencia na data informada. Se o
+// município atual foi desmembrado depois dela, o CEP pertencia ao município de origem, desde
+// que ele seja único: com várias origens, não há como saber de qual delas era o endereço.
+func (uc *IBGEUseCase) GetCidadeByCEPNaData(cep string, data string) (*domain.Cidade, error) {
+	cidade, err := uc.GetCidadeByCEP(cep)
+	if err != nil {
+		return nil, err
+	}
+	visitados := map[int]bool{}
+	for {
+		if situacao, existia := cidade.NaData(data); existia {
+			return &situacao, nil
+		}
+		origem := cidade.OrigemNaData(data)
+		if len(origem) != 1 || visitados[origem[0]] {
+			return nil, domain.NaoEncontrado(domain.MsgCidadeInexistenteNaData, cidade.CodigoIBGE, data)
+		}
+		visitados[cidade.CodigoIBGE] = true
+		if cidade, err = uc.cidadeIncluindoExtintas(strconv.Itoa(origem[0])); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// cidadeIncluindoExtintas busca o município pelo código IBGE, mesmo que ele já esteja extinto.
+func (uc *IBGEUseCase) cidadeIncluindoExtintas(codigo_ibge string) (*domain.Cidade, error) {
+	cidade, err := uc.repo.FindCidadeByCodigo(codigo_ibge)
+	var extinta *domain.CidadeExtintaError
+	if errors.As(err, &extinta) {
+		return &extinta.Cidade, nil
+	}
+	return cidade, err
+}
+
+// CidadeNaData aplica à cidade as alterações vigentes na data informada (nome da época,
+// por exemplo), retornando erro se o município ainda não existia ou estava extinto. Extinto na
+// data, o erro é um CidadeExtintaError com os sucessores daquela data.
+func (uc *IBGEUseCase) CidadeNaData(cidade *domain.Cidade, data string) (*domain.Cidade, error) {
+	situacao, existia := cidade.NaData(data)
+	if existia {
+		return &situacao, nil
+	}
+	extincao, extinta := cidade.ExtincaoEm(data)
+	if !extinta {
+		return nil, domain.NaoEncontrado(domain.MsgCidadeInexistenteNaData, cidade.CodigoIBGE, data)
+	}
+
+	erro := &domain.CidadeExtintaError{Cidade: *cidade, Data: extincao.Data}
+	for _, codigo := range extincao.Sucessores {
+		sucessora, err := uc.cidadeIncluindoExtintas(strconv.Itoa(codigo))
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if situacao, existia := sucessora.NaData(data); existia {
+			erro.Sucessores = append(erro.Sucessores, situacao)
+		}
+	}
+	return nil, erro
+}
+
+// CidadesNaData retorna a situação de uma lista de cidades na data informada. Cidades criadas
+// depois dessa data são removidas; municípios extintos depois dela que satisfaçam o critério
+// incluir são acrescentados ao final da lista.
+func (uc *IBGEUseCase) CidadesNaData(cidades []domain.Cidade, data string, incluir func(domain.Cidade) bool) ([]domain.Cidade, error) {
+	resultado := []domain.Cidade{}
+	for _, cidade := range cidades {
+		if situacao, existia := cidade.NaData(data); existia {
+			resultado = append(resultado, situacao)
+		}
+	}
+
+	extintas, err := uc.repo.FindCidadesExtintas()
+	if err != nil {
+		return nil, err
+	}
+	for _, cidade := range extintas {
+		if !incluir(cidade) {
+			continue
+		}
+		if situacao, existia := cidade.NaData(data); existia {
+			resultado = append(resultado, situacao)
+		}
+	}
+	return resultado, nil
+}
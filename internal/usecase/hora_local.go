@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// GetHoraLocal retorna a hora corrente e o deslocamento UTC no fuso horário do município.
+// Se o município não tiver fuso próprio cadastrado, é usado o fuso padrão do estado.
+func (uc *IBGEUseCase) GetHoraLocal(codigo_ibge string) (*domain.HoraLocal, error) {
+	cidade, err := uc.repo.FindCidadeByCodigo(codigo_ibge)
+	if err != nil {
+		return nil, err
+	}
+
+	fuso := cidade.FusoHorario
+	if fuso == "" {
+		estado, err := uc.repo.FindEstadoByUF(cidade.EstadoSigla)
+		if err != nil {
+			return nil, err
+		}
+		fuso = estado.FusoHorario
+	}
+	if fuso == "" {
+		return nil, domain.NaoEncontrado(domain.MsgFusoNaoCadastrado, codigo_ibge)
+	}
+
+	loc, err := time.LoadLocation(fuso)
+	if err != nil {
+		return nil, fmt.Errorf("fuso horário %s inválido: %w", fuso, err)
+	}
+
+	agora := uc.agora().In(loc)
+	return &domain.HoraLocal{
+		CodigoIBGE:   cidade.CodigoIBGE,
+		Nome:         cidade.Nome,
+		EstadoSigla:  cidade.EstadoSigla,
+		FusoHorario:  fuso,
+		HoraLocal:    agora,
+		UTCOffset:    agora.Format("-07:00"),
+		Abreviacao:   agora.Format("MST"),
+		HorarioVerao: agora.IsDST(),
+	}, nil
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+	_ "time/tzdata" // Como no binário, o teste não depende da base de fusos do sistema
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// repositorioHoraLocal atende apenas às consultas usadas por GetHoraLocal; os demais métodos da
+// interface não são chamados e entram pelo campo embutido.
+type repositorioHoraLocal struct {
+	IBGERepository
+	cidades map[string]domain.Cidade
+	estados map[string]domain.Estado
+}
+
+func (r *repositorioHoraLocal) FindCidadeByCodigo(codigo string) (*domain.Cidade, error) {
+	cidade, ok := r.cidades[codigo]
+	if !ok {
+		return nil, domain.NaoEncontrado(domain.MsgCidadeNaoEncontrada, codigo)
+	}
+	return &cidade, nil
+}
+
+func (r *repositorioHoraLocal) FindEstadoByUF(uf string) (*domain.Estado, error) {
+	estado, ok := r.estados[uf]
+	if !ok {
+		return nil, domain.NaoEncontrado(domain.MsgEstadoSiglaNaoEncontrado, uf)
+	}
+	return &estado, nil
+}
+
+// novoCasoDeUsoNoInstante cria o caso de uso com o relógio parado no instante informado.
+func novoCasoDeUsoNoInstante(instante time.Time) *IBGEUseCase {
+	uc := NewIBGEUseCase(&repositorioHoraLocal{
+		cidades: map[string]domain.Cidade{
+			"3550308": {CodigoIBGE: 3550308, Nome: "São Paulo", EstadoSigla: "SP", FusoHorario: "America/Sao_Paulo"},
+			"1302603": {CodigoIBGE: 1302603, Nome: "Manaus", EstadoSigla: "AM"},
+			"1200401": {CodigoIBGE: 1200401, Nome: "Rio Branco", EstadoSigla: "AC"},
+		},
+		estados: map[string]domain.Estado{
+			"SP": {CodigoIBGE: 35, Sigla: "SP", Nome: "São Paulo", FusoHorario: "America/Sao_Paulo"},
+			"AM": {CodigoIBGE: 13, Sigla: "AM", Nome: "Amazonas", FusoHorario: "America/Manaus"},
+			"AC": {CodigoIBGE: 12, Sigla: "AC", Nome: "Acre"},
+		},
+	})
+	uc.agora = func() time.Time { return instante }
+	return uc
+}
+
+func TestGetHoraLocal(t *testing.T) {
+	casos := []struct {
+		nome         string
+		instante     time.Time
+		codigo       string
+		fuso         string
+		horaLocal    string
+		utcOffset    string
+		horarioVerao bool
+	}{
+		{
+			nome:      "fuso do município",
+			instante:  time.Date(2024, 1, 15, 15, 0, 0, 0, time.UTC),
+			codigo:    "3550308",
+			fuso:      "America/Sao_Paulo",
+			horaLocal: "2024-01-15T12:00:00-03:00",
+			utcOffset: "-03:00",
+		},
+		{
+			nome:         "horário de verão, em vigor até 2019",
+			instante:     time.Date(2018, 1, 15, 15, 0, 0, 0, time.UTC),
+			codigo:       "3550308",
+			fuso:         "America/Sao_Paulo",
+			horaLocal:    "2018-01-15T13:00:00-02:00",
+			utcOffset:    "-02:00",
+			horarioVerao: true,
+		},
+		{
+			nome:      "município sem fuso próprio usa o do estado",
+			instante:  time.Date(2024, 1, 15, 15, 0, 0, 0, time.UTC),
+			codigo:    "1302603",
+			fuso:      "America/Manaus",
+			horaLocal: "2024-01-15T11:00:00-04:00",
+			utcOffset: "-04:00",
+		},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			hora, err := novoCasoDeUsoNoInstante(caso.instante).GetHoraLocal(caso.codigo)
+			if err != nil {
+				t.Fatalf("Erro inesperado: %v", err)
+			}
+			if hora.FusoHorario != caso.fuso {
+				t.Errorf("Fuso incorreto: got %s want %s", hora.FusoHorario, caso.fuso)
+			}
+			if got := hora.HoraLocal.Format(time.RFC3339); got != caso.horaLocal {
+				t.Errorf("Hora local incorreta: got %s want %s", got, caso.horaLocal)
+			}
+			if !hora.HoraLocal.Equal(caso.instante) {
+				t.Errorf("A hora local deve ser o mesmo instante do relógio: got %s want %s", hora.HoraLocal, caso.instante)
+			}
+			if hora.UTCOffset != caso.utcOffset {
+				t.Errorf("Deslocamento incorreto: got %s want %s", hora.UTCOffset, caso.utcOffset)
+			}
+			if hora.HorarioVerao != caso.horarioVerao {
+				t.Errorf("Horário de verão incorreto: got %v want %v", hora.HorarioVerao, caso.horarioVerao)
+			}
+		})
+	}
+
+	t.Run("sem fuso no município nem no estado", func(t *testing.T) {
+		_, err := novoCasoDeUsoNoInstante(time.Now()).GetHoraLocal("1200401")
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Erro incorreto: got %v want ErrNotFound", err)
+		}
+	})
+}
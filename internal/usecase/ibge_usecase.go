@@ -1,6 +1,10 @@
 package usecase
 
-import "github.com/brauliohms/ibge-service/internal/domain"
+import (
+	"time"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
 
 // IBGERepository é a interface que define os contratos de acesso aos dados.
 // É a porta de entrada para a persistência, permitindo a inversão de dependência.
@@ -12,16 +16,27 @@ type IBGERepository interface {
 	FindCidadesByEstadoCodigoIbge(codigo_ibge string) ([]domain.Cidade, error)
 	FindCidadeByCodigo(codigo_ibge string) (*domain.Cidade, error)
 	FindCidadeByCodigoTOM(codigo_tom string) (*domain.Cidade, error)
+	FindCidadeByCEP(cep string) (*domain.Cidade, error)
+	FindEstadoByCEP(cep string) (*domain.Estado, error)
+	FindAllFaixasCEP() ([]domain.FaixaCEP, error)
+	FindCidadesByNome(nome string, uf string) ([]domain.Cidade, error)
+	FindEstados(consulta domain.ConsultaEstados) ([]domain.Estado, error)
+	FindCidades(consulta domain.ConsultaCidades) ([]domain.Cidade, error)
+	FindCidadesExtintas() ([]domain.Cidade, error)
+	FindAlteracoesDesde(versao int) ([]domain.Alteracao, error)
+	FindVersaoDataset() (int, error)
+	FindAtualizadoEm() (time.Time, error)
 }
 
 // IBGEUseCase encapsula a lógica de negócio relacionada ao IBGE.
 type IBGEUseCase struct {
-	repo IBGERepository
+	repo  IBGERepository
+	agora func() time.Time // Relógio injetável para facilitar os testes
 }
 
 // NewIBGEUseCase cria uma nova instância do caso de uso, injetando o repositório.
 func NewIBGEUseCase(repo IBGERepository) *IBGEUseCase {
-	return &IBGEUseCase{repo: repo}
+	return &IBGEUseCase{repo: repo, agora: time.Now}
 }
 
 // GetAllEstados retorna todos os estados.
@@ -56,3 +71,24 @@ func (uc *IBGEUseCase) GetCidadeByCodigo(codigo_ibge string) (*domain.Cidade, er
 func (uc *IBGEUseCase) GetCidadeByCodigoTOM(codigo_tom string) (*domain.Cidade, error) {
 	return uc.repo.FindCidadeByCodigoTOM(codigo_tom)
 }
+
+// BuscarCidades retorna as cidades cujo nome ou nome alternativo contém o termo informado.
+func (uc *IBGEUseCase) BuscarCidades(nome string, uf string) ([]domain.Cidade, error) {
+	return uc.repo.FindCidadesByNome(nome, uf)
+}
+
+// ConsultarEstados retorna os estados que satisfazem os filtros da consulta, na ordem pedida.
+func (uc *IBGEUseCase) ConsultarEstados(consulta domain.ConsultaEstados) ([]domain.Estado, error) {
+	return uc.repo.FindEstados(consulta)
+}
+
+// ConsultarCidades retorna as cidades que satisfazem os filtros da consulta, na ordem pedida.
+func (uc *IBGEUseCase) ConsultarCidades(consulta domain.ConsultaCidades) ([]domain.Cidade, error) {
+	return uc.repo.FindCidades(consulta)
+}
+
+// GetAtualizadoEm retorna a data da última execução do seed, que é quando o conjunto de dados
+// mudou pela última vez (zero se a fonte não a informar).
+func (uc *IBGEUseCase) GetAtualizadoEm() (time.Time, error) {
+	return uc.repo.FindAtualizadoEm()
+}
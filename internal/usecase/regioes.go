@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// GetRegioes retorna as cinco Grandes Regiões do Brasil.
+func (uc *IBGEUseCase) GetRegioes() []domain.Regiao {
+	return domain.Regioes()
+}
+
+// GetRegiao busca uma Grande Região pelo código IBGE (ex: "3") ou pela sigla (ex: "SE").
+func (uc *IBGEUseCase) GetRegiao(codigoOuSigla string) (*domain.Regiao, error) {
+	regiao, ok := domain.RegiaoPorCodigoOuSigla(codigoOuSigla)
+	if !ok {
+		return nil, domain.NaoEncontrado(domain.MsgRegiaoNaoEncontrada, codigoOuSigla)
+	}
+	return &regiao, nil
+}
+
+// GetEstadosByRegiao retorna os estados de uma Grande Região, ordenados pelo código IBGE.
+func (uc *IBGEUseCase) GetEstadosByRegiao(codigoOuSigla string) ([]domain.Estado, error) {
+	regiao, err := uc.GetRegiao(codigoOuSigla)
+	if err != nil {
+		return nil, err
+	}
+
+	todos, err := uc.repo.FindAllEstados()
+	if err != nil {
+		return nil, err
+	}
+
+	estados := []domain.Estado{}
+	for _, e := range todos {
+		if e.Regiao().CodigoIBGE == regiao.CodigoIBGE {
+			estados = append(estados, e)
+		}
+	}
+	sort.Slice(estados, func(i, j int) bool { return estados[i].CodigoIBGE < estados[j].CodigoIBGE })
+	return estados, nil
+}
+
+// GetAllCidades retorna as cidades de todos os estados, ordenadas pelo código IBGE.
+func (uc *IBGEUseCase) GetAllCidades() ([]domain.Cidade, error) {
+	estados, err := uc.repo.FindAllEstados()
+	if err != nil {
+		return nil, err
+	}
+	return uc.cidadesDosEstados(estados)
+}
+
+// GetCidadesByRegiao retorna as cidades dos estados de uma Grande Região, ordenadas pelo código IBGE.
+func (uc *IBGEUseCase) GetCidadesByRegiao(codigoOuSigla string) ([]domain.Cidade, error) {
+	estados, err := uc.GetEstadosByRegiao(codigoOuSigla)
+	if err != nil {
+		return nil, err
+	}
+	return uc.cidadesDosEstados(estados)
+}
+
+func (uc *IBGEUseCase) cidadesDosEstados(estados []domain.Estado) ([]domain.Cidade, error) {
+	cidades := []domain.Cidade{}
+	for _, e := range estados {
+		doEstado, err := uc.repo.FindCidadesByEstadoCodigoIbge(strconv.Itoa(e.CodigoIBGE))
+		if err != nil {
+			return nil, err
+		}
+		cidades = append(cidades, doEstado...)
+	}
+	sort.Slice(cidades, func(i, j int) bool { return cidades[i].CodigoIBGE < cidades[j].CodigoIBGE })
+	return cidades, nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/brauliohms/ibge-service/internal/domain"
+)
+
+// RegistroVersoes mantém um caso de uso para cada edição do conjunto de dados
+// (ex: DTB 2020, 2022, 2024), permitindo servi-las lado a lado.
+type RegistroVersoes struct {
+	versoes map[string]*IBGEUseCase
+	padrao  string
+}
+
+// NewRegistroVersoes cria um registro de versões vazio.
+func NewRegistroVersoes() *RegistroVersoes {
+	return &RegistroVersoes{versoes: make(map[string]*IBGEUseCase)}
+}
+
+// Registrar adiciona uma edição do conjunto de dados. Enquanto nenhuma versão padrão for
+// definida explicitamente, a padrão é a mais recente (maior em ordem lexicográfica).
+func (r *RegistroVersoes) Registrar(versao string, uc *IBGEUseCase) {
+	r.versoes[versao] = uc
+}
+
+// DefinirPadrao escolhe a versão usada quando o cliente não informa nenhuma.
+func (r *RegistroVersoes) DefinirPadrao(versao string) error {
+	if _, found := r.versoes[versao]; !found {
+		return fmt.Errorf("versão do conjunto de dados %s não registrada", versao)
+	}
+	r.padrao = versao
+	return nil
+}
+
+// Padrao retorna a versão usada quando o cliente não informa nenhuma.
+func (r *RegistroVersoes) Padrao() string {
+	if r.padrao != "" {
+		return r.padrao
+	}
+	versoes := r.Versoes()
+	if len(versoes) == 0 {
+		return ""
+	}
+	return versoes[len(versoes)-1]
+}
+
+// Versoes retorna as versões registradas em ordem crescente.
+func (r *RegistroVersoes) Versoes() []string {
+	versoes := make([]string, 0, len(r.versoes))
+	for versao := range r.versoes {
+		versoes = append(versoes, versao)
+	}
+	sort.Strings(versoes)
+	return versoes
+}
+
+// Versao retorna o caso de uso da versão informada, ou da versão padrão se ela for vazia.
+// O segundo retorno é o nome da versão efetivamente selecionada.
+func (r *RegistroVersoes) Versao(versao string) (*IBGEUseCase, string, error) {
+	if versao == "" {
+		versao = r.Padrao()
+	}
+	uc, found := r.versoes[versao]
+	if !found {
+		return nil, "", domain.ArgumentoInvalido(domain.MsgVersaoDatasetNaoEncontrada, versao, r.Versoes())
+	}
+	return uc, versao, nil
+}
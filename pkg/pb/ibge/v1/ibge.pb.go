@@ -0,0 +1,2452 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        (unknown)
+// source: ibge/v1/ibge.proto
+
+// Serviço gRPC de consulta de estados e cidades do Brasil, baseado nos dados do IBGE.
+// A edição do conjunto de dados pode ser escolhida com o metadado x-dataset-version.
+
+package ibgev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Grande Região do Brasil.
+type Regiao struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CodigoIbge    int32                  `protobuf:"varint,1,opt,name=codigo_ibge,json=codigoIbge,proto3" json:"codigo_ibge,omitempty"`
+	Sigla         string                 `protobuf:"bytes,2,opt,name=sigla,proto3" json:"sigla,omitempty"`
+	Nome          string                 `protobuf:"bytes,3,opt,name=nome,proto3" json:"nome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Regiao) Reset() {
+	*x = Regiao{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Regiao) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Regiao) ProtoMessage() {}
+
+func (x *Regiao) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Regiao.ProtoReflect.Descriptor instead.
+func (*Regiao) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Regiao) GetCodigoIbge() int32 {
+	if x != nil {
+		return x.CodigoIbge
+	}
+	return 0
+}
+
+func (x *Regiao) GetSigla() string {
+	if x != nil {
+		return x.Sigla
+	}
+	return ""
+}
+
+func (x *Regiao) GetNome() string {
+	if x != nil {
+		return x.Nome
+	}
+	return ""
+}
+
+// Regra de um feriado: fixo (dia e mes) ou móvel (dias_apos_pascoa, ex: Carnaval = -47).
+type RegraFeriado struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Nome  string                 `protobuf:"bytes,1,opt,name=nome,proto3" json:"nome,omitempty"`
+	// nacional, estadual ou municipal.
+	Tipo           string `protobuf:"bytes,2,opt,name=tipo,proto3" json:"tipo,omitempty"`
+	Dia            int32  `protobuf:"varint,3,opt,name=dia,proto3" json:"dia,omitempty"`
+	Mes            int32  `protobuf:"varint,4,opt,name=mes,proto3" json:"mes,omitempty"`
+	DiasAposPascoa *int32 `protobuf:"varint,5,opt,name=dias_apos_pascoa,json=diasAposPascoa,proto3,oneof" json:"dias_apos_pascoa,omitempty"`
+	DesdeAno       int32  `protobuf:"varint,6,opt,name=desde_ano,json=desdeAno,proto3" json:"desde_ano,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegraFeriado) Reset() {
+	*x = RegraFeriado{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegraFeriado) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegraFeriado) ProtoMessage() {}
+
+func (x *RegraFeriado) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegraFeriado.ProtoReflect.Descriptor instead.
+func (*RegraFeriado) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegraFeriado) GetNome() string {
+	if x != nil {
+		return x.Nome
+	}
+	return ""
+}
+
+func (x *RegraFeriado) GetTipo() string {
+	if x != nil {
+		return x.Tipo
+	}
+	return ""
+}
+
+func (x *RegraFeriado) GetDia() int32 {
+	if x != nil {
+		return x.Dia
+	}
+	return 0
+}
+
+func (x *RegraFeriado) GetMes() int32 {
+	if x != nil {
+		return x.Mes
+	}
+	return 0
+}
+
+func (x *RegraFeriado) GetDiasAposPascoa() int32 {
+	if x != nil && x.DiasAposPascoa != nil {
+		return *x.DiasAposPascoa
+	}
+	return 0
+}
+
+func (x *RegraFeriado) GetDesdeAno() int32 {
+	if x != nil {
+		return x.DesdeAno
+	}
+	return 0
+}
+
+// Alteração territorial de um município, com a data em que passou a vigorar (AAAA-MM-DD).
+type EventoMunicipio struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CodigoIbge int32                  `protobuf:"varint,1,opt,name=codigo_ibge,json=codigoIbge,proto3" json:"codigo_ibge,omitempty"`
+	// criacao, desmembramento, renomeacao ou extincao.
+	Tipo         string `protobuf:"bytes,2,opt,name=tipo,proto3" json:"tipo,omitempty"`
+	Data         string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Nome         string `protobuf:"bytes,4,opt,name=nome,proto3" json:"nome,omitempty"`
+	NomeAnterior string `protobuf:"bytes,5,opt,name=nome_anterior,json=nomeAnterior,proto3" json:"nome_anterior,omitempty"`
+	Uf           string `protobuf:"bytes,6,opt,name=uf,proto3" json:"uf,omitempty"`
+	// Municípios dos quais o território foi desmembrado.
+	Origem []int32 `protobuf:"varint,7,rep,packed,name=origem,proto3" json:"origem,omitempty"`
+	// Municípios que absorveram o território de um município extinto.
+	Sucessores    []int32 `protobuf:"varint,8,rep,packed,name=sucessores,proto3" json:"sucessores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventoMunicipio) Reset() {
+	*x = EventoMunicipio{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventoMunicipio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventoMunicipio) ProtoMessage() {}
+
+func (x *EventoMunicipio) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventoMunicipio.ProtoReflect.Descriptor instead.
+func (*EventoMunicipio) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventoMunicipio) GetCodigoIbge() int32 {
+	if x != nil {
+		return x.CodigoIbge
+	}
+	return 0
+}
+
+func (x *EventoMunicipio) GetTipo() string {
+	if x != nil {
+		return x.Tipo
+	}
+	return ""
+}
+
+func (x *EventoMunicipio) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *EventoMunicipio) GetNome() string {
+	if x != nil {
+		return x.Nome
+	}
+	return ""
+}
+
+func (x *EventoMunicipio) GetNomeAnterior() string {
+	if x != nil {
+		return x.NomeAnterior
+	}
+	return ""
+}
+
+func (x *EventoMunicipio) GetUf() string {
+	if x != nil {
+		return x.Uf
+	}
+	return ""
+}
+
+func (x *EventoMunicipio) GetOrigem() []int32 {
+	if x != nil {
+		return x.Origem
+	}
+	return nil
+}
+
+func (x *EventoMunicipio) GetSucessores() []int32 {
+	if x != nil {
+		return x.Sucessores
+	}
+	return nil
+}
+
+// Unidade Federativa do Brasil.
+type Estado struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CodigoIbge  int32                  `protobuf:"varint,1,opt,name=codigo_ibge,json=codigoIbge,proto3" json:"codigo_ibge,omitempty"`
+	Nome        string                 `protobuf:"bytes,2,opt,name=nome,proto3" json:"nome,omitempty"`
+	Sigla       string                 `protobuf:"bytes,3,opt,name=sigla,proto3" json:"sigla,omitempty"`
+	FusoHorario string                 `protobuf:"bytes,4,opt,name=fuso_horario,json=fusoHorario,proto3" json:"fuso_horario,omitempty"`
+	Regiao      *Regiao                `protobuf:"bytes,5,opt,name=regiao,proto3" json:"regiao,omitempty"`
+	// Feriados estaduais.
+	Feriados      []*RegraFeriado `protobuf:"bytes,6,rep,name=feriados,proto3" json:"feriados,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Estado) Reset() {
+	*x = Estado{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Estado) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Estado) ProtoMessage() {}
+
+func (x *Estado) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Estado.ProtoReflect.Descriptor instead.
+func (*Estado) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Estado) GetCodigoIbge() int32 {
+	if x != nil {
+		return x.CodigoIbge
+	}
+	return 0
+}
+
+func (x *Estado) GetNome() string {
+	if x != nil {
+		return x.Nome
+	}
+	return ""
+}
+
+func (x *Estado) GetSigla() string {
+	if x != nil {
+		return x.Sigla
+	}
+	return ""
+}
+
+func (x *Estado) GetFusoHorario() string {
+	if x != nil {
+		return x.FusoHorario
+	}
+	return ""
+}
+
+func (x *Estado) GetRegiao() *Regiao {
+	if x != nil {
+		return x.Regiao
+	}
+	return nil
+}
+
+func (x *Estado) GetFeriados() []*RegraFeriado {
+	if x != nil {
+		return x.Feriados
+	}
+	return nil
+}
+
+// Município brasileiro.
+type Cidade struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CodigoIbge       int32                  `protobuf:"varint,1,opt,name=codigo_ibge,json=codigoIbge,proto3" json:"codigo_ibge,omitempty"`
+	Nome             string                 `protobuf:"bytes,2,opt,name=nome,proto3" json:"nome,omitempty"`
+	Gentilico        string                 `protobuf:"bytes,3,opt,name=gentilico,proto3" json:"gentilico,omitempty"`
+	Aliases          []string               `protobuf:"bytes,4,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	CodigoTom        string                 `protobuf:"bytes,5,opt,name=codigo_tom,json=codigoTom,proto3" json:"codigo_tom,omitempty"`
+	MicroRegiao      string                 `protobuf:"bytes,6,opt,name=micro_regiao,json=microRegiao,proto3" json:"micro_regiao,omitempty"`
+	RegiaoImediata   string                 `protobuf:"bytes,7,opt,name=regiao_imediata,json=regiaoImediata,proto3" json:"regiao_imediata,omitempty"`
+	FusoHorario      string                 `protobuf:"bytes,8,opt,name=fuso_horario,json=fusoHorario,proto3" json:"fuso_horario,omitempty"`
+	EstadoCodigoIbge int32                  `protobuf:"varint,9,opt,name=estado_codigo_ibge,json=estadoCodigoIbge,proto3" json:"estado_codigo_ibge,omitempty"`
+	EstadoSigla      string                 `protobuf:"bytes,10,opt,name=estado_sigla,json=estadoSigla,proto3" json:"estado_sigla,omitempty"`
+	EstadoNome       string                 `protobuf:"bytes,11,opt,name=estado_nome,json=estadoNome,proto3" json:"estado_nome,omitempty"`
+	// Classificações territoriais: ausentes quando a lista oficial completa do recorte não foi carregada.
+	AmazoniaLegal   *bool    `protobuf:"varint,12,opt,name=amazonia_legal,json=amazoniaLegal,proto3,oneof" json:"amazonia_legal,omitempty"`
+	Semiarido       *bool    `protobuf:"varint,13,opt,name=semiarido,proto3,oneof" json:"semiarido,omitempty"`
+	FaixaFronteira  *bool    `protobuf:"varint,14,opt,name=faixa_fronteira,json=faixaFronteira,proto3,oneof" json:"faixa_fronteira,omitempty"`
+	PaisesFronteira []string `protobuf:"bytes,15,rep,name=paises_fronteira,json=paisesFronteira,proto3" json:"paises_fronteira,omitempty"`
+	ZonaCosteira    *bool    `protobuf:"varint,16,opt,name=zona_costeira,json=zonaCosteira,proto3,oneof" json:"zona_costeira,omitempty"`
+	Matopiba        *bool    `protobuf:"varint,17,opt,name=matopiba,proto3,oneof" json:"matopiba,omitempty"`
+	// Feriados municipais.
+	Feriados      []*RegraFeriado    `protobuf:"bytes,18,rep,name=feriados,proto3" json:"feriados,omitempty"`
+	Historico     []*EventoMunicipio `protobuf:"bytes,19,rep,name=historico,proto3" json:"historico,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cidade) Reset() {
+	*x = Cidade{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cidade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cidade) ProtoMessage() {}
+
+func (x *Cidade) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cidade.ProtoReflect.Descriptor instead.
+func (*Cidade) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Cidade) GetCodigoIbge() int32 {
+	if x != nil {
+		return x.CodigoIbge
+	}
+	return 0
+}
+
+func (x *Cidade) GetNome() string {
+	if x != nil {
+		return x.Nome
+	}
+	return ""
+}
+
+func (x *Cidade) GetGentilico() string {
+	if x != nil {
+		return x.Gentilico
+	}
+	return ""
+}
+
+func (x *Cidade) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Cidade) GetCodigoTom() string {
+	if x != nil {
+		return x.CodigoTom
+	}
+	return ""
+}
+
+func (x *Cidade) GetMicroRegiao() string {
+	if x != nil {
+		return x.MicroRegiao
+	}
+	return ""
+}
+
+func (x *Cidade) GetRegiaoImediata() string {
+	if x != nil {
+		return x.RegiaoImediata
+	}
+	return ""
+}
+
+func (x *Cidade) GetFusoHorario() string {
+	if x != nil {
+		return x.FusoHorario
+	}
+	return ""
+}
+
+func (x *Cidade) GetEstadoCodigoIbge() int32 {
+	if x != nil {
+		return x.EstadoCodigoIbge
+	}
+	return 0
+}
+
+func (x *Cidade) GetEstadoSigla() string {
+	if x != nil {
+		return x.EstadoSigla
+	}
+	return ""
+}
+
+func (x *Cidade) GetEstadoNome() string {
+	if x != nil {
+		return x.EstadoNome
+	}
+	return ""
+}
+
+func (x *Cidade) GetAmazoniaLegal() bool {
+	if x != nil && x.AmazoniaLegal != nil {
+		return *x.AmazoniaLegal
+	}
+	return false
+}
+
+func (x *Cidade) GetSemiarido() bool {
+	if x != nil && x.Semiarido != nil {
+		return *x.Semiarido
+	}
+	return false
+}
+
+func (x *Cidade) GetFaixaFronteira() bool {
+	if x != nil && x.FaixaFronteira != nil {
+		return *x.FaixaFronteira
+	}
+	return false
+}
+
+func (x *Cidade) GetPaisesFronteira() []string {
+	if x != nil {
+		return x.PaisesFronteira
+	}
+	return nil
+}
+
+func (x *Cidade) GetZonaCosteira() bool {
+	if x != nil && x.ZonaCosteira != nil {
+		return *x.ZonaCosteira
+	}
+	return false
+}
+
+func (x *Cidade) GetMatopiba() bool {
+	if x != nil && x.Matopiba != nil {
+		return *x.Matopiba
+	}
+	return false
+}
+
+func (x *Cidade) GetFeriados() []*RegraFeriado {
+	if x != nil {
+		return x.Feriados
+	}
+	return nil
+}
+
+func (x *Cidade) GetHistorico() []*EventoMunicipio {
+	if x != nil {
+		return x.Historico
+	}
+	return nil
+}
+
+// Lista de estados, corpo das respostas application/x-protobuf da API HTTP que retornam estados.
+type Estados struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Estados       []*Estado              `protobuf:"bytes,1,rep,name=estados,proto3" json:"estados,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Estados) Reset() {
+	*x = Estados{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Estados) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Estados) ProtoMessage() {}
+
+func (x *Estados) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Estados.ProtoReflect.Descriptor instead.
+func (*Estados) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Estados) GetEstados() []*Estado {
+	if x != nil {
+		return x.Estados
+	}
+	return nil
+}
+
+// Lista de municípios, corpo das respostas application/x-protobuf da API HTTP que retornam cidades.
+type Cidades struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cidades       []*Cidade              `protobuf:"bytes,1,rep,name=cidades,proto3" json:"cidades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cidades) Reset() {
+	*x = Cidades{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cidades) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cidades) ProtoMessage() {}
+
+func (x *Cidades) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cidades.ProtoReflect.Descriptor instead.
+func (*Cidades) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Cidades) GetCidades() []*Cidade {
+	if x != nil {
+		return x.Cidades
+	}
+	return nil
+}
+
+// Detalhes de um erro (RFC 7807), corpo das respostas de erro application/x-protobuf da API HTTP.
+type Problema struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Type      string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status    int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Detail    string                 `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	Code      string                 `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	Instance  string                 `protobuf:"bytes,6,opt,name=instance,proto3" json:"instance,omitempty"`
+	RequestId string                 `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Preenchidos apenas na resposta 410 para municípios extintos.
+	DataExtincao  string    `protobuf:"bytes,8,opt,name=data_extincao,json=dataExtincao,proto3" json:"data_extincao,omitempty"`
+	Cidade        *Cidade   `protobuf:"bytes,9,opt,name=cidade,proto3" json:"cidade,omitempty"`
+	Sucessores    []*Cidade `protobuf:"bytes,10,rep,name=sucessores,proto3" json:"sucessores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Problema) Reset() {
+	*x = Problema{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Problema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Problema) ProtoMessage() {}
+
+func (x *Problema) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Problema.ProtoReflect.Descriptor instead.
+func (*Problema) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Problema) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Problema) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Problema) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Problema) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *Problema) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Problema) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *Problema) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *Problema) GetDataExtincao() string {
+	if x != nil {
+		return x.DataExtincao
+	}
+	return ""
+}
+
+func (x *Problema) GetCidade() *Cidade {
+	if x != nil {
+		return x.Cidade
+	}
+	return nil
+}
+
+func (x *Problema) GetSucessores() []*Cidade {
+	if x != nil {
+		return x.Sucessores
+	}
+	return nil
+}
+
+// Detalhe dos erros FAILED_PRECONDITION do IBGEService para códigos de municípios extintos: a
+// data de extinção, o município e os municípios que absorveram o seu território.
+type CidadeExtinta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DataExtincao  string                 `protobuf:"bytes,1,opt,name=data_extincao,json=dataExtincao,proto3" json:"data_extincao,omitempty"`
+	Cidade        *Cidade                `protobuf:"bytes,2,opt,name=cidade,proto3" json:"cidade,omitempty"`
+	Sucessores    []*Cidade              `protobuf:"bytes,3,rep,name=sucessores,proto3" json:"sucessores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CidadeExtinta) Reset() {
+	*x = CidadeExtinta{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CidadeExtinta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CidadeExtinta) ProtoMessage() {}
+
+func (x *CidadeExtinta) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CidadeExtinta.ProtoReflect.Descriptor instead.
+func (*CidadeExtinta) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CidadeExtinta) GetDataExtincao() string {
+	if x != nil {
+		return x.DataExtincao
+	}
+	return ""
+}
+
+func (x *CidadeExtinta) GetCidade() *Cidade {
+	if x != nil {
+		return x.Cidade
+	}
+	return nil
+}
+
+func (x *CidadeExtinta) GetSucessores() []*Cidade {
+	if x != nil {
+		return x.Sucessores
+	}
+	return nil
+}
+
+// Feriado em uma data (AAAA-MM-DD) de um ano, calculado a partir das regras.
+type Feriado struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Data  string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Nome  string                 `protobuf:"bytes,2,opt,name=nome,proto3" json:"nome,omitempty"`
+	// nacional, estadual ou municipal.
+	Tipo          string `protobuf:"bytes,3,opt,name=tipo,proto3" json:"tipo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feriado) Reset() {
+	*x = Feriado{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feriado) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feriado) ProtoMessage() {}
+
+func (x *Feriado) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feriado.ProtoReflect.Descriptor instead.
+func (*Feriado) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Feriado) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *Feriado) GetNome() string {
+	if x != nil {
+		return x.Nome
+	}
+	return ""
+}
+
+func (x *Feriado) GetTipo() string {
+	if x != nil {
+		return x.Tipo
+	}
+	return ""
+}
+
+// Lista de feriados, corpo da resposta application/x-protobuf de /feriados.
+type Feriados struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feriados      []*Feriado             `protobuf:"bytes,1,rep,name=feriados,proto3" json:"feriados,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feriados) Reset() {
+	*x = Feriados{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feriados) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feriados) ProtoMessage() {}
+
+func (x *Feriados) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feriados.ProtoReflect.Descriptor instead.
+func (*Feriados) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Feriados) GetFeriados() []*Feriado {
+	if x != nil {
+		return x.Feriados
+	}
+	return nil
+}
+
+// Prazo em dias úteis de um município, corpo da resposta application/x-protobuf de /dias-uteis.
+type DiasUteis struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CodigoIbge           int32                  `protobuf:"varint,1,opt,name=codigo_ibge,json=codigoIbge,proto3" json:"codigo_ibge,omitempty"`
+	Inicio               string                 `protobuf:"bytes,2,opt,name=inicio,proto3" json:"inicio,omitempty"`
+	Dias                 int32                  `protobuf:"varint,3,opt,name=dias,proto3" json:"dias,omitempty"`
+	DataFinal            string                 `protobuf:"bytes,4,opt,name=data_final,json=dataFinal,proto3" json:"data_final,omitempty"`
+	FeriadosConsiderados []*Feriado             `protobuf:"bytes,5,rep,name=feriados_considerados,json=feriadosConsiderados,proto3" json:"feriados_considerados,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DiasUteis) Reset() {
+	*x = DiasUteis{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiasUteis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiasUteis) ProtoMessage() {}
+
+func (x *DiasUteis) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiasUteis.ProtoReflect.Descriptor instead.
+func (*DiasUteis) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DiasUteis) GetCodigoIbge() int32 {
+	if x != nil {
+		return x.CodigoIbge
+	}
+	return 0
+}
+
+func (x *DiasUteis) GetInicio() string {
+	if x != nil {
+		return x.Inicio
+	}
+	return ""
+}
+
+func (x *DiasUteis) GetDias() int32 {
+	if x != nil {
+		return x.Dias
+	}
+	return 0
+}
+
+func (x *DiasUteis) GetDataFinal() string {
+	if x != nil {
+		return x.DataFinal
+	}
+	return ""
+}
+
+func (x *DiasUteis) GetFeriadosConsiderados() []*Feriado {
+	if x != nil {
+		return x.FeriadosConsiderados
+	}
+	return nil
+}
+
+// Hora corrente de um município, corpo da resposta application/x-protobuf de /cidades/{codigo_ibge}/hora-local.
+type HoraLocal struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CodigoIbge  int32                  `protobuf:"varint,1,opt,name=codigo_ibge,json=codigoIbge,proto3" json:"codigo_ibge,omitempty"`
+	Nome        string                 `protobuf:"bytes,2,opt,name=nome,proto3" json:"nome,omitempty"`
+	EstadoSigla string                 `protobuf:"bytes,3,opt,name=estado_sigla,json=estadoSigla,proto3" json:"estado_sigla,omitempty"`
+	FusoHorario string                 `protobuf:"bytes,4,opt,name=fuso_horario,json=fusoHorario,proto3" json:"fuso_horario,omitempty"`
+	// RFC 3339, com o deslocamento do fuso (ex: 2026-01-23T10:00:00-04:00).
+	HoraLocal     string `protobuf:"bytes,5,opt,name=hora_local,json=horaLocal,proto3" json:"hora_local,omitempty"`
+	UtcOffset     string `protobuf:"bytes,6,opt,name=utc_offset,json=utcOffset,proto3" json:"utc_offset,omitempty"`
+	Abreviacao    string `protobuf:"bytes,7,opt,name=abreviacao,proto3" json:"abreviacao,omitempty"`
+	HorarioVerao  bool   `protobuf:"varint,8,opt,name=horario_verao,json=horarioVerao,proto3" json:"horario_verao,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoraLocal) Reset() {
+	*x = HoraLocal{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoraLocal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoraLocal) ProtoMessage() {}
+
+func (x *HoraLocal) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoraLocal.ProtoReflect.Descriptor instead.
+func (*HoraLocal) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *HoraLocal) GetCodigoIbge() int32 {
+	if x != nil {
+		return x.CodigoIbge
+	}
+	return 0
+}
+
+func (x *HoraLocal) GetNome() string {
+	if x != nil {
+		return x.Nome
+	}
+	return ""
+}
+
+func (x *HoraLocal) GetEstadoSigla() string {
+	if x != nil {
+		return x.EstadoSigla
+	}
+	return ""
+}
+
+func (x *HoraLocal) GetFusoHorario() string {
+	if x != nil {
+		return x.FusoHorario
+	}
+	return ""
+}
+
+func (x *HoraLocal) GetHoraLocal() string {
+	if x != nil {
+		return x.HoraLocal
+	}
+	return ""
+}
+
+func (x *HoraLocal) GetUtcOffset() string {
+	if x != nil {
+		return x.UtcOffset
+	}
+	return ""
+}
+
+func (x *HoraLocal) GetAbreviacao() string {
+	if x != nil {
+		return x.Abreviacao
+	}
+	return ""
+}
+
+func (x *HoraLocal) GetHorarioVerao() bool {
+	if x != nil {
+		return x.HorarioVerao
+	}
+	return false
+}
+
+// Resultado da validação de um endereço, corpo da resposta application/x-protobuf de /cep/{cep}/validacao.
+type ValidacaoEndereco struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Cep             string                 `protobuf:"bytes,1,opt,name=cep,proto3" json:"cep,omitempty"`
+	Valido          bool                   `protobuf:"varint,2,opt,name=valido,proto3" json:"valido,omitempty"`
+	UfConfere       bool                   `protobuf:"varint,3,opt,name=uf_confere,json=ufConfere,proto3" json:"uf_confere,omitempty"`
+	CidadeConfere   bool                   `protobuf:"varint,4,opt,name=cidade_confere,json=cidadeConfere,proto3" json:"cidade_confere,omitempty"`
+	EstadoEsperado  *Estado                `protobuf:"bytes,5,opt,name=estado_esperado,json=estadoEsperado,proto3" json:"estado_esperado,omitempty"`
+	CidadeEsperada  *Cidade                `protobuf:"bytes,6,opt,name=cidade_esperada,json=cidadeEsperada,proto3" json:"cidade_esperada,omitempty"`
+	Inconsistencias []string               `protobuf:"bytes,7,rep,name=inconsistencias,proto3" json:"inconsistencias,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ValidacaoEndereco) Reset() {
+	*x = ValidacaoEndereco{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidacaoEndereco) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidacaoEndereco) ProtoMessage() {}
+
+func (x *ValidacaoEndereco) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidacaoEndereco.ProtoReflect.Descriptor instead.
+func (*ValidacaoEndereco) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ValidacaoEndereco) GetCep() string {
+	if x != nil {
+		return x.Cep
+	}
+	return ""
+}
+
+func (x *ValidacaoEndereco) GetValido() bool {
+	if x != nil {
+		return x.Valido
+	}
+	return false
+}
+
+func (x *ValidacaoEndereco) GetUfConfere() bool {
+	if x != nil {
+		return x.UfConfere
+	}
+	return false
+}
+
+func (x *ValidacaoEndereco) GetCidadeConfere() bool {
+	if x != nil {
+		return x.CidadeConfere
+	}
+	return false
+}
+
+func (x *ValidacaoEndereco) GetEstadoEsperado() *Estado {
+	if x != nil {
+		return x.EstadoEsperado
+	}
+	return nil
+}
+
+func (x *ValidacaoEndereco) GetCidadeEsperada() *Cidade {
+	if x != nil {
+		return x.CidadeEsperada
+	}
+	return nil
+}
+
+func (x *ValidacaoEndereco) GetInconsistencias() []string {
+	if x != nil {
+		return x.Inconsistencias
+	}
+	return nil
+}
+
+// Mudança de valor de um campo de um registro alterado.
+type DiferencaCampo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campo         string                 `protobuf:"bytes,1,opt,name=campo,proto3" json:"campo,omitempty"`
+	Antes         string                 `protobuf:"bytes,2,opt,name=antes,proto3" json:"antes,omitempty"`
+	Depois        string                 `protobuf:"bytes,3,opt,name=depois,proto3" json:"depois,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiferencaCampo) Reset() {
+	*x = DiferencaCampo{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiferencaCampo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiferencaCampo) ProtoMessage() {}
+
+func (x *DiferencaCampo) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiferencaCampo.ProtoReflect.Descriptor instead.
+func (*DiferencaCampo) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DiferencaCampo) GetCampo() string {
+	if x != nil {
+		return x.Campo
+	}
+	return ""
+}
+
+func (x *DiferencaCampo) GetAntes() string {
+	if x != nil {
+		return x.Antes
+	}
+	return ""
+}
+
+func (x *DiferencaCampo) GetDepois() string {
+	if x != nil {
+		return x.Depois
+	}
+	return ""
+}
+
+// Criação, atualização ou remoção de um estado ou município registrada pelo seed.
+type Alteracao struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Versao int32                  `protobuf:"varint,1,opt,name=versao,proto3" json:"versao,omitempty"`
+	// estado ou cidade.
+	Entidade string `protobuf:"bytes,2,opt,name=entidade,proto3" json:"entidade,omitempty"`
+	// adicionado, removido ou alterado.
+	Tipo          string            `protobuf:"bytes,3,opt,name=tipo,proto3" json:"tipo,omitempty"`
+	CodigoIbge    int32             `protobuf:"varint,4,opt,name=codigo_ibge,json=codigoIbge,proto3" json:"codigo_ibge,omitempty"`
+	Nome          string            `protobuf:"bytes,5,opt,name=nome,proto3" json:"nome,omitempty"`
+	Uf            string            `protobuf:"bytes,6,opt,name=uf,proto3" json:"uf,omitempty"`
+	Campos        []*DiferencaCampo `protobuf:"bytes,7,rep,name=campos,proto3" json:"campos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alteracao) Reset() {
+	*x = Alteracao{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alteracao) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alteracao) ProtoMessage() {}
+
+func (x *Alteracao) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alteracao.ProtoReflect.Descriptor instead.
+func (*Alteracao) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Alteracao) GetVersao() int32 {
+	if x != nil {
+		return x.Versao
+	}
+	return 0
+}
+
+func (x *Alteracao) GetEntidade() string {
+	if x != nil {
+		return x.Entidade
+	}
+	return ""
+}
+
+func (x *Alteracao) GetTipo() string {
+	if x != nil {
+		return x.Tipo
+	}
+	return ""
+}
+
+func (x *Alteracao) GetCodigoIbge() int32 {
+	if x != nil {
+		return x.CodigoIbge
+	}
+	return 0
+}
+
+func (x *Alteracao) GetNome() string {
+	if x != nil {
+		return x.Nome
+	}
+	return ""
+}
+
+func (x *Alteracao) GetUf() string {
+	if x != nil {
+		return x.Uf
+	}
+	return ""
+}
+
+func (x *Alteracao) GetCampos() []*DiferencaCampo {
+	if x != nil {
+		return x.Campos
+	}
+	return nil
+}
+
+// Feed de sincronização, corpo da resposta application/x-protobuf de /alteracoes.
+type FeedAlteracoes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Desde         int32                  `protobuf:"varint,1,opt,name=desde,proto3" json:"desde,omitempty"`
+	VersaoAtual   int32                  `protobuf:"varint,2,opt,name=versao_atual,json=versaoAtual,proto3" json:"versao_atual,omitempty"`
+	Alteracoes    []*Alteracao           `protobuf:"bytes,3,rep,name=alteracoes,proto3" json:"alteracoes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedAlteracoes) Reset() {
+	*x = FeedAlteracoes{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedAlteracoes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedAlteracoes) ProtoMessage() {}
+
+func (x *FeedAlteracoes) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedAlteracoes.ProtoReflect.Descriptor instead.
+func (*FeedAlteracoes) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FeedAlteracoes) GetDesde() int32 {
+	if x != nil {
+		return x.Desde
+	}
+	return 0
+}
+
+func (x *FeedAlteracoes) GetVersaoAtual() int32 {
+	if x != nil {
+		return x.VersaoAtual
+	}
+	return 0
+}
+
+func (x *FeedAlteracoes) GetAlteracoes() []*Alteracao {
+	if x != nil {
+		return x.Alteracoes
+	}
+	return nil
+}
+
+// Edições do conjunto de dados, corpo da resposta application/x-protobuf de /versoes.
+type Versoes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Padrao        string                 `protobuf:"bytes,1,opt,name=padrao,proto3" json:"padrao,omitempty"`
+	Versoes       []string               `protobuf:"bytes,2,rep,name=versoes,proto3" json:"versoes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Versoes) Reset() {
+	*x = Versoes{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Versoes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Versoes) ProtoMessage() {}
+
+func (x *Versoes) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Versoes.ProtoReflect.Descriptor instead.
+func (*Versoes) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Versoes) GetPadrao() string {
+	if x != nil {
+		return x.Padrao
+	}
+	return ""
+}
+
+func (x *Versoes) GetVersoes() []string {
+	if x != nil {
+		return x.Versoes
+	}
+	return nil
+}
+
+// Pacote para download de uma edição do conjunto de dados.
+type Download struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Formato string                 `protobuf:"bytes,1,opt,name=formato,proto3" json:"formato,omitempty"`
+	Arquivo string                 `protobuf:"bytes,2,opt,name=arquivo,proto3" json:"arquivo,omitempty"`
+	Url     string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// Tamanho em bytes.
+	Tamanho       int64  `protobuf:"varint,4,opt,name=tamanho,proto3" json:"tamanho,omitempty"`
+	Sha256        string `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Download) Reset() {
+	*x = Download{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Download) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Download) ProtoMessage() {}
+
+func (x *Download) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Download.ProtoReflect.Descriptor instead.
+func (*Download) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Download) GetFormato() string {
+	if x != nil {
+		return x.Formato
+	}
+	return ""
+}
+
+func (x *Download) GetArquivo() string {
+	if x != nil {
+		return x.Arquivo
+	}
+	return ""
+}
+
+func (x *Download) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Download) GetTamanho() int64 {
+	if x != nil {
+		return x.Tamanho
+	}
+	return 0
+}
+
+func (x *Download) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+// Pacotes da edição selecionada, corpo da resposta application/x-protobuf de /downloads.
+type Downloads struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VersaoDataset string                 `protobuf:"bytes,1,opt,name=versao_dataset,json=versaoDataset,proto3" json:"versao_dataset,omitempty"`
+	Downloads     []*Download            `protobuf:"bytes,2,rep,name=downloads,proto3" json:"downloads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Downloads) Reset() {
+	*x = Downloads{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Downloads) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Downloads) ProtoMessage() {}
+
+func (x *Downloads) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Downloads.ProtoReflect.Descriptor instead.
+func (*Downloads) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Downloads) GetVersaoDataset() string {
+	if x != nil {
+		return x.VersaoDataset
+	}
+	return ""
+}
+
+func (x *Downloads) GetDownloads() []*Download {
+	if x != nil {
+		return x.Downloads
+	}
+	return nil
+}
+
+type ListEstadosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEstadosRequest) Reset() {
+	*x = ListEstadosRequest{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEstadosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEstadosRequest) ProtoMessage() {}
+
+func (x *ListEstadosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEstadosRequest.ProtoReflect.Descriptor instead.
+func (*ListEstadosRequest) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{20}
+}
+
+type ListEstadosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Estados       []*Estado              `protobuf:"bytes,1,rep,name=estados,proto3" json:"estados,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEstadosResponse) Reset() {
+	*x = ListEstadosResponse{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEstadosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEstadosResponse) ProtoMessage() {}
+
+func (x *ListEstadosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEstadosResponse.ProtoReflect.Descriptor instead.
+func (*ListEstadosResponse) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListEstadosResponse) GetEstados() []*Estado {
+	if x != nil {
+		return x.Estados
+	}
+	return nil
+}
+
+type GetEstadoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identificador:
+	//
+	//	*GetEstadoRequest_Uf
+	//	*GetEstadoRequest_CodigoIbge
+	Identificador isGetEstadoRequest_Identificador `protobuf_oneof:"identificador"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEstadoRequest) Reset() {
+	*x = GetEstadoRequest{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEstadoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEstadoRequest) ProtoMessage() {}
+
+func (x *GetEstadoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEstadoRequest.ProtoReflect.Descriptor instead.
+func (*GetEstadoRequest) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetEstadoRequest) GetIdentificador() isGetEstadoRequest_Identificador {
+	if x != nil {
+		return x.Identificador
+	}
+	return nil
+}
+
+func (x *GetEstadoRequest) GetUf() string {
+	if x != nil {
+		if x, ok := x.Identificador.(*GetEstadoRequest_Uf); ok {
+			return x.Uf
+		}
+	}
+	return ""
+}
+
+func (x *GetEstadoRequest) GetCodigoIbge() int32 {
+	if x != nil {
+		if x, ok := x.Identificador.(*GetEstadoRequest_CodigoIbge); ok {
+			return x.CodigoIbge
+		}
+	}
+	return 0
+}
+
+type isGetEstadoRequest_Identificador interface {
+	isGetEstadoRequest_Identificador()
+}
+
+type GetEstadoRequest_Uf struct {
+	// Sigla do estado (ex: SP).
+	Uf string `protobuf:"bytes,1,opt,name=uf,proto3,oneof"`
+}
+
+type GetEstadoRequest_CodigoIbge struct {
+	// Código IBGE do estado (ex: 35).
+	CodigoIbge int32 `protobuf:"varint,2,opt,name=codigo_ibge,json=codigoIbge,proto3,oneof"`
+}
+
+func (*GetEstadoRequest_Uf) isGetEstadoRequest_Identificador() {}
+
+func (*GetEstadoRequest_CodigoIbge) isGetEstadoRequest_Identificador() {}
+
+type GetCidadeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identificador:
+	//
+	//	*GetCidadeRequest_CodigoIbge
+	//	*GetCidadeRequest_CodigoTom
+	Identificador isGetCidadeRequest_Identificador `protobuf_oneof:"identificador"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCidadeRequest) Reset() {
+	*x = GetCidadeRequest{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCidadeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCidadeRequest) ProtoMessage() {}
+
+func (x *GetCidadeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCidadeRequest.ProtoReflect.Descriptor instead.
+func (*GetCidadeRequest) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCidadeRequest) GetIdentificador() isGetCidadeRequest_Identificador {
+	if x != nil {
+		return x.Identificador
+	}
+	return nil
+}
+
+func (x *GetCidadeRequest) GetCodigoIbge() int32 {
+	if x != nil {
+		if x, ok := x.Identificador.(*GetCidadeRequest_CodigoIbge); ok {
+			return x.CodigoIbge
+		}
+	}
+	return 0
+}
+
+func (x *GetCidadeRequest) GetCodigoTom() string {
+	if x != nil {
+		if x, ok := x.Identificador.(*GetCidadeRequest_CodigoTom); ok {
+			return x.CodigoTom
+		}
+	}
+	return ""
+}
+
+type isGetCidadeRequest_Identificador interface {
+	isGetCidadeRequest_Identificador()
+}
+
+type GetCidadeRequest_CodigoIbge struct {
+	// Código IBGE do município (ex: 3550308).
+	CodigoIbge int32 `protobuf:"varint,1,opt,name=codigo_ibge,json=codigoIbge,proto3,oneof"`
+}
+
+type GetCidadeRequest_CodigoTom struct {
+	// Código TOM da Receita Federal (ex: 7107).
+	CodigoTom string `protobuf:"bytes,2,opt,name=codigo_tom,json=codigoTom,proto3,oneof"`
+}
+
+func (*GetCidadeRequest_CodigoIbge) isGetCidadeRequest_Identificador() {}
+
+func (*GetCidadeRequest_CodigoTom) isGetCidadeRequest_Identificador() {}
+
+type GetCidadeByCEPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cep           string                 `protobuf:"bytes,1,opt,name=cep,proto3" json:"cep,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCidadeByCEPRequest) Reset() {
+	*x = GetCidadeByCEPRequest{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCidadeByCEPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCidadeByCEPRequest) ProtoMessage() {}
+
+func (x *GetCidadeByCEPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCidadeByCEPRequest.ProtoReflect.Descriptor instead.
+func (*GetCidadeByCEPRequest) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetCidadeByCEPRequest) GetCep() string {
+	if x != nil {
+		return x.Cep
+	}
+	return ""
+}
+
+type BuscarCidadesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Nome oficial, histórico ou alternativo, sem distinção de acentos e caixa.
+	Nome string `protobuf:"bytes,1,opt,name=nome,proto3" json:"nome,omitempty"`
+	// Sigla do estado para restringir a busca (opcional).
+	Uf            string `protobuf:"bytes,2,opt,name=uf,proto3" json:"uf,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuscarCidadesRequest) Reset() {
+	*x = BuscarCidadesRequest{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuscarCidadesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuscarCidadesRequest) ProtoMessage() {}
+
+func (x *BuscarCidadesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuscarCidadesRequest.ProtoReflect.Descriptor instead.
+func (*BuscarCidadesRequest) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BuscarCidadesRequest) GetNome() string {
+	if x != nil {
+		return x.Nome
+	}
+	return ""
+}
+
+func (x *BuscarCidadesRequest) GetUf() string {
+	if x != nil {
+		return x.Uf
+	}
+	return ""
+}
+
+type BuscarCidadesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cidades       []*Cidade              `protobuf:"bytes,1,rep,name=cidades,proto3" json:"cidades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuscarCidadesResponse) Reset() {
+	*x = BuscarCidadesResponse{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuscarCidadesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuscarCidadesResponse) ProtoMessage() {}
+
+func (x *BuscarCidadesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuscarCidadesResponse.ProtoReflect.Descriptor instead.
+func (*BuscarCidadesResponse) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BuscarCidadesResponse) GetCidades() []*Cidade {
+	if x != nil {
+		return x.Cidades
+	}
+	return nil
+}
+
+type ListCidadesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sigla do estado; vazio lista as cidades de todo o país.
+	Uf            string `protobuf:"bytes,1,opt,name=uf,proto3" json:"uf,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCidadesRequest) Reset() {
+	*x = ListCidadesRequest{}
+	mi := &file_ibge_v1_ibge_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCidadesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCidadesRequest) ProtoMessage() {}
+
+func (x *ListCidadesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibge_v1_ibge_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCidadesRequest.ProtoReflect.Descriptor instead.
+func (*ListCidadesRequest) Descriptor() ([]byte, []int) {
+	return file_ibge_v1_ibge_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListCidadesRequest) GetUf() string {
+	if x != nil {
+		return x.Uf
+	}
+	return ""
+}
+
+var File_ibge_v1_ibge_proto protoreflect.FileDescriptor
+
+var file_ibge_v1_ibge_proto_rawDesc = string([]byte{
+	0x0a, 0x12, 0x69, 0x62, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x53, 0x0a,
+	0x06, 0x52, 0x65, 0x67, 0x69, 0x61, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x69, 0x67,
+	0x6f, 0x5f, 0x69, 0x62, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f,
+	0x64, 0x69, 0x67, 0x6f, 0x49, 0x62, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x67, 0x6c,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x67, 0x6c, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
+	0x6d, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x72, 0x61, 0x46, 0x65, 0x72, 0x69,
+	0x61, 0x64, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x70, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x69, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x69, 0x61, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x65, 0x73, 0x12,
+	0x2d, 0x0a, 0x10, 0x64, 0x69, 0x61, 0x73, 0x5f, 0x61, 0x70, 0x6f, 0x73, 0x5f, 0x70, 0x61, 0x73,
+	0x63, 0x6f, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x69, 0x61,
+	0x73, 0x41, 0x70, 0x6f, 0x73, 0x50, 0x61, 0x73, 0x63, 0x6f, 0x61, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x65, 0x73, 0x64, 0x65, 0x5f, 0x61, 0x6e, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x64, 0x65, 0x73, 0x64, 0x65, 0x41, 0x6e, 0x6f, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x64, 0x69, 0x61, 0x73, 0x5f, 0x61, 0x70, 0x6f, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x63, 0x6f, 0x61,
+	0x22, 0xdb, 0x01, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x4d, 0x75, 0x6e, 0x69, 0x63,
+	0x69, 0x70, 0x69, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x5f, 0x69,
+	0x62, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x64, 0x69, 0x67,
+	0x6f, 0x49, 0x62, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x6d,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x6f, 0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x6d, 0x65, 0x41, 0x6e,
+	0x74, 0x65, 0x72, 0x69, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x75, 0x66, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x75, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x65, 0x6d,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x65, 0x6d, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x75, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x65, 0x73, 0x22, 0xd2,
+	0x01, 0x0a, 0x06, 0x45, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x64,
+	0x69, 0x67, 0x6f, 0x5f, 0x69, 0x62, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x49, 0x62, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x69, 0x67, 0x6c, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x69, 0x67, 0x6c, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x73, 0x6f, 0x5f, 0x68, 0x6f, 0x72,
+	0x61, 0x72, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x73, 0x6f,
+	0x48, 0x6f, 0x72, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x61,
+	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x61, 0x6f, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x61, 0x6f,
+	0x12, 0x31, 0x0a, 0x08, 0x66, 0x65, 0x72, 0x69, 0x61, 0x64, 0x6f, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67,
+	0x72, 0x61, 0x46, 0x65, 0x72, 0x69, 0x61, 0x64, 0x6f, 0x52, 0x08, 0x66, 0x65, 0x72, 0x69, 0x61,
+	0x64, 0x6f, 0x73, 0x22, 0xa7, 0x06, 0x0a, 0x06, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x5f, 0x69, 0x62, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x49, 0x62, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x69, 0x63, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x69, 0x63,
+	0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x64, 0x69, 0x67, 0x6f, 0x5f, 0x74, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x54, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69,
+	0x63, 0x72, 0x6f, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x61, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x52, 0x65, 0x67, 0x69, 0x61, 0x6f, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x67, 0x69, 0x61, 0x6f, 0x5f, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x61,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x61, 0x6f, 0x49, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x73, 0x6f, 0x5f, 0x68,
+	0x6f, 0x72, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75,
+	0x73, 0x6f, 0x48, 0x6f, 0x72, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x73, 0x74,
+	0x61, 0x64, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x5f, 0x69, 0x62, 0x67, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x43, 0x6f, 0x64,
+	0x69, 0x67, 0x6f, 0x49, 0x62, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x73, 0x74, 0x61, 0x64,
+	0x6f, 0x5f, 0x73, 0x69, 0x67, 0x6c, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65,
+	0x73, 0x74, 0x61, 0x64, 0x6f, 0x53, 0x69, 0x67, 0x6c, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x73,
+	0x74, 0x61, 0x64, 0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x4e, 0x6f, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0e, 0x61,
+	0x6d, 0x61, 0x7a, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x6d, 0x61, 0x7a, 0x6f, 0x6e, 0x69, 0x61, 0x4c,
+	0x65, 0x67, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x65, 0x6d, 0x69, 0x61,
+	0x72, 0x69, 0x64, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x09, 0x73, 0x65,
+	0x6d, 0x69, 0x61, 0x72, 0x69, 0x64, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x66, 0x61,
+	0x69, 0x78, 0x61, 0x5f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x69, 0x72, 0x61, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x78, 0x61, 0x46, 0x72, 0x6f, 0x6e,
+	0x74, 0x65, 0x69, 0x72, 0x61, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x69, 0x73,
+	0x65, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x69, 0x72, 0x61, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x69, 0x73, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65,
+	0x69, 0x72, 0x61, 0x12, 0x28, 0x0a, 0x0d, 0x7a, 0x6f, 0x6e, 0x61, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x65, 0x69, 0x72, 0x61, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0c, 0x7a, 0x6f,
+	0x6e, 0x61, 0x43, 0x6f, 0x73, 0x74, 0x65, 0x69, 0x72, 0x61, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a,
+	0x08, 0x6d, 0x61, 0x74, 0x6f, 0x70, 0x69, 0x62, 0x61, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x04, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x6f, 0x70, 0x69, 0x62, 0x61, 0x88, 0x01, 0x01, 0x12, 0x31,
+	0x0a, 0x08, 0x66, 0x65, 0x72, 0x69, 0x61, 0x64, 0x6f, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61,
+	0x46, 0x65, 0x72, 0x69, 0x61, 0x64, 0x6f, 0x52, 0x08, 0x66, 0x65, 0x72, 0x69, 0x61, 0x64, 0x6f,
+	0x73, 0x12, 0x36, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x6f, 0x18, 0x13,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x4d, 0x75, 0x6e, 0x69, 0x63, 0x69, 0x70, 0x69, 0x6f, 0x52, 0x09,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x6f, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x61, 0x6d,
+	0x61, 0x7a, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x73, 0x65, 0x6d, 0x69, 0x61, 0x72, 0x69, 0x64, 0x6f, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x66,
+	0x61, 0x69, 0x78, 0x61, 0x5f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x69, 0x72, 0x61, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x7a, 0x6f, 0x6e, 0x61, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x65, 0x69, 0x72, 0x61,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x70, 0x69, 0x62, 0x61, 0x22, 0x34, 0x0a,
+	0x07, 0x45, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x73, 0x74, 0x61,
+	0x64, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x52, 0x07, 0x65, 0x73, 0x74, 0x61,
+	0x64, 0x6f, 0x73, 0x22, 0x34, 0x0a, 0x07, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x73, 0x12, 0x29,
+	0x0a, 0x07, 0x63, 0x69, 0x64, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65,
+	0x52, 0x07, 0x63, 0x69, 0x64, 0x61, 0x64, 0x65, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x78, 0x74, 0x69, 0x6e, 0x63, 0x61, 0x6f,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x45, 0x78, 0x74, 0x69,
+	0x6e, 0x63, 0x61, 0x6f, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x69, 0x64, 0x61, 0x64, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x69, 0x64, 0x61, 0x64, 0x65, 0x52, 0x06, 0x63, 0x69, 0x64, 0x61, 0x64, 0x65, 0x12, 0x2f, 0x0a,
+	0x0a, 0x73, 0x75, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x64, 0x61,
+	0x64, 0x65, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x8e,
+	0x01, 0x0a, 0x0d, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x45, 0x78, 0x74, 0x69, 0x6e, 0x74, 0x61,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x78, 0x74, 0x69, 0x6e, 0x63, 0x61,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x45, 0x78, 0x74,
+	0x69, 0x6e, 0x63, 0x61, 0x6f, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x69, 0x64, 0x61, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x52, 0x06, 0x63, 0x69, 0x64, 0x61, 0x64, 0x65, 0x12, 0x2f,
+	0x0a, 0x0a, 0x73, 0x75, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x64,
+	0x61, 0x64, 0x65, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x65, 0x73, 0x22,
+	0x45, 0x0a, 0x07, 0x46, 0x65, 0x72, 0x69, 0x61, 0x64, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x69, 0x70, 0x6f, 0x22, 0x38, 0x0a, 0x08, 0x46, 0x65, 0x72, 0x69, 0x61, 0x64,
+	0x6f, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x66, 0x65, 0x72, 0x69, 0x61, 0x64, 0x6f, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x65, 0x72, 0x69, 0x61, 0x64, 0x6f, 0x52, 0x08, 0x66, 0x65, 0x72, 0x69, 0x61, 0x64, 0x6f, 0x73,
+	0x22, 0xbe, 0x01, 0x0a, 0x09, 0x44, 0x69, 0x61, 0x73, 0x55, 0x74, 0x65, 0x69, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x5f, 0x69, 0x62, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x49, 0x62, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x6e, 0x69, 0x63, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x6e, 0x69, 0x63, 0x69, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x61, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x69, 0x61, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x45, 0x0a, 0x15, 0x66, 0x65,
+	0x72, 0x69, 0x61, 0x64, 0x6f, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x61,
+	0x64, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x62, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x72, 0x69, 0x61, 0x64, 0x6f, 0x52, 0x14, 0x66, 0x65, 0x72,
+	0x69, 0x61, 0x64, 0x6f, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x61, 0x64, 0x6f,
+	0x73, 0x22, 0x89, 0x02, 0x0a, 0x09, 0x48, 0x6f, 0x72, 0x61, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x5f, 0x69, 0x62, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x49, 0x62, 0x67, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x5f, 0x73,
+	0x69, 0x67, 0x6c, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x73, 0x74, 0x61,
+	0x64, 0x6f, 0x53, 0x69, 0x67, 0x6c, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x73, 0x6f, 0x5f,
+	0x68, 0x6f, 0x72, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66,
+	0x75, 0x73, 0x6f, 0x48, 0x6f, 0x72, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f,
+	0x72, 0x61, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x68, 0x6f, 0x72, 0x61, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x74, 0x63,
+	0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x74, 0x63, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x62, 0x72, 0x65,
+	0x76, 0x69, 0x61, 0x63, 0x61, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x62,
+	0x72, 0x65, 0x76, 0x69, 0x61, 0x63, 0x61, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x6f, 0x72, 0x61,
+	0x72, 0x69, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x61, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x68, 0x6f, 0x72, 0x61, 0x72, 0x69, 0x6f, 0x56, 0x65, 0x72, 0x61, 0x6f, 0x22, 0xa1, 0x02,
+	0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x63, 0x61, 0x6f, 0x45, 0x6e, 0x64, 0x65, 0x72,
+	0x65, 0x63, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x63, 0x65, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x6f, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x66, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x75, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x69, 0x64, 0x61, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x69, 0x64, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x0f, 0x65, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x5f, 0x65, 0x73,
+	0x70, 0x65, 0x72, 0x61, 0x64, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69,
+	0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x52, 0x0e, 0x65,
+	0x73, 0x74, 0x61, 0x64, 0x6f, 0x45, 0x73, 0x70, 0x65, 0x72, 0x61, 0x64, 0x6f, 0x12, 0x38, 0x0a,
+	0x0f, 0x63, 0x69, 0x64, 0x61, 0x64, 0x65, 0x5f, 0x65, 0x73, 0x70, 0x65, 0x72, 0x61, 0x64, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x52, 0x0e, 0x63, 0x69, 0x64, 0x61, 0x64, 0x65, 0x45,
+	0x73, 0x70, 0x65, 0x72, 0x61, 0x64, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6e,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x61, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x61,
+	0x73, 0x22, 0x54, 0x0a, 0x0e, 0x44, 0x69, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x61, 0x43, 0x61,
+	0x6d, 0x70, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x6d, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x6d, 0x70, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6e, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6e, 0x74, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x65, 0x70, 0x6f, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x65, 0x70, 0x6f, 0x69, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x09, 0x41, 0x6c, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x61, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6f, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x6e, 0x74, 0x69, 0x64, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x6e, 0x74, 0x69, 0x64, 0x61, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x70, 0x6f, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x5f, 0x69, 0x62, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x49, 0x62, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
+	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x75, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x75, 0x66, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x61, 0x6d, 0x70, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x61, 0x43, 0x61, 0x6d, 0x70, 0x6f, 0x52, 0x06, 0x63, 0x61, 0x6d,
+	0x70, 0x6f, 0x73, 0x22, 0x7d, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x64, 0x41, 0x6c, 0x74, 0x65, 0x72,
+	0x61, 0x63, 0x6f, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x73, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x73, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x6f, 0x5f, 0x61, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6f, 0x41, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x32,
+	0x0a, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x61, 0x63, 0x6f, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x74,
+	0x65, 0x72, 0x61, 0x63, 0x61, 0x6f, 0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x61, 0x63, 0x6f,
+	0x65, 0x73, 0x22, 0x3b, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x6f, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x64, 0x72, 0x61, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x61, 0x64, 0x72, 0x61, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x6f, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x6f, 0x65, 0x73, 0x22,
+	0x82, 0x01, 0x0a, 0x08, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x71, 0x75, 0x69, 0x76,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x72, 0x71, 0x75, 0x69, 0x76, 0x6f,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x6d, 0x61, 0x6e, 0x68, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x61, 0x6d, 0x61, 0x6e, 0x68, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x22, 0x63, 0x0a, 0x09, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x6f, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x62,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x09,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x40, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x73, 0x74, 0x61, 0x64, 0x6f,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x52, 0x07, 0x65, 0x73, 0x74, 0x61, 0x64, 0x6f,
+	0x73, 0x22, 0x58, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x02, 0x75, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x02, 0x75, 0x66, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x69, 0x67,
+	0x6f, 0x5f, 0x69, 0x62, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0a,
+	0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x49, 0x62, 0x67, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x64, 0x6f, 0x72, 0x22, 0x67, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x5f, 0x69, 0x62, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x49, 0x62,
+	0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x5f, 0x74, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f,
+	0x54, 0x6f, 0x6d, 0x42, 0x0f, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x64, 0x6f, 0x72, 0x22, 0x29, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x69, 0x64, 0x61, 0x64,
+	0x65, 0x42, 0x79, 0x43, 0x45, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x65, 0x70, 0x22,
+	0x3a, 0x0a, 0x14, 0x42, 0x75, 0x73, 0x63, 0x61, 0x72, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x75,
+	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x75, 0x66, 0x22, 0x42, 0x0a, 0x15, 0x42,
+	0x75, 0x73, 0x63, 0x61, 0x72, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x69, 0x64, 0x61, 0x64, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x52, 0x07, 0x63, 0x69, 0x64, 0x61, 0x64, 0x65, 0x73, 0x22,
+	0x24, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x75, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x75, 0x66, 0x32, 0x9b, 0x03, 0x0a, 0x0b, 0x49, 0x42, 0x47, 0x45, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x73, 0x74,
+	0x61, 0x64, 0x6f, 0x73, 0x12, 0x1b, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x37, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x12, 0x19, 0x2e, 0x69,
+	0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x73, 0x74, 0x61, 0x64, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x73, 0x74, 0x61, 0x64, 0x6f, 0x12, 0x37, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43,
+	0x69, 0x64, 0x61, 0x64, 0x65, 0x12, 0x19, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x64, 0x61, 0x64,
+	0x65, 0x12, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x42, 0x79,
+	0x43, 0x45, 0x50, 0x12, 0x1e, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x42, 0x79, 0x43, 0x45, 0x50, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69,
+	0x64, 0x61, 0x64, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x42, 0x75, 0x73, 0x63, 0x61, 0x72, 0x43, 0x69,
+	0x64, 0x61, 0x64, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x73, 0x63, 0x61, 0x72, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x73, 0x63, 0x61, 0x72, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x64, 0x61,
+	0x64, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x69, 0x64, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0f, 0x2e, 0x69, 0x62, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x64, 0x61, 0x64,
+	0x65, 0x30, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x62, 0x72, 0x61, 0x75, 0x6c, 0x69, 0x6f, 0x68, 0x6d, 0x73, 0x2f, 0x69, 0x62, 0x67,
+	0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62,
+	0x2f, 0x69, 0x62, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x62, 0x67, 0x65, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_ibge_v1_ibge_proto_rawDescOnce sync.Once
+	file_ibge_v1_ibge_proto_rawDescData []byte
+)
+
+func file_ibge_v1_ibge_proto_rawDescGZIP() []byte {
+	file_ibge_v1_ibge_proto_rawDescOnce.Do(func() {
+		file_ibge_v1_ibge_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ibge_v1_ibge_proto_rawDesc), len(file_ibge_v1_ibge_proto_rawDesc)))
+	})
+	return file_ibge_v1_ibge_proto_rawDescData
+}
+
+var file_ibge_v1_ibge_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_ibge_v1_ibge_proto_goTypes = []any{
+	(*Regiao)(nil),                // 0: ibge.v1.Regiao
+	(*RegraFeriado)(nil),          // 1: ibge.v1.RegraFeriado
+	(*EventoMunicipio)(nil),       // 2: ibge.v1.EventoMunicipio
+	(*Estado)(nil),                // 3: ibge.v1.Estado
+	(*Cidade)(nil),                // 4: ibge.v1.Cidade
+	(*Estados)(nil),               // 5: ibge.v1.Estados
+	(*Cidades)(nil),               // 6: ibge.v1.Cidades
+	(*Problema)(nil),              // 7: ibge.v1.Problema
+	(*CidadeExtinta)(nil),         // 8: ibge.v1.CidadeExtinta
+	(*Feriado)(nil),               // 9: ibge.v1.Feriado
+	(*Feriados)(nil),              // 10: ibge.v1.Feriados
+	(*DiasUteis)(nil),             // 11: ibge.v1.DiasUteis
+	(*HoraLocal)(nil),             // 12: ibge.v1.HoraLocal
+	(*ValidacaoEndereco)(nil),     // 13: ibge.v1.ValidacaoEndereco
+	(*DiferencaCampo)(nil),        // 14: ibge.v1.DiferencaCampo
+	(*Alteracao)(nil),             // 15: ibge.v1.Alteracao
+	(*FeedAlteracoes)(nil),        // 16: ibge.v1.FeedAlteracoes
+	(*Versoes)(nil),               // 17: ibge.v1.Versoes
+	(*Download)(nil),              // 18: ibge.v1.Download
+	(*Downloads)(nil),             // 19: ibge.v1.Downloads
+	(*ListEstadosRequest)(nil),    // 20: ibge.v1.ListEstadosRequest
+	(*ListEstadosResponse)(nil),   // 21: ibge.v1.ListEstadosResponse
+	(*GetEstadoRequest)(nil),      // 22: ibge.v1.GetEstadoRequest
+	(*GetCidadeRequest)(nil),      // 23: ibge.v1.GetCidadeRequest
+	(*GetCidadeByCEPRequest)(nil), // 24: ibge.v1.GetCidadeByCEPRequest
+	(*BuscarCidadesRequest)(nil),  // 25: ibge.v1.BuscarCidadesRequest
+	(*BuscarCidadesResponse)(nil), // 26: ibge.v1.BuscarCidadesResponse
+	(*ListCidadesRequest)(nil),    // 27: ibge.v1.ListCidadesRequest
+}
+var file_ibge_v1_ibge_proto_depIdxs = []int32{
+	0,  // 0: ibge.v1.Estado.regiao:type_name -> ibge.v1.Regiao
+	1,  // 1: ibge.v1.Estado.feriados:type_name -> ibge.v1.RegraFeriado
+	1,  // 2: ibge.v1.Cidade.feriados:type_name -> ibge.v1.RegraFeriado
+	2,  // 3: ibge.v1.Cidade.historico:type_name -> ibge.v1.EventoMunicipio
+	3,  // 4: ibge.v1.Estados.estados:type_name -> ibge.v1.Estado
+	4,  // 5: ibge.v1.Cidades.cidades:type_name -> ibge.v1.Cidade
+	4,  // 6: ibge.v1.Problema.cidade:type_name -> ibge.v1.Cidade
+	4,  // 7: ibge.v1.Problema.sucessores:type_name -> ibge.v1.Cidade
+	4,  // 8: ibge.v1.CidadeExtinta.cidade:type_name -> ibge.v1.Cidade
+	4,  // 9: ibge.v1.CidadeExtinta.sucessores:type_name -> ibge.v1.Cidade
+	9,  // 10: ibge.v1.Feriados.feriados:type_name -> ibge.v1.Feriado
+	9,  // 11: ibge.v1.DiasUteis.feriados_considerados:type_name -> ibge.v1.Feriado
+	3,  // 12: ibge.v1.ValidacaoEndereco.estado_esperado:type_name -> ibge.v1.Estado
+	4,  // 13: ibge.v1.ValidacaoEndereco.cidade_esperada:type_name -> ibge.v1.Cidade
+	14, // 14: ibge.v1.Alteracao.campos:type_name -> ibge.v1.DiferencaCampo
+	15, // 15: ibge.v1.FeedAlteracoes.alteracoes:type_name -> ibge.v1.Alteracao
+	18, // 16: ibge.v1.Downloads.downloads:type_name -> ibge.v1.Download
+	3,  // 17: ibge.v1.ListEstadosResponse.estados:type_name -> ibge.v1.Estado
+	4,  // 18: ibge.v1.BuscarCidadesResponse.cidades:type_name -> ibge.v1.Cidade
+	20, // 19: ibge.v1.IBGEService.ListEstados:input_type -> ibge.v1.ListEstadosRequest
+	22, // 20: ibge.v1.IBGEService.GetEstado:input_type -> ibge.v1.GetEstadoRequest
+	23, // 21: ibge.v1.IBGEService.GetCidade:input_type -> ibge.v1.GetCidadeRequest
+	24, // 22: ibge.v1.IBGEService.GetCidadeByCEP:input_type -> ibge.v1.GetCidadeByCEPRequest
+	25, // 23: ibge.v1.IBGEService.BuscarCidades:input_type -> ibge.v1.BuscarCidadesRequest
+	27, // 24: ibge.v1.IBGEService.ListCidades:input_type -> ibge.v1.ListCidadesRequest
+	21, // 25: ibge.v1.IBGEService.ListEstados:output_type -> ibge.v1.ListEstadosResponse
+	3,  // 26: ibge.v1.IBGEService.GetEstado:output_type -> ibge.v1.Estado
+	4,  // 27: ibge.v1.IBGEService.GetCidade:output_type -> ibge.v1.Cidade
+	4,  // 28: ibge.v1.IBGEService.GetCidadeByCEP:output_type -> ibge.v1.Cidade
+	26, // 29: ibge.v1.IBGEService.BuscarCidades:output_type -> ibge.v1.BuscarCidadesResponse
+	4,  // 30: ibge.v1.IBGEService.ListCidades:output_type -> ibge.v1.Cidade
+	25, // [25:31] is the sub-list for method output_type
+	19, // [19:25] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_ibge_v1_ibge_proto_init() }
+func file_ibge_v1_ibge_proto_init() {
+	if File_ibge_v1_ibge_proto != nil {
+		return
+	}
+	file_ibge_v1_ibge_proto_msgTypes[1].OneofWrappers = []any{}
+	file_ibge_v1_ibge_proto_msgTypes[4].OneofWrappers = []any{}
+	file_ibge_v1_ibge_proto_msgTypes[22].OneofWrappers = []any{
+		(*GetEstadoRequest_Uf)(nil),
+		(*GetEstadoRequest_CodigoIbge)(nil),
+	}
+	file_ibge_v1_ibge_proto_msgTypes[23].OneofWrappers = []any{
+		(*GetCidadeRequest_CodigoIbge)(nil),
+		(*GetCidadeRequest_CodigoTom)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ibge_v1_ibge_proto_rawDesc), len(file_ibge_v1_ibge_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ibge_v1_ibge_proto_goTypes,
+		DependencyIndexes: file_ibge_v1_ibge_proto_depIdxs,
+		MessageInfos:      file_ibge_v1_ibge_proto_msgTypes,
+	}.Build()
+	File_ibge_v1_ibge_proto = out.File
+	file_ibge_v1_ibge_proto_goTypes = nil
+	file_ibge_v1_ibge_proto_depIdxs = nil
+}
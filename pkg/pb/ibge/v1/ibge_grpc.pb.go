@@ -0,0 +1,320 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ibge/v1/ibge.proto
+
+// Serviço gRPC de consulta de estados e cidades do Brasil, baseado nos dados do IBGE.
+// A edição do conjunto de dados pode ser escolhida com o metadado x-dataset-version.
+
+package ibgev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	IBGEService_ListEstados_FullMethodName    = "/ibge.v1.IBGEService/ListEstados"
+	IBGEService_GetEstado_FullMethodName      = "/ibge.v1.IBGEService/GetEstado"
+	IBGEService_GetCidade_FullMethodName      = "/ibge.v1.IBGEService/GetCidade"
+	IBGEService_GetCidadeByCEP_FullMethodName = "/ibge.v1.IBGEService/GetCidadeByCEP"
+	IBGEService_BuscarCidades_FullMethodName  = "/ibge.v1.IBGEService/BuscarCidades"
+	IBGEService_ListCidades_FullMethodName    = "/ibge.v1.IBGEService/ListCidades"
+)
+
+// IBGEServiceClient is the client API for IBGEService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IBGEServiceClient interface {
+	ListEstados(ctx context.Context, in *ListEstadosRequest, opts ...grpc.CallOption) (*ListEstadosResponse, error)
+	GetEstado(ctx context.Context, in *GetEstadoRequest, opts ...grpc.CallOption) (*Estado, error)
+	GetCidade(ctx context.Context, in *GetCidadeRequest, opts ...grpc.CallOption) (*Cidade, error)
+	GetCidadeByCEP(ctx context.Context, in *GetCidadeByCEPRequest, opts ...grpc.CallOption) (*Cidade, error)
+	BuscarCidades(ctx context.Context, in *BuscarCidadesRequest, opts ...grpc.CallOption) (*BuscarCidadesResponse, error)
+	// Envia as cidades uma a uma, ordenadas pelo código IBGE.
+	ListCidades(ctx context.Context, in *ListCidadesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Cidade], error)
+}
+
+type iBGEServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIBGEServiceClient(cc grpc.ClientConnInterface) IBGEServiceClient {
+	return &iBGEServiceClient{cc}
+}
+
+func (c *iBGEServiceClient) ListEstados(ctx context.Context, in *ListEstadosRequest, opts ...grpc.CallOption) (*ListEstadosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEstadosResponse)
+	err := c.cc.Invoke(ctx, IBGEService_ListEstados_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iBGEServiceClient) GetEstado(ctx context.Context, in *GetEstadoRequest, opts ...grpc.CallOption) (*Estado, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Estado)
+	err := c.cc.Invoke(ctx, IBGEService_GetEstado_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iBGEServiceClient) GetCidade(ctx context.Context, in *GetCidadeRequest, opts ...grpc.CallOption) (*Cidade, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cidade)
+	err := c.cc.Invoke(ctx, IBGEService_GetCidade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iBGEServiceClient) GetCidadeByCEP(ctx context.Context, in *GetCidadeByCEPRequest, opts ...grpc.CallOption) (*Cidade, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cidade)
+	err := c.cc.Invoke(ctx, IBGEService_GetCidadeByCEP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iBGEServiceClient) BuscarCidades(ctx context.Context, in *BuscarCidadesRequest, opts ...grpc.CallOption) (*BuscarCidadesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuscarCidadesResponse)
+	err := c.cc.Invoke(ctx, IBGEService_BuscarCidades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iBGEServiceClient) ListCidades(ctx context.Context, in *ListCidadesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Cidade], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IBGEService_ServiceDesc.Streams[0], IBGEService_ListCidades_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListCidadesRequest, Cidade]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IBGEService_ListCidadesClient = grpc.ServerStreamingClient[Cidade]
+
+// IBGEServiceServer is the server API for IBGEService service.
+// All implementations must embed UnimplementedIBGEServiceServer
+// for forward compatibility.
+type IBGEServiceServer interface {
+	ListEstados(context.Context, *ListEstadosRequest) (*ListEstadosResponse, error)
+	GetEstado(context.Context, *GetEstadoRequest) (*Estado, error)
+	GetCidade(context.Context, *GetCidadeRequest) (*Cidade, error)
+	GetCidadeByCEP(context.Context, *GetCidadeByCEPRequest) (*Cidade, error)
+	BuscarCidades(context.Context, *BuscarCidadesRequest) (*BuscarCidadesResponse, error)
+	// Envia as cidades uma a uma, ordenadas pelo código IBGE.
+	ListCidades(*ListCidadesRequest, grpc.ServerStreamingServer[Cidade]) error
+	mustEmbedUnimplementedIBGEServiceServer()
+}
+
+// UnimplementedIBGEServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIBGEServiceServer struct{}
+
+func (UnimplementedIBGEServiceServer) ListEstados(context.Context, *ListEstadosRequest) (*ListEstadosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEstados not implemented")
+}
+func (UnimplementedIBGEServiceServer) GetEstado(context.Context, *GetEstadoRequest) (*Estado, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEstado not implemented")
+}
+func (UnimplementedIBGEServiceServer) GetCidade(context.Context, *GetCidadeRequest) (*Cidade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCidade not implemented")
+}
+func (UnimplementedIBGEServiceServer) GetCidadeByCEP(context.Context, *GetCidadeByCEPRequest) (*Cidade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCidadeByCEP not implemented")
+}
+func (UnimplementedIBGEServiceServer) BuscarCidades(context.Context, *BuscarCidadesRequest) (*BuscarCidadesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuscarCidades not implemented")
+}
+func (UnimplementedIBGEServiceServer) ListCidades(*ListCidadesRequest, grpc.ServerStreamingServer[Cidade]) error {
+	return status.Errorf(codes.Unimplemented, "method ListCidades not implemented")
+}
+func (UnimplementedIBGEServiceServer) mustEmbedUnimplementedIBGEServiceServer() {}
+func (UnimplementedIBGEServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeIBGEServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IBGEServiceServer will
+// result in compilation errors.
+type UnsafeIBGEServiceServer interface {
+	mustEmbedUnimplementedIBGEServiceServer()
+}
+
+func RegisterIBGEServiceServer(s grpc.ServiceRegistrar, srv IBGEServiceServer) {
+	// If the following call pancis, it indicates UnimplementedIBGEServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&IBGEService_ServiceDesc, srv)
+}
+
+func _IBGEService_ListEstados_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEstadosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IBGEServiceServer).ListEstados(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IBGEService_ListEstados_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IBGEServiceServer).ListEstados(ctx, req.(*ListEstadosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IBGEService_GetEstado_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEstadoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IBGEServiceServer).GetEstado(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IBGEService_GetEstado_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IBGEServiceServer).GetEstado(ctx, req.(*GetEstadoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IBGEService_GetCidade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCidadeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IBGEServiceServer).GetCidade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IBGEService_GetCidade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IBGEServiceServer).GetCidade(ctx, req.(*GetCidadeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IBGEService_GetCidadeByCEP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCidadeByCEPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IBGEServiceServer).GetCidadeByCEP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IBGEService_GetCidadeByCEP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IBGEServiceServer).GetCidadeByCEP(ctx, req.(*GetCidadeByCEPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IBGEService_BuscarCidades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuscarCidadesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IBGEServiceServer).BuscarCidades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IBGEService_BuscarCidades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IBGEServiceServer).BuscarCidades(ctx, req.(*BuscarCidadesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IBGEService_ListCidades_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCidadesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IBGEServiceServer).ListCidades(m, &grpc.GenericServerStream[ListCidadesRequest, Cidade]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IBGEService_ListCidadesServer = grpc.ServerStreamingServer[Cidade]
+
+// IBGEService_ServiceDesc is the grpc.ServiceDesc for IBGEService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IBGEService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ibge.v1.IBGEService",
+	HandlerType: (*IBGEServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListEstados",
+			Handler:    _IBGEService_ListEstados_Handler,
+		},
+		{
+			MethodName: "GetEstado",
+			Handler:    _IBGEService_GetEstado_Handler,
+		},
+		{
+			MethodName: "GetCidade",
+			Handler:    _IBGEService_GetCidade_Handler,
+		},
+		{
+			MethodName: "GetCidadeByCEP",
+			Handler:    _IBGEService_GetCidadeByCEP_Handler,
+		},
+		{
+			MethodName: "BuscarCidades",
+			Handler:    _IBGEService_BuscarCidades_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListCidades",
+			Handler:       _IBGEService_ListCidades_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ibge/v1/ibge.proto",
+}